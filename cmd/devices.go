@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/truvami/decoder/internal/logger"
+	"github.com/truvami/decoder/pkg/decoder"
+	_ "github.com/truvami/decoder/pkg/devices"
+	"github.com/truvami/decoder/pkg/solver"
+	"github.com/truvami/decoder/pkg/solver/aws"
+	"github.com/truvami/decoder/pkg/solver/ble"
+	"github.com/truvami/decoder/pkg/solver/loracloud"
+	"github.com/truvami/decoder/pkg/solver/wifi"
+	"go.uber.org/zap"
+)
+
+var devEui string
+
+// the metadata of the decoded uplink, see addUplinkFlags
+var uplinkFCnt uint32
+var uplinkReceivedAt string
+var uplinkGateways string
+
+func init() {
+	for _, device := range decoder.Devices() {
+		rootCmd.AddCommand(deviceCmd(device))
+	}
+}
+
+// deviceCmd returns the command which decodes the payloads of a registered device.
+func deviceCmd(device decoder.Device) *cobra.Command {
+	name := device.Name
+	if _, err := decoder.Lookup(device.Name); err != nil {
+		// the device has multiple versions
+		name = device.Name + "-" + device.Version
+	}
+
+	cmd := &cobra.Command{
+		Use:   name + " [port] [payload]",
+		Short: "decode " + device.Description + " payloads",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			if cmd != nil {
+				ctx = cmd.Context()
+			}
+
+			var solver solver.SolverV1
+			if device.Solver {
+				solver = newSolver(ctx)
+			}
+
+			logger.Logger.Debug("initializing decoder", zap.String("device", device.Path()))
+			d, err := decoder.New(device.Path(), deviceOptions(ctx, solver))
+			if err != nil {
+				logger.Logger.Error("error while initializing decoder", zap.Error(err), zap.String("device", device.Path()))
+				return
+			}
+
+			port, err := strconv.Atoi(args[0])
+			if err != nil {
+				logger.Logger.Error("error while parsing port", zap.Error(err), zap.String("port", args[0]))
+				return
+			}
+			logger.Logger.Debug("port parsed successfully", zap.Int("port", port))
+			if port < 0 || port > 255 {
+				logger.Logger.Error("port must be between 0 and 255", zap.Int("port", port))
+				return
+			}
+
+			options, err := uplinkOptions(cmd, devEui, uint8(port))
+			if err != nil {
+				logger.Logger.Error("error while parsing uplink metadata", zap.Error(err))
+				return
+			}
+
+			data, err := decodeUplink(ctx, d, args[1], options)
+			if err != nil && !logWarnings(err) {
+				logger.Logger.Error("error while decoding data", decodeErrorFields(err)...)
+				return
+			}
+
+			printUplink(data)
+		},
+	}
+
+	if device.Solver {
+		cmd.Flags().StringVar(&devEui, "dev-eui", "", "DevEUI of the originator device.\nThis is only required for loracloud solver.")
+	}
+	addUplinkFlags(cmd)
+	return cmd
+}
+
+// addUplinkFlags adds the flags of the metadata of the decoded uplink.
+func addUplinkFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint32Var(&uplinkFCnt, "fcnt", 0, "Frame counter of the uplink. (default: \033[31munknown\033[0m)")
+	cmd.Flags().StringVar(&uplinkReceivedAt, "received-at", "", "Time the uplink was received at in RFC 3339 format, e.g. 2025-01-02T03:04:05Z. (default: \033[31munknown\033[0m)")
+	cmd.Flags().StringVar(&uplinkGateways, "gateways", "", "JSON array of the gateways which received the uplink, e.g. [{\"gatewayId\":\"0016c001ff10a235\",\"rssi\":-80,\"snr\":7.5}]. (default: \033[31mempty\033[0m)")
+}
+
+// uplinkOptions returns the options of the uplink from the flags of the
+// command. The frame counter and the receive time are only set if given.
+func uplinkOptions(cmd *cobra.Command, devEui string, port uint8) (decoder.DecodeOptions, error) {
+	options := decoder.DecodeOptions{
+		DevEUI: devEui,
+		Port:   port,
+	}
+
+	if cmd != nil && cmd.Flags().Changed("fcnt") {
+		fcnt := uplinkFCnt
+		options.FCnt = &fcnt
+	}
+	if uplinkReceivedAt != "" {
+		receivedAt, err := time.Parse(time.RFC3339, uplinkReceivedAt)
+		if err != nil {
+			return options, fmt.Errorf("invalid receive time: %w", err)
+		}
+		options.ReceivedAt = &receivedAt
+	}
+	if uplinkGateways != "" {
+		err := json.Unmarshal([]byte(uplinkGateways), &options.Gateways)
+		if err != nil {
+			return options, fmt.Errorf("invalid gateways: %w", err)
+		}
+	}
+	return options, nil
+}
+
+// decodeUplink decodes the hex encoded payload with the options of the uplink.
+// Failed WiFi and indoor position lookups are logged and do not fail the decode.
+func decodeUplink(ctx context.Context, d decoder.Decoder, payload string, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	bytes, err := decoder.FromHex(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+	uplink, err := decoder.DecodeWithOptions(ctx, d, bytes, options)
+	if wifiErr := locateWifi(ctx, uplink); wifiErr != nil {
+		logger.Logger.Warn("failed to locate the access points", zap.Error(wifiErr), zap.String("devEui", options.DevEUI), zap.Uint8("port", options.Port))
+	}
+	if indoorErr := locateIndoor(ctx, uplink); indoorErr != nil {
+		logger.Logger.Warn("failed to locate the beacons", zap.Error(indoorErr), zap.String("devEui", options.DevEUI), zap.Uint8("port", options.Port))
+	}
+	return uplink, err
+}
+
+// wifiDatabase caches the local WiFi solver of the --wifi-database flag.
+var wifiDatabase struct {
+	sync.Mutex
+	path   string
+	solver *wifi.LocalSolver
+}
+
+// locateWifi resolves the position of the WiFi access points of the uplink
+// with the access point database of the --wifi-database flag, if any.
+func locateWifi(ctx context.Context, uplink *decoder.DecodedUplink) error {
+	if WifiDatabase == "" || uplink == nil {
+		return nil
+	}
+
+	wifiDatabase.Lock()
+	if wifiDatabase.solver == nil || wifiDatabase.path != WifiDatabase {
+		localSolver, err := wifi.OpenCSV(WifiDatabase)
+		if err != nil {
+			wifiDatabase.Unlock()
+			return err
+		}
+		wifiDatabase.path = WifiDatabase
+		wifiDatabase.solver = localSolver
+	}
+	wifiSolver := wifiDatabase.solver
+	wifiDatabase.Unlock()
+
+	err := solver.LocateWifi(ctx, wifiSolver, uplink)
+	if errors.Is(err, solver.ErrNoKnownAccessPoints) {
+		logger.Logger.Debug("no position for the access points", zap.Error(err))
+		return nil
+	}
+	return err
+}
+
+// beaconRegistry caches the locator of the --beacon-registry flag.
+var beaconRegistry struct {
+	sync.Mutex
+	path    string
+	locator *ble.Locator
+}
+
+// locateIndoor resolves the indoor position of the BLE beacons of the uplink
+// with the registry of the --beacon-registry flag, if any.
+func locateIndoor(ctx context.Context, uplink *decoder.DecodedUplink) error {
+	if BeaconRegistry == "" || uplink == nil {
+		return nil
+	}
+
+	beaconRegistry.Lock()
+	if beaconRegistry.locator == nil || beaconRegistry.path != BeaconRegistry {
+		locator, err := ble.OpenRegistry(BeaconRegistry)
+		if err != nil {
+			beaconRegistry.Unlock()
+			return err
+		}
+		beaconRegistry.path = BeaconRegistry
+		beaconRegistry.locator = locator
+	}
+	locator := beaconRegistry.locator
+	beaconRegistry.Unlock()
+
+	err := solver.LocateIndoor(ctx, locator, uplink)
+	if errors.Is(err, solver.ErrNoKnownBeacons) {
+		logger.Logger.Debug("no position for the beacons", zap.Error(err))
+		return nil
+	}
+	return err
+}
+
+// deviceOptions returns the options decoders are built with from the global flags.
+func deviceOptions(ctx context.Context, solver solver.SolverV1) decoder.DeviceOptions {
+	return decoder.DeviceOptions{
+		Context:        ctx,
+		Solver:         solver,
+		Logger:         logger.Logger,
+		SkipValidation: SkipValidation,
+		Lenient:        Lenient,
+	}
+}
+
+// newSolver creates the solver selected by the --solver flag. It exits if the
+// solver cannot be created.
+func newSolver(ctx context.Context) solver.SolverV1 {
+	switch strings.ToLower(Solver) {
+	case "aws":
+		solver, err := aws.NewAwsPositionEstimateClient(ctx, logger.Logger)
+		if err != nil {
+			logger.Logger.Error("error while creating AWS position estimate client", zap.Error(err))
+			os.Exit(1)
+		}
+		return solver
+	case "loracloud":
+		if LoracloudAccessToken == "" {
+			logger.Logger.Error("loracloud access token is required for loracloud solver")
+			os.Exit(1)
+		}
+		solver, err := loracloud.NewLoracloudClient(ctx, LoracloudAccessToken, logger.Logger)
+		if err != nil {
+			logger.Logger.Error("error while creating LoRa Cloud position estimate client", zap.Error(err))
+			os.Exit(1)
+		}
+		return solver
+	}
+	return nil
+}
+
+// deviceRegistry returns the registered devices and the devices described by the
+// schemas of --schema-dir.
+func deviceRegistry() (*decoder.Registry, error) {
+	registry := decoder.DefaultRegistry.Clone()
+	if SchemaDir != "" {
+		if err := registerSchemas(registry, SchemaDir); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+func TestUplinkOptions(t *testing.T) {
+	receivedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	fcnt := uint32(70000)
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected decoder.DecodeOptions
+		err      bool
+	}{
+		{name: "Unknown", args: []string{}, expected: decoder.DecodeOptions{Port: 1}},
+		{
+			name: "Given",
+			args: []string{"--fcnt", "70000", "--received-at", "2025-01-02T03:04:05Z", "--gateways", `[{"gatewayId":"0016c001ff10a235","rssi":-80,"snr":7.5}]`},
+			expected: decoder.DecodeOptions{
+				FCnt:       &fcnt,
+				Port:       1,
+				ReceivedAt: &receivedAt,
+				Gateways:   []decoder.GatewayMetadata{{GatewayID: "0016c001ff10a235", RSSI: -80, SNR: 7.5}},
+			},
+		},
+		{name: "ZeroFrameCounter", args: []string{"--fcnt", "0"}, expected: decoder.DecodeOptions{FCnt: new(uint32), Port: 1}},
+		{name: "InvalidReceivedAt", args: []string{"--received-at", "yesterday"}, err: true},
+		{name: "InvalidGateways", args: []string{"--gateways", "0016c001ff10a235"}, err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				uplinkFCnt, uplinkReceivedAt, uplinkGateways = 0, "", ""
+			}()
+
+			cmd := deviceCmd(decoder.Device{Name: "test", Version: "v1"})
+			if err := cmd.ParseFlags(test.args); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			options, err := uplinkOptions(cmd, "", 1)
+			if test.err {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(options, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, options)
+			}
+		})
+	}
+}
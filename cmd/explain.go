@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/truvami/decoder/internal/logger"
+	"github.com/truvami/decoder/pkg/decoder"
+	"go.uber.org/zap"
+)
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+}
+
+var explainCmd = &cobra.Command{
+	Use:   "explain [device] [port] [payload]",
+	Short: "show which bytes of a payload map to which fields",
+	Long: `Show which bytes of a payload map to which fields.
+
+For every field the byte range, the raw hex and integer value, the decoded value
+and the validation outcome are printed. The device is a registered device like tagsl
+or a device of --schema-dir, optionally followed by its version.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		if cmd != nil {
+			ctx = cmd.Context()
+		}
+
+		registry, err := deviceRegistry()
+		if err != nil {
+			logger.Logger.Error("error while loading schemas", zap.Error(err), zap.String("dir", SchemaDir))
+			return
+		}
+
+		d, err := registry.New(args[0], decoder.DeviceOptions{Context: ctx, Logger: logger.Logger})
+		if err != nil {
+			logger.Logger.Error("error while selecting decoder", zap.Error(err), zap.String("device", args[0]))
+			return
+		}
+
+		explainer, ok := d.(decoder.Explainer)
+		if !ok {
+			logger.Logger.Error("decoder does not support explain", zap.String("device", args[0]))
+			return
+		}
+
+		port, err := strconv.Atoi(args[1])
+		if err != nil {
+			logger.Logger.Error("error while parsing port", zap.Error(err), zap.String("port", args[1]))
+			return
+		}
+		if port < 0 || port > 255 {
+			logger.Logger.Error("port must be between 0 and 255", zap.Int("port", port))
+			return
+		}
+
+		payload, err := decoder.FromHex(args[2])
+		if err != nil {
+			logger.Logger.Error("error while parsing payload", zap.Error(err), zap.String("payload", args[2]))
+			return
+		}
+
+		explanations, err := explainer.Explain(ctx, payload, uint8(port))
+		if err != nil {
+			if explanations == nil {
+				logger.Logger.Error("error while explaining payload", zap.Error(err))
+				return
+			}
+			logger.Logger.Warn("payload could only be explained in part", zap.Error(err))
+		}
+
+		if Json {
+			printJSON(explanations)
+			return
+		}
+		printExplanations(explanations)
+	},
+}
+
+func printExplanations(explanations []decoder.FieldExplanation) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Println()
+	_, _ = fmt.Fprintln(w, "BYTES\tBITS\tFIELD\tHEX\tINTEGER\tVALUE\tVALIDATION")
+	for _, e := range explanations {
+		bytes := strconv.Itoa(e.Start)
+		if e.Length > 1 {
+			bytes = fmt.Sprintf("%d-%d", e.Start, e.Start+e.Length-1)
+		}
+		if e.Tag != "" {
+			bytes = e.Tag + " " + bytes
+		}
+
+		bits := ""
+		if e.BitLength == 1 {
+			bits = strconv.Itoa(e.BitOffset)
+		} else if e.BitLength > 1 {
+			bits = fmt.Sprintf("%d-%d", e.BitOffset, e.BitOffset+e.BitLength-1)
+		}
+
+		integer := ""
+		if e.Integer != nil {
+			integer = strconv.FormatUint(*e.Integer, 10)
+		}
+
+		value := ""
+		if e.Value != nil {
+			value = fmt.Sprint(e.Value)
+		}
+
+		validation := "ok"
+		switch {
+		case e.Missing && e.Valid:
+			validation = "not present"
+		case e.Error != "":
+			validation = e.Error
+		}
+
+		_, _ = fmt.Fprintln(w, strings.Join([]string{bytes, bits, e.Name, e.Hex, integer, value, validation}, "\t"))
+	}
+	_ = w.Flush()
+	fmt.Println()
+}
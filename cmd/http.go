@@ -7,7 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
-	"strings"
+	"reflect"
 	"time"
 
 	"github.com/go-playground/validator"
@@ -17,18 +17,7 @@ import (
 	"github.com/truvami/decoder/internal/logger"
 	helpers "github.com/truvami/decoder/pkg/common"
 	"github.com/truvami/decoder/pkg/decoder"
-	nomadxlDecoder "github.com/truvami/decoder/pkg/decoder/nomadxl/v1"
-	nomadxsDecoder "github.com/truvami/decoder/pkg/decoder/nomadxs/v1"
-	smartlabelDecoder "github.com/truvami/decoder/pkg/decoder/smartlabel/v1"
-	tagslDecoder "github.com/truvami/decoder/pkg/decoder/tagsl/v1"
-	tagxlDecoder "github.com/truvami/decoder/pkg/decoder/tagxl/v1"
 	"github.com/truvami/decoder/pkg/encoder"
-	nomadxsEncoder "github.com/truvami/decoder/pkg/encoder/nomadxs/v1"
-	smartlabelEncoder "github.com/truvami/decoder/pkg/encoder/smartlabel/v1"
-	tagslEncoder "github.com/truvami/decoder/pkg/encoder/tagsl/v1"
-	"github.com/truvami/decoder/pkg/solver"
-	"github.com/truvami/decoder/pkg/solver/aws"
-	"github.com/truvami/decoder/pkg/solver/loracloud"
 	"go.uber.org/zap"
 )
 
@@ -68,67 +57,33 @@ var httpCmd = &cobra.Command{
 			router.Handle("/metrics", promhttp.Handler())
 		}
 
-		type decoderEndpoint struct {
-			path    string
-			decoder decoder.Decoder
-		}
-
 		ctx := context.Background()
 		if cmd != nil {
 			ctx = cmd.Context()
 		}
 
-		var solver solver.SolverV1
-		var err error
+		registry, err := deviceRegistry()
+		if err != nil {
+			logger.Logger.Error("error while loading schemas", zap.Error(err), zap.String("dir", SchemaDir))
+			os.Exit(1)
+		}
 
-		switch strings.ToLower(Solver) {
-		case "aws":
-			solver, err = aws.NewAwsPositionEstimateClient(ctx, logger.Logger)
-			if err != nil {
-				logger.Logger.Error("error while creating AWS position estimate client", zap.Error(err))
-				os.Exit(1)
-			}
-		case "loracloud":
-			if LoracloudAccessToken == "" {
-				logger.Logger.Error("loracloud access token is required for loracloud solver")
-				os.Exit(1)
-			}
-			solver, err = loracloud.NewLoracloudClient(ctx, LoracloudAccessToken, logger.Logger)
+		// add the decoders and encoders of all devices
+		options := deviceOptions(ctx, newSolver(ctx))
+		for _, device := range registry.Devices() {
+			d, err := registry.New(device.Path(), options)
 			if err != nil {
-				logger.Logger.Error("error while creating LoRa Cloud position estimate client", zap.Error(err))
+				logger.Logger.Error("error while initializing decoder", zap.Error(err), zap.String("device", device.Path()))
 				os.Exit(1)
 			}
-		}
-
-		var decoders = []decoderEndpoint{
-			{"tagsl/v1", tagslDecoder.NewTagSLv1Decoder(tagslDecoder.WithSkipValidation(SkipValidation))},
-			{"tagxl/v1", tagxlDecoder.NewTagXLv1Decoder(ctx, solver, logger.Logger, tagxlDecoder.WithSkipValidation(SkipValidation))},
-			{"nomadxs/v1", nomadxsDecoder.NewNomadXSv1Decoder(nomadxsDecoder.WithSkipValidation(SkipValidation))},
-			{"nomadxl/v1", nomadxlDecoder.NewNomadXLv1Decoder(nomadxlDecoder.WithSkipValidation(SkipValidation))},
-			{"smartlabel/v1", smartlabelDecoder.NewSmartLabelv1Decoder(ctx, solver, logger.Logger, smartlabelDecoder.WithSkipValidation(SkipValidation))},
-		}
-
-		// add the decoders
-		for _, d := range decoders {
-			addDecoder(ctx, router, d.path, d.decoder)
-		}
-
-		// Define encoder endpoints
-		type encoderEndpoint struct {
-			path    string
-			encoder encoder.Encoder
-		}
-
-		var encoders = []encoderEndpoint{
-			{"encode/tagsl/v1", tagslEncoder.NewTagSLv1Encoder()},
-			{"encode/nomadxs/v1", nomadxsEncoder.NewNomadXSv1Encoder()},
-			{"encode/smartlabel/v1", smartlabelEncoder.NewSmartlabelv1Encoder()},
-		}
+			addDecoder(ctx, router, device.Path(), d)
+			router.HandleFunc("GET /jsonschema/"+device.Path(), getJSONSchemaHandler(registry, device.Path()))
 
-		// add the encoders
-		for _, e := range encoders {
-			addEncoder(router, e.path, e.encoder)
+			if device.NewEncoder != nil {
+				addEncoder(router, "encode/"+device.Path(), device.NewEncoder())
+			}
 		}
+		router.HandleFunc("GET /devices", getDevicesHandler(registry))
 
 		// middleware
 		handler := loggingMiddleware(logger.Logger, router)
@@ -143,17 +98,85 @@ var httpCmd = &cobra.Command{
 	},
 }
 
-func addDecoder(ctx context.Context, router *http.ServeMux, path string, decoder decoder.Decoder) {
+func addDecoder(ctx context.Context, router *http.ServeMux, path string, d decoder.Decoder) {
 	logger.Logger.Debug("adding decoder", zap.String("path", path))
-	router.HandleFunc("POST /"+path, getHandler(ctx, decoder))
+	router.HandleFunc("POST /"+path, getHandler(ctx, d))
+
+	if explainer, ok := d.(decoder.Explainer); ok {
+		router.HandleFunc("POST /explain/"+path, getExplainHandler(ctx, explainer))
+	}
+}
+
+// getDevicesHandler lists the devices of the registry with the payloads of their ports.
+func getDevicesHandler(registry *decoder.Registry) func(http.ResponseWriter, *http.Request) {
+	type device struct {
+		Name        string             `json:"name"`
+		Version     string             `json:"version"`
+		Description string             `json:"description"`
+		Features    []decoder.Feature  `json:"features"`
+		Solver      bool               `json:"solver"`
+		Encoder     bool               `json:"encoder"`
+		Ports       []decoder.PortInfo `json:"ports"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		devices := []device{}
+		for _, d := range registry.Devices() {
+			ports, err := registry.Ports(d.Path())
+			if err != nil {
+				logger.Logger.Error("error while describing ports", zap.Error(err), zap.String("device", d.Path()))
+				setBody(w, http.StatusInternalServerError, map[string]any{
+					"error": err.Error(),
+					"docs":  "https://docs.truvami.com",
+				})
+				return
+			}
+
+			devices = append(devices, device{
+				Name:        d.Name,
+				Version:     d.Version,
+				Description: d.Description,
+				Features:    d.Features,
+				Solver:      d.Solver,
+				Encoder:     d.NewEncoder != nil,
+				Ports:       ports,
+			})
+		}
+
+		setBody(w, http.StatusOK, map[string]any{
+			"data": devices,
+		})
+	}
+}
+
+// getJSONSchemaHandler returns the JSON Schema of the payloads of the device.
+func getJSONSchemaHandler(registry *decoder.Registry, path string) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		schema, err := registry.JSONSchema(path)
+		if err != nil {
+			logger.Logger.Error("error while building JSON schema", zap.Error(err), zap.String("device", path))
+			setBody(w, http.StatusInternalServerError, map[string]any{
+				"error": err.Error(),
+				"docs":  "https://docs.truvami.com",
+			})
+			return
+		}
+
+		setBody(w, http.StatusOK, map[string]any{
+			"data": schema,
+		})
+	}
 }
 
 func getHandler(ctx context.Context, targetDecoder decoder.Decoder) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		type request struct {
-			Port    uint8  `json:"port" validate:"required,gt=0,lte=255"`
-			Payload string `json:"payload" validate:"required,hexadecimal"`
-			DevEUI  string `json:"devEui" validate:"omitempty,hexadecimal,len=16"`
+			Port       uint8                     `json:"port" validate:"required,gt=0,lte=255"`
+			Payload    string                    `json:"payload" validate:"required,hexadecimal"`
+			DevEUI     string                    `json:"devEui" validate:"omitempty,hexadecimal,len=16"`
+			FCnt       *uint32                   `json:"fcnt"`
+			ReceivedAt *time.Time                `json:"receivedAt"`
+			Gateways   []decoder.GatewayMetadata `json:"gateways"`
 		}
 
 		// decode the request
@@ -180,41 +203,135 @@ func getHandler(ctx context.Context, targetDecoder decoder.Decoder) func(http.Re
 			return
 		}
 
-		logger.Logger.Debug("set context values",
+		profile := outputProfile()
+		if r.URL.Query().Has("profile") {
+			profile, err = decoder.ParseOutputProfile(r.URL.Query().Get("profile"))
+			if err != nil {
+				logger.Logger.Error("invalid output profile", zap.Error(err))
+				setBody(w, http.StatusBadRequest, map[string]any{
+					"error": err.Error(),
+					"docs":  "https://docs.truvami.com",
+				})
+				return
+			}
+		}
+
+		logger.Logger.Debug("decoding payload",
 			zap.String("devEui", req.DevEUI),
 			zap.Uint8("port", req.Port),
 			zap.String("payload", req.Payload),
 		)
-		ctx = context.WithValue(ctx, decoder.DEVEUI_CONTEXT_KEY, req.DevEUI)
-		ctx = context.WithValue(ctx, decoder.PORT_CONTEXT_KEY, req.Port)
-		ctx = context.WithValue(ctx, decoder.FCNT_CONTEXT_KEY, 1) // Default frame count, can be adjusted as needed
-
-		logger.Logger.Debug("decoding payload")
 
 		var warnings []string = nil
-		data, err := targetDecoder.Decode(ctx, req.Payload, req.Port)
+		var missingFields []helpers.FieldError = nil
+		data, err := decodeUplink(ctx, targetDecoder, req.Payload, decoder.DecodeOptions{
+			DevEUI:     req.DevEUI,
+			FCnt:       req.FCnt,
+			Port:       req.Port,
+			ReceivedAt: req.ReceivedAt,
+			Gateways:   req.Gateways,
+		})
 		if err != nil {
-			if errors.Is(err, helpers.ErrValidationFailed) {
+			var partial *helpers.PartialDecodeError
+			if errors.Is(err, helpers.ErrValidationFailed) || errors.As(err, &partial) {
 				warnings = []string{}
 				for _, err := range helpers.UnwrapError(err) {
 					logger.Logger.Warn("validation error", zap.Error(err), zap.String("devEui", req.DevEUI), zap.Uint8("port", req.Port))
 					warnings = append(warnings, err.Error())
 				}
-				logger.Logger.Warn("validation for some fields failed - are you using the correct port?")
+				if errors.Is(err, helpers.ErrValidationFailed) {
+					logger.Logger.Warn("validation for some fields failed - are you using the correct port?")
+				}
+				if partial != nil {
+					logger.Logger.Warn("payload is truncated - some fields could not be decoded", zap.Int("missing", len(partial.Fields)))
+					missingFields = partial.Fields
+				}
 			} else {
 				logger.Logger.Error("error while decoding payload", zap.Error(err), zap.String("devEui", req.DevEUI), zap.Uint8("port", req.Port))
 
+				body := map[string]any{
+					"error": err.Error(),
+					"docs":  "https://docs.truvami.com",
+				}
+				var decodeError *helpers.DecodeError
+				if errors.As(err, &decodeError) {
+					body["details"] = decodeError
+				}
+				setBody(w, http.StatusBadRequest, body)
+				return
+			}
+		}
+
+		logger.Logger.Info("payload decoded successfully", zap.String("devEui", req.DevEUI), zap.Uint8("port", req.Port))
+		body := map[string]any{
+			"data":     outputData(data.Data, profile),
+			"metadata": data.Metadata,
+			"warnings": warnings,
+		}
+		if missingFields != nil {
+			body["missingFields"] = missingFields
+		}
+		setBody(w, http.StatusOK, body)
+	}
+}
+
+func getExplainHandler(ctx context.Context, explainer decoder.Explainer) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		type request struct {
+			Port    uint8  `json:"port" validate:"required,gt=0,lte=255"`
+			Payload string `json:"payload" validate:"required,hexadecimal"`
+		}
+
+		var req request
+
+		logger.Logger.Debug("decoding request")
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			logger.Logger.Error("error while decoding request", zap.Error(err))
+
+			setBody(w, http.StatusBadRequest, map[string]any{
+				"error": err.Error(),
+				"docs":  "https://docs.truvami.com",
+			})
+			return
+		}
+
+		if err := validator.New().Struct(req); err != nil {
+			logger.Logger.Error("request validation failed", zap.Error(err))
+			setBody(w, http.StatusBadRequest, map[string]any{
+				"error": "request validation failed",
+				"docs":  "https://docs.truvami.com",
+			})
+			return
+		}
+
+		payload, err := decoder.FromHex(req.Payload)
+		if err != nil {
+			logger.Logger.Error("error while parsing payload", zap.Error(err))
+			setBody(w, http.StatusBadRequest, map[string]any{
+				"error": err.Error(),
+				"docs":  "https://docs.truvami.com",
+			})
+			return
+		}
+
+		var warnings []string = nil
+		explanations, err := explainer.Explain(ctx, payload, req.Port)
+		if err != nil {
+			if explanations == nil {
+				logger.Logger.Error("error while explaining payload", zap.Error(err), zap.Uint8("port", req.Port))
 				setBody(w, http.StatusBadRequest, map[string]any{
 					"error": err.Error(),
 					"docs":  "https://docs.truvami.com",
 				})
 				return
 			}
+			logger.Logger.Warn("payload could only be explained in part", zap.Error(err), zap.Uint8("port", req.Port))
+			warnings = []string{err.Error()}
 		}
 
-		logger.Logger.Info("payload decoded successfully", zap.String("devEui", req.DevEUI), zap.Uint8("port", req.Port))
 		setBody(w, http.StatusOK, map[string]any{
-			"data":     data.Data,
+			"data":     explanations,
 			"warnings": warnings,
 		})
 	}
@@ -225,7 +342,7 @@ func addEncoder(router *http.ServeMux, path string, encoder encoder.Encoder) {
 	router.HandleFunc("POST /"+path, getEncoderHandler(encoder))
 }
 
-func getEncoderHandler(encoder encoder.Encoder) func(http.ResponseWriter, *http.Request) {
+func getEncoderHandler(e encoder.Encoder) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// First, decode the request to get the port and raw payload
 		var rawReq struct {
@@ -255,91 +372,10 @@ func getEncoderHandler(encoder encoder.Encoder) func(http.ResponseWriter, *http.
 			return
 		}
 
-		// Now we have the raw payload and port, we can determine the correct struct type
-		// and unmarshal the payload into it
-		var structPayload any
-
-		// This is a simplified example - in a real implementation, you would have a more
-		// comprehensive mapping of device types and ports to struct types
-		switch r.URL.Path {
-		case "/encode/smartlabel/v1":
-			switch rawReq.Port {
-			case 128:
-				var payload smartlabelEncoder.Port128Payload
-				if err := json.Unmarshal(rawReq.Payload, &payload); err != nil {
-					logger.Logger.Error("error unmarshaling payload", zap.Error(err))
-					setBody(w, http.StatusBadRequest, map[string]any{
-						"error": fmt.Sprintf("Error unmarshaling payload: %v", err),
-						"docs":  "https://docs.truvami.com",
-					})
-					return
-				}
-				structPayload = payload
-			default:
-				logger.Logger.Error("unsupported port", zap.Uint8("port", rawReq.Port))
-				setBody(w, http.StatusBadRequest, map[string]any{
-					"error": fmt.Sprintf("Unsupported port: %d", rawReq.Port),
-					"docs":  "https://docs.truvami.com",
-				})
-				return
-			}
-		case "/encode/tagsl/v1":
-			switch rawReq.Port {
-			case 128:
-				var payload tagslEncoder.Port128Payload
-				if err := json.Unmarshal(rawReq.Payload, &payload); err != nil {
-					logger.Logger.Error("error unmarshaling payload", zap.Error(err))
-					setBody(w, http.StatusBadRequest, map[string]any{
-						"error": fmt.Sprintf("Error unmarshaling payload: %v", err),
-						"docs":  "https://docs.truvami.com/docs/payloads/tag%20S/v3.2.0/",
-					})
-					return
-				}
-				structPayload = payload
-			case 129:
-				var payload tagslEncoder.Port129Payload
-				if err := json.Unmarshal(rawReq.Payload, &payload); err != nil {
-					logger.Logger.Error("error unmarshaling payload", zap.Error(err))
-					setBody(w, http.StatusBadRequest, map[string]any{
-						"error": fmt.Sprintf("Error unmarshaling payload: %v", err),
-						"docs":  "https://docs.truvami.com/docs/payloads/tag%20S/v3.2.0/",
-					})
-					return
-				}
-				structPayload = payload
-			case 131:
-				var payload tagslEncoder.Port131Payload
-				if err := json.Unmarshal(rawReq.Payload, &payload); err != nil {
-					logger.Logger.Error("error unmarshaling payload", zap.Error(err))
-					setBody(w, http.StatusBadRequest, map[string]any{
-						"error": fmt.Sprintf("Error unmarshaling payload: %v", err),
-						"docs":  "https://docs.truvami.com/docs/payloads/tag%20S/v3.2.0/",
-					})
-					return
-				}
-				structPayload = payload
-			case 134:
-				var payload tagslEncoder.Port134Payload
-				if err := json.Unmarshal(rawReq.Payload, &payload); err != nil {
-					logger.Logger.Error("error unmarshaling payload", zap.Error(err))
-					setBody(w, http.StatusBadRequest, map[string]any{
-						"error": fmt.Sprintf("Error unmarshaling payload: %v", err),
-						"docs":  "https://docs.truvami.com/docs/payloads/tag%20S/v3.2.0/",
-					})
-					return
-				}
-				structPayload = payload
-			default:
-				logger.Logger.Error("unsupported port", zap.Uint8("port", rawReq.Port))
-				setBody(w, http.StatusBadRequest, map[string]any{
-					"error": fmt.Sprintf("Unsupported port: %d", rawReq.Port),
-					"docs":  "https://docs.truvami.com/docs/payloads/tag%20S/v3.2.0/",
-				})
-				return
-			}
-		default:
-			// For other device types, you would add similar switch statements
-			logger.Logger.Error("unsupported device type", zap.String("path", r.URL.Path))
+		// unmarshal the payload into the struct the encoder expects for the port
+		typer, ok := e.(encoder.PayloadTyper)
+		if !ok {
+			logger.Logger.Error("encoder does not support requests", zap.String("path", r.URL.Path))
 			setBody(w, http.StatusBadRequest, map[string]any{
 				"error": "Unsupported device type",
 				"docs":  "https://docs.truvami.com",
@@ -347,10 +383,31 @@ func getEncoderHandler(encoder encoder.Encoder) func(http.ResponseWriter, *http.
 			return
 		}
 
+		payloadType, err := typer.PayloadType(rawReq.Port)
+		if err != nil {
+			logger.Logger.Error("unsupported port", zap.Uint8("port", rawReq.Port))
+			setBody(w, http.StatusBadRequest, map[string]any{
+				"error": fmt.Sprintf("Unsupported port: %d", rawReq.Port),
+				"docs":  "https://docs.truvami.com",
+			})
+			return
+		}
+
+		payload := reflect.New(payloadType)
+		if err := json.Unmarshal(rawReq.Payload, payload.Interface()); err != nil {
+			logger.Logger.Error("error unmarshaling payload", zap.Error(err))
+			setBody(w, http.StatusBadRequest, map[string]any{
+				"error": fmt.Sprintf("Error unmarshaling payload: %v", err),
+				"docs":  "https://docs.truvami.com",
+			})
+			return
+		}
+		structPayload := payload.Elem().Interface()
+
 		logger.Logger.Debug("encoding payload", zap.Any("payload", structPayload), zap.Uint8("port", rawReq.Port))
 
 		var warnings []string = nil
-		encoded, err := encoder.Encode(structPayload, rawReq.Port)
+		encoded, err := e.Encode(structPayload, rawReq.Port)
 		if err != nil {
 			if errors.Is(err, helpers.ErrValidationFailed) {
 				warnings = []string{}
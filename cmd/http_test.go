@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -15,6 +18,7 @@ import (
 
 	"github.com/truvami/decoder/internal/logger"
 	"github.com/truvami/decoder/pkg/common"
+	decoderPkg "github.com/truvami/decoder/pkg/decoder"
 	tagslDecoder "github.com/truvami/decoder/pkg/decoder/tagsl/v1"
 	tagslEncoder "github.com/truvami/decoder/pkg/encoder/tagsl/v1"
 )
@@ -36,6 +40,11 @@ func TestAddDecoder(t *testing.T) {
 	if pattern != "POST /test/path" {
 		t.Errorf("expected pattern to be 'POST /test/path', got '%s'", pattern)
 	}
+
+	_, pattern = router.Handler(&http.Request{Method: "POST", URL: &url.URL{Path: "/explain/test/path"}})
+	if pattern != "POST /explain/test/path" {
+		t.Errorf("expected pattern to be 'POST /explain/test/path', got '%s'", pattern)
+	}
 }
 
 func TestGetHandler(t *testing.T) {
@@ -114,6 +123,571 @@ func TestGetHandler(t *testing.T) {
 	}
 }
 
+func TestGetHandlerLenient(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	decoder := tagslDecoder.NewTagSLv1Decoder(tagslDecoder.WithLenient(true))
+	handler := getHandler(context.TODO(), decoder)
+
+	reqBody := `{"port": 1, "payload": "8002cdcd1300744f5e166018040b14", "devEui": ""}`
+	req, err := http.NewRequest("POST", "/test/path", strings.NewReader(reqBody))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, req)
+
+	resp := recorder.Result()
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var body struct {
+		Data          map[string]any      `json:"data"`
+		Warnings      []string            `json:"warnings"`
+		MissingFields []common.FieldError `json:"missingFields"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response body: %v", err)
+	}
+
+	if body.Data["latitude"] != 47.041811 {
+		t.Errorf("expected latitude 47.041811, got %v", body.Data["latitude"])
+	}
+
+	expected := []common.FieldError{
+		{Name: "Minute", Offset: 15, Length: 1, Available: 0},
+		{Name: "Second", Offset: 16, Length: 1, Available: 0},
+	}
+	if !reflect.DeepEqual(body.MissingFields, expected) {
+		t.Errorf("expected missing fields %v, got %v", expected, body.MissingFields)
+	}
+	if len(body.Warnings) != 1 {
+		t.Errorf("expected a single warning, got %v", body.Warnings)
+	}
+}
+
+func TestGetHandlerMetadata(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	handler := getHandler(context.TODO(), tagslDecoder.NewTagSLv1Decoder())
+
+	receivedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	fcnt := uint32(70000)
+
+	tests := []struct {
+		name     string
+		metadata string
+		fcnt     *uint32
+		received *time.Time
+		gateways []decoderPkg.GatewayMetadata
+	}{
+		{name: "Unknown"},
+		{
+			name:     "Given",
+			metadata: `, "fcnt": 70000, "receivedAt": "2025-01-02T03:04:05Z", "gateways": [{"gatewayId": "0016c001ff10a235", "rssi": -80, "snr": 7.5}]`,
+			fcnt:     &fcnt,
+			received: &receivedAt,
+			gateways: []decoderPkg.GatewayMetadata{{GatewayID: "0016c001ff10a235", RSSI: -80, SNR: 7.5}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reqBody := `{"port": 1, "payload": "8002cdcd1300744f5e166018040b14341a", "devEui": "0011223344556677"` + test.metadata + `}`
+			req, err := http.NewRequest("POST", "/test/path", strings.NewReader(reqBody))
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, req)
+
+			resp := recorder.Result()
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+
+			var body struct {
+				Metadata decoderPkg.Metadata `json:"metadata"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+
+			metadata := body.Metadata
+			if metadata.Port != 1 || metadata.DeviceType != "tagsl" || metadata.DecoderVersion != "v1" {
+				t.Errorf("unexpected metadata %+v", metadata)
+			}
+			if metadata.Payload != "8002cdcd1300744f5e166018040b14341a" || metadata.DevEUI != "0011223344556677" {
+				t.Errorf("unexpected metadata %+v", metadata)
+			}
+			if !reflect.DeepEqual(metadata.FrameCounter, test.fcnt) {
+				t.Errorf("expected frame counter %v, got %v", test.fcnt, metadata.FrameCounter)
+			}
+			if !reflect.DeepEqual(metadata.ReceivedAt, test.received) {
+				t.Errorf("expected receive time %v, got %v", test.received, metadata.ReceivedAt)
+			}
+			if !reflect.DeepEqual(metadata.Gateways, test.gateways) {
+				t.Errorf("expected gateways %+v, got %+v", test.gateways, metadata.Gateways)
+			}
+		})
+	}
+}
+
+func TestGetHandlerWifiPosition(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	path := filepath.Join(t.TempDir(), "access-points.csv")
+	err := os.WriteFile(path, []byte("mac,latitude,longitude,accuracy\n8c:59:c3:c9:9f:c0,47.0418,8.3093,15\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	WifiDatabase = path
+	defer func() { WifiDatabase = "" }()
+
+	handler := getHandler(context.TODO(), tagslDecoder.NewTagSLv1Decoder())
+
+	tests := []struct {
+		payload  string
+		expected *decoderPkg.WifiPosition
+	}{
+		{payload: "808c59c3c99fc0ad", expected: &decoderPkg.WifiPosition{Latitude: 47.0418, Longitude: 8.3093, Accuracy: 15, AccessPoints: 1}},
+		{payload: "80e0286d8a2742a1", expected: nil},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest("POST", "/test/path", strings.NewReader(`{"port": 5, "payload": "`+test.payload+`"}`))
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+
+		recorder := httptest.NewRecorder()
+		handler(recorder, req)
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, recorder.Code)
+		}
+
+		var body struct {
+			Metadata decoderPkg.Metadata `json:"metadata"`
+		}
+		if err := json.NewDecoder(recorder.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode response body: %v", err)
+		}
+		if !reflect.DeepEqual(body.Metadata.WifiPosition, test.expected) {
+			t.Errorf("expected position %+v, got %+v", test.expected, body.Metadata.WifiPosition)
+		}
+	}
+}
+
+func TestGetHandlerIndoorPosition(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	path := filepath.Join(t.TempDir(), "beacons.csv")
+	err := os.WriteFile(path, []byte("mac,latitude,longitude,floor,zone\nf052fab920fe,47.0418,8.3093,1,kitchen\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	floor := 1
+	tests := []struct {
+		registry string
+		payload  string
+		expected *decoderPkg.IndoorPosition
+	}{
+		{registry: path, payload: "01eb0101f052fab920feac", expected: &decoderPkg.IndoorPosition{Latitude: 47.0418, Longitude: 8.3093, Accuracy: 10, Floor: &floor, Zone: "kitchen", Beacons: 1}},
+		{registry: path, payload: "01eb0101d0e4158b38b9af", expected: nil},
+		{registry: filepath.Join(t.TempDir(), "missing.csv"), payload: "01eb0101f052fab920feac", expected: nil},
+	}
+
+	handler := getHandler(context.TODO(), tagslDecoder.NewTagSLv1Decoder())
+	defer func() { BeaconRegistry = "" }()
+
+	for _, test := range tests {
+		BeaconRegistry = test.registry
+
+		req, err := http.NewRequest("POST", "/test/path", strings.NewReader(`{"port": 3, "payload": "`+test.payload+`"}`))
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+
+		recorder := httptest.NewRecorder()
+		handler(recorder, req)
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, recorder.Code)
+		}
+
+		var body struct {
+			Metadata decoderPkg.Metadata `json:"metadata"`
+		}
+		if err := json.NewDecoder(recorder.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode response body: %v", err)
+		}
+		if !reflect.DeepEqual(body.Metadata.IndoorPosition, test.expected) {
+			t.Errorf("expected position %+v, got %+v", test.expected, body.Metadata.IndoorPosition)
+		}
+	}
+}
+
+func TestGetHandlerWifiDatabaseMissing(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	WifiDatabase = filepath.Join(t.TempDir(), "missing.csv")
+	defer func() { WifiDatabase = "" }()
+
+	handler := getHandler(context.TODO(), tagslDecoder.NewTagSLv1Decoder())
+
+	req, err := http.NewRequest("POST", "/test/path", strings.NewReader(`{"port": 5, "payload": "808c59c3c99fc0ad"}`))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, req)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, recorder.Code)
+	}
+
+	var body struct {
+		Metadata decoderPkg.Metadata `json:"metadata"`
+	}
+	if err := json.NewDecoder(recorder.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response body: %v", err)
+	}
+	if body.Metadata.WifiPosition != nil {
+		t.Errorf("expected no position, got %+v", body.Metadata.WifiPosition)
+	}
+}
+
+func TestGetHandlerProfile(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	tests := []struct {
+		query    string
+		status   int
+		expected any
+	}{
+		{query: "", status: http.StatusOK, expected: "438.9m"},
+		{query: "?profile=display", status: http.StatusOK, expected: "438.9m"},
+		{query: "?profile=machine", status: http.StatusOK, expected: 438.9},
+		{query: "?profile=fancy", status: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			handler := getHandler(context.TODO(), tagslDecoder.NewTagSLv1Decoder())
+
+			reqBody := `{"port": 10, "payload": "0002d30b070082491f11256718d9fe0ede190505", "devEui": ""}`
+			req, err := http.NewRequest("POST", "/test/path"+test.query, strings.NewReader(reqBody))
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, req)
+
+			resp := recorder.Result()
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != test.status {
+				t.Fatalf("expected status code %d, got %d", test.status, resp.StatusCode)
+			}
+			if test.status != http.StatusOK {
+				return
+			}
+
+			var body struct {
+				Data map[string]any `json:"data"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+			if body.Data["altitude"] != test.expected {
+				t.Errorf("expected altitude %v, got %v", test.expected, body.Data["altitude"])
+			}
+		})
+	}
+}
+
+func TestGetHandlerNumberedFields(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	tests := []struct {
+		numbered bool
+		expected []string
+	}{
+		{numbered: false, expected: []string{"accessPoints"}},
+		{numbered: true, expected: []string{"mac1", "rssi1", "mac2", "rssi2"}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("Numbered%t", test.numbered), func(t *testing.T) {
+			NumberedFields = test.numbered
+			defer func() { NumberedFields = false }()
+
+			handler := getHandler(context.TODO(), tagslDecoder.NewTagSLv1Decoder())
+
+			reqBody := `{"port": 5, "payload": "00e0286d8aabfca8e0286d8a9478c2", "devEui": ""}`
+			req, err := http.NewRequest("POST", "/test/path", strings.NewReader(reqBody))
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, req)
+
+			resp := recorder.Result()
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+
+			var body struct {
+				Data map[string]any `json:"data"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+
+			for _, key := range test.expected {
+				if _, ok := body.Data[key]; !ok {
+					t.Errorf("expected key %s in %v", key, body.Data)
+				}
+			}
+			if _, ok := body.Data["accessPoints"]; ok == test.numbered {
+				t.Errorf("unexpected access points in %v", body.Data)
+			}
+		})
+	}
+}
+
+func TestGetHandlerDecodeError(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	decoder := tagslDecoder.NewTagSLv1Decoder()
+	handler := getHandler(context.TODO(), decoder)
+
+	reqBody := `{"port": 1, "payload": "8002cdcd1300744f5e1660", "devEui": ""}`
+	req, err := http.NewRequest("POST", "/test/path", strings.NewReader(reqBody))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, req)
+
+	resp := recorder.Result()
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status code %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+
+	var body struct {
+		Error   string         `json:"error"`
+		Details map[string]any `json:"details"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response body: %v", err)
+	}
+
+	expected := map[string]any{
+		"error":          body.Error,
+		"device":         "tagsl/v1",
+		"port":           float64(1),
+		"offset":         float64(11),
+		"expectedLength": float64(17),
+		"actualLength":   float64(11),
+		"raw":            "cd1300744f5e1660",
+		"rawOffset":      float64(3),
+	}
+	if !reflect.DeepEqual(body.Details, expected) {
+		t.Errorf("expected details %v, got %v", expected, body.Details)
+	}
+}
+
+func TestGetExplainHandler(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	decoder := tagslDecoder.NewTagSLv1Decoder()
+	handler := getExplainHandler(context.TODO(), decoder.(decoderPkg.Explainer))
+
+	tests := []struct {
+		body   string
+		status int
+		fields int
+	}{
+		{
+			body:   `{"port": 1, "payload": "8002cdcd1300744f5e166018040b14341a"}`,
+			status: http.StatusOK,
+			fields: 13,
+		},
+		{
+			body:   `{"port": 1, "payload": "8002cdcd1300744f5e1660"}`,
+			status: http.StatusOK,
+			fields: 13,
+		},
+		{
+			body:   `{"port": 42, "payload": "8002cdcd1300744f5e166018040b14341a"}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			body:   `{"port": 1, "payload": "xyz"}`,
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.body, func(t *testing.T) {
+			req, err := http.NewRequest("POST", "/explain/test/path", strings.NewReader(test.body))
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, req)
+
+			resp := recorder.Result()
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != test.status {
+				t.Errorf("expected status code %d, got %d", test.status, resp.StatusCode)
+			}
+
+			var body struct {
+				Data []decoderPkg.FieldExplanation `json:"data"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+			if len(body.Data) != test.fields {
+				t.Errorf("expected %d fields, got %d", test.fields, len(body.Data))
+			}
+		})
+	}
+}
+
+func TestGetDevicesHandler(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	handler := getDevicesHandler(decoderPkg.DefaultRegistry)
+
+	req, err := http.NewRequest("GET", "/devices", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, req)
+
+	resp := recorder.Result()
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var body struct {
+		Data []struct {
+			Name    string                `json:"name"`
+			Version string                `json:"version"`
+			Encoder bool                  `json:"encoder"`
+			Ports   []decoderPkg.PortInfo `json:"ports"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response body: %v", err)
+	}
+
+	devices := map[string]int{}
+	for i, device := range body.Data {
+		devices[device.Name+"/"+device.Version] = i
+	}
+
+	tagsl, ok := devices["tagsl/v1"]
+	if !ok || !body.Data[tagsl].Encoder {
+		t.Fatalf("expected tagsl/v1 with encoder, got %+v", body.Data)
+	}
+	if body.Data[tagsl].Ports[0].Port != 1 || body.Data[tagsl].Ports[0].Payload != "tagsl.Port1Payload" {
+		t.Errorf("expected tagsl port 1 first, got %+v", body.Data[tagsl].Ports[0])
+	}
+
+	tagxl, ok := devices["tagxl/v1"]
+	if !ok {
+		t.Fatalf("expected tagxl/v1, got %+v", body.Data)
+	}
+	versions := 0
+	for _, port := range body.Data[tagxl].Ports {
+		if port.Port == 152 && port.Version != nil {
+			versions++
+		}
+	}
+	if versions != 2 {
+		t.Errorf("expected 2 versions of tagxl port 152, got %d", versions)
+	}
+}
+
+func TestGetJSONSchemaHandler(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	handler := getJSONSchemaHandler(decoderPkg.DefaultRegistry, "tagxl/v1")
+
+	req, err := http.NewRequest("GET", "/jsonschema/tagxl/v1", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, req)
+
+	resp := recorder.Result()
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var body struct {
+		Data struct {
+			Schema string `json:"$schema"`
+			Defs   map[string]struct {
+				Properties map[string]map[string]any `json:"properties"`
+			} `json:"$defs"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response body: %v", err)
+	}
+
+	if body.Data.Schema != decoderPkg.JSONSchemaDialect {
+		t.Errorf("expected schema dialect %s, got %s", decoderPkg.JSONSchemaDialect, body.Data.Schema)
+	}
+	battery := body.Data.Defs["port151"].Properties["battery"]
+	if battery[decoderPkg.JSONSchemaUnit] != "V" {
+		t.Errorf("expected battery in V, got %v", battery)
+	}
+	if _, ok := body.Data.Defs["port152v2"]; !ok {
+		t.Errorf("expected version 2 of port 152, got %v", body.Data.Defs)
+	}
+}
+
 func TestSetHeaders(t *testing.T) {
 	recorder := httptest.NewRecorder()
 	status := http.StatusOK
@@ -226,18 +800,14 @@ func TestHTTPCmd(t *testing.T) {
 		ConfigId:     0,
 		ConfigChange: false,
 		Moving:       true,
-		Mac1:         "72a741b1e238",
-		Rssi1:        -75,
-		Mac2:         common.StringPtr("72a741b1e08b"),
-		Rssi2:        common.Int8Ptr(-80),
-		Mac3:         common.StringPtr("3498b5c583e2"),
-		Rssi3:        common.Int8Ptr(-79),
-		Mac4:         common.StringPtr("72a741b1e0cd"),
-		Rssi4:        common.Int8Ptr(-89),
-		Mac5:         common.StringPtr("72a741beed4c"),
-		Rssi5:        common.Int8Ptr(-60),
-		Mac6:         common.StringPtr("72a741beef53"),
-		Rssi6:        common.Int8Ptr(-73),
+		AccessPoints: []tagslDecoder.AccessPoint{
+			{Mac: "72a741b1e238", Rssi: -75},
+			{Mac: "72a741b1e08b", Rssi: -80},
+			{Mac: "3498b5c583e2", Rssi: -79},
+			{Mac: "72a741b1e0cd", Rssi: -89},
+			{Mac: "72a741beed4c", Rssi: -60},
+			{Mac: "72a741beef53", Rssi: -73},
+		},
 	}
 
 	if !reflect.DeepEqual(response.Data, expectedData) {
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/truvami/decoder/internal/logger"
+	"go.uber.org/zap"
+)
+
+func init() {
+	rootCmd.AddCommand(jsonSchemaCmd)
+}
+
+var jsonSchemaCmd = &cobra.Command{
+	Use:   "jsonschema [device]",
+	Short: "print the JSON Schema of the payloads of a device",
+	Long: `Print the JSON Schema of the payloads of a device.
+
+The payload of every port, and every version of versioned ports, is a definition
+in $defs as written by the machine output profile. Fields with a unit state it
+with the x-unit keyword. The schema uses JSON Schema 2020-12, which OpenAPI 3.1
+schemas can reference. The device is a registered device like tagsl or a device
+of --schema-dir, optionally followed by its version.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		registry, err := deviceRegistry()
+		if err != nil {
+			logger.Logger.Error("error while loading schemas", zap.Error(err), zap.String("dir", SchemaDir))
+			return
+		}
+
+		schema, err := registry.JSONSchema(args[0])
+		if err != nil {
+			logger.Logger.Error("error while selecting device", zap.Error(err), zap.String("device", args[0]))
+			return
+		}
+
+		marshaled, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			logger.Logger.Error("marshaling error", zap.Error(err))
+			return
+		}
+		fmt.Println(string(marshaled))
+	},
+}
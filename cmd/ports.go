@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/truvami/decoder/internal/logger"
+	"github.com/truvami/decoder/pkg/decoder"
+	"go.uber.org/zap"
+)
+
+func init() {
+	rootCmd.AddCommand(portsCmd)
+}
+
+var portsCmd = &cobra.Command{
+	Use:   "ports [device]",
+	Short: "list the ports of a device and the payloads they carry",
+	Long: `List the ports of a device and the payloads they carry.
+
+For every port, and every version of versioned ports, the payload struct, the
+features and the fields are printed. The device is a registered device like tagsl
+or a device of --schema-dir, optionally followed by its version.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		registry, err := deviceRegistry()
+		if err != nil {
+			logger.Logger.Error("error while loading schemas", zap.Error(err), zap.String("dir", SchemaDir))
+			return
+		}
+
+		ports, err := registry.Ports(args[0])
+		if err != nil {
+			logger.Logger.Error("error while selecting device", zap.Error(err), zap.String("device", args[0]))
+			return
+		}
+
+		if Json {
+			logger.Logger.Info("ports of device", zap.String("device", args[0]), zap.Reflect("ports", ports))
+			return
+		}
+		printPorts(ports)
+	},
+}
+
+func printPorts(ports []decoder.PortInfo) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Println()
+	_, _ = fmt.Fprintln(w, "PORT\tVERSION\tPAYLOAD\tFEATURES\tFIELDS")
+	for _, p := range ports {
+		version := ""
+		if p.Version != nil {
+			version = strconv.Itoa(int(*p.Version))
+		}
+
+		payload := p.Payload
+		if p.Solver {
+			payload = "solver"
+		}
+
+		features := make([]string, len(p.Features))
+		for i, feature := range p.Features {
+			features[i] = string(feature)
+		}
+
+		fields := make([]string, len(p.Fields))
+		for i, field := range p.Fields {
+			fields[i] = field.Name
+		}
+
+		_, _ = fmt.Fprintln(w, strings.Join([]string{strconv.Itoa(int(p.Port)), version, payload, strings.Join(features, ", "), strings.Join(fields, ", ")}, "\t"))
+	}
+	_ = w.Flush()
+
+	if len(ports) > 0 && ports[0].Docs != "" {
+		fmt.Println()
+		fmt.Println("docs:", ports[0].Docs)
+	}
+	fmt.Println()
+}
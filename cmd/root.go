@@ -3,8 +3,11 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,6 +15,8 @@ import (
 	"github.com/spf13/viper"
 	"github.com/truvami/decoder/internal/logger"
 	"github.com/truvami/decoder/internal/selfupdate"
+	helpers "github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -27,10 +32,17 @@ var banner = []string{
 var Debug bool
 var Json bool
 var SkipValidation bool
+var Lenient bool
+var NumberedFields bool
+var OutputProfile string
 
 var Solver string
 var LoracloudAccessToken string
 
+var SchemaDir string
+var WifiDatabase string
+var BeaconRegistry string
+
 func init() {
 	rootCmd.PersistentFlags().BoolVarP(&Debug, "debug", "d", false, "Display debugging output in the console. (default: \033[31mfalse\033[0m)")
 	err := viper.BindPFlag("debug", rootCmd.PersistentFlags().Lookup("debug"))
@@ -50,6 +62,24 @@ func init() {
 		logger.Logger.Error("error while binding skip-validation flag", zap.Error(err))
 	}
 
+	rootCmd.PersistentFlags().BoolVarP(&Lenient, "lenient", "", false, "Decode truncated payloads as far as possible and report the missing fields. (default: \033[31mfalse\033[0m)")
+	err = viper.BindPFlag("lenient", rootCmd.PersistentFlags().Lookup("lenient"))
+	if err != nil {
+		logger.Logger.Error("error while binding lenient flag", zap.Error(err))
+	}
+
+	rootCmd.PersistentFlags().BoolVarP(&NumberedFields, "numbered-fields", "", false, "Output repeated groups such as access points as numbered fields (mac1, rssi1, ...) like older versions. (default: \033[31mfalse\033[0m)")
+	err = viper.BindPFlag("numbered-fields", rootCmd.PersistentFlags().Lookup("numbered-fields"))
+	if err != nil {
+		logger.Logger.Error("error while binding numbered-fields flag", zap.Error(err))
+	}
+
+	rootCmd.PersistentFlags().StringVarP(&OutputProfile, "output-profile", "", string(decoder.ProfileDisplay), "Output profile of decoded payloads.\nThis can be display (values with units like \"3.612v\") or machine (plain numbers, durations in seconds).")
+	err = viper.BindPFlag("output-profile", rootCmd.PersistentFlags().Lookup("output-profile"))
+	if err != nil {
+		logger.Logger.Error("error while binding output-profile flag", zap.Error(err))
+	}
+
 	rootCmd.PersistentFlags().StringVarP(&Solver, "solver", "s", "aws", "Solver to use for decoding the payload.\nThis can be aws or loracloud.")
 	err = viper.BindPFlag("solver", rootCmd.PersistentFlags().Lookup("solver"))
 	if err != nil {
@@ -61,6 +91,24 @@ func init() {
 	if err != nil {
 		logger.Logger.Error("error while binding loracloud-access-token flag", zap.Error(err))
 	}
+
+	rootCmd.PersistentFlags().StringVarP(&SchemaDir, "schema-dir", "", "", "Directory with YAML or JSON payload schemas of additional devices. (default: \033[31mempty\033[0m)")
+	err = viper.BindPFlag("schema-dir", rootCmd.PersistentFlags().Lookup("schema-dir"))
+	if err != nil {
+		logger.Logger.Error("error while binding schema-dir flag", zap.Error(err))
+	}
+
+	rootCmd.PersistentFlags().StringVarP(&WifiDatabase, "wifi-database", "", "", "CSV file of access points (mac, latitude, longitude, accuracy) to resolve WiFi positions locally. (default: \033[31mempty\033[0m)")
+	err = viper.BindPFlag("wifi-database", rootCmd.PersistentFlags().Lookup("wifi-database"))
+	if err != nil {
+		logger.Logger.Error("error while binding wifi-database flag", zap.Error(err))
+	}
+
+	rootCmd.PersistentFlags().StringVarP(&BeaconRegistry, "beacon-registry", "", "", "CSV file of mounted BLE beacons (mac, latitude, longitude, floor, zone) to resolve indoor positions locally. (default: \033[31mempty\033[0m)")
+	err = viper.BindPFlag("beacon-registry", rootCmd.PersistentFlags().Lookup("beacon-registry"))
+	if err != nil {
+		logger.Logger.Error("error while binding beacon-registry flag", zap.Error(err))
+	}
 }
 
 var rootCmd = &cobra.Command{
@@ -97,6 +145,10 @@ A CLI tool to help decode @truvami payloads.`,
 
 		logger.NewLogger(options...)
 
+		if _, err := decoder.ParseOutputProfile(OutputProfile); err != nil {
+			logger.Logger.Fatal("invalid output profile", zap.Error(err), zap.Any("profiles", decoder.OutputProfiles))
+		}
+
 		// Non-blocking update check (ignore network errors).
 		go func() {
 			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
@@ -130,17 +182,34 @@ func Execute() {
 }
 
 func printJSON(data any) {
+	printBody(map[string]any{
+		"data": outputData(data, outputProfile()),
+	})
+}
+
+// printUplink prints the data of a decoded uplink like printJSON together with
+// its metadata.
+func printUplink(uplink *decoder.DecodedUplink) {
+	printBody(map[string]any{
+		"data":     outputData(uplink.Data, outputProfile()),
+		"metadata": uplink.Metadata,
+	})
+}
+
+func printBody(body map[string]any) {
 	if Json {
-		logger.Logger.Info("successfully decoded payload", zap.Any("data", data))
+		fields := []zap.Field{}
+		for _, key := range slices.Sorted(maps.Keys(body)) {
+			fields = append(fields, zap.Reflect(key, body[key]))
+		}
+		logger.Logger.Info("successfully decoded payload", fields...)
 		return
 	}
 
 	logger.Logger.Info("successfully decoded payload")
 
 	// print data beautifully and formatted
-	marshaled, err := json.MarshalIndent(map[string]any{
-		"data": data,
-	}, "", "   ")
+	marshaled, err := json.MarshalIndent(body, "", "   ")
 
 	// handle marshaling error
 	if err != nil {
@@ -153,6 +222,69 @@ func printJSON(data any) {
 	fmt.Println()
 }
 
+// outputProfile returns the output profile of the --output-profile flag.
+func outputProfile() decoder.OutputProfile {
+	profile, err := decoder.ParseOutputProfile(OutputProfile)
+	if err != nil {
+		return decoder.ProfileDisplay
+	}
+	return profile
+}
+
+// outputData returns the data as it is written to the output in the profile. With
+// --numbered-fields the records of repeated groups are written as numbered fields
+// like older versions.
+func outputData(data any, profile decoder.OutputProfile) any {
+	if !NumberedFields && profile == decoder.ProfileDisplay {
+		return data
+	}
+
+	var marshaled []byte
+	var err error
+	if NumberedFields {
+		marshaled, err = helpers.MarshalNumberedProfile(data, profile)
+	} else {
+		marshaled, err = decoder.MarshalProfile(data, profile)
+	}
+	if err != nil {
+		logger.Logger.Error("error while marshaling output", zap.Error(err), zap.String("profile", string(profile)))
+		return data
+	}
+	return json.RawMessage(marshaled)
+}
+
+// logWarnings logs the errors of a decode that still returned data, such as failed
+// validations and the missing fields of a lenient decode. It returns false for any other error.
+func logWarnings(err error) bool {
+	var partial *helpers.PartialDecodeError
+	if !errors.Is(err, helpers.ErrValidationFailed) && !errors.As(err, &partial) {
+		return false
+	}
+
+	for _, err := range helpers.UnwrapError(err) {
+		logger.Logger.Warn("", zap.Error(err))
+	}
+	if errors.Is(err, helpers.ErrValidationFailed) {
+		logger.Logger.Warn("validation for some fields failed - are you using the correct port?")
+	}
+	if partial != nil {
+		logger.Logger.Warn("payload is truncated - some fields could not be decoded", zap.Any("fields", partial.Fields))
+	}
+	return true
+}
+
+// decodeErrorFields returns the log fields of a decode error, including the
+// diagnostics of a helpers.DecodeError.
+func decodeErrorFields(err error) []zap.Field {
+	fields := []zap.Field{zap.Error(err)}
+
+	var decodeError *helpers.DecodeError
+	if errors.As(err, &decodeError) {
+		fields = append(fields, zap.Reflect("details", decodeError))
+	}
+	return fields
+}
+
 func getBanner() string {
 	if time.Now().Month() == time.December {
 		banner = []string{
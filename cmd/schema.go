@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/truvami/decoder/internal/logger"
+	"github.com/truvami/decoder/pkg/decoder"
+	"github.com/truvami/decoder/pkg/schema"
+	"go.uber.org/zap"
+)
+
+func init() {
+	addUplinkFlags(schemaCmd)
+	rootCmd.AddCommand(schemaCmd)
+}
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema [device] [port] [payload]",
+	Short: "decode payloads of devices described by a schema in --schema-dir",
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		if SchemaDir == "" {
+			logger.Logger.Error("the --schema-dir flag is required")
+			return
+		}
+
+		logger.Logger.Debug("loading schemas", zap.String("dir", SchemaDir))
+		registry := decoder.NewRegistry()
+		err := registerSchemas(registry, SchemaDir)
+		if err != nil {
+			logger.Logger.Error("error while loading schemas", zap.Error(err), zap.String("dir", SchemaDir))
+			return
+		}
+
+		d, err := registry.New(args[0], deviceOptions(cmd.Context(), nil))
+		if err != nil {
+			logger.Logger.Error("error while selecting schema", zap.Error(err), zap.String("device", args[0]))
+			return
+		}
+
+		port, err := strconv.Atoi(args[1])
+		if err != nil {
+			logger.Logger.Error("error while parsing port", zap.Error(err), zap.String("port", args[1]))
+			return
+		}
+		logger.Logger.Debug("port parsed successfully", zap.Int("port", port))
+		if port < 0 || port > 255 {
+			logger.Logger.Error("port must be between 0 and 255", zap.Int("port", port))
+			return
+		}
+
+		options, err := uplinkOptions(cmd, "", uint8(port))
+		if err != nil {
+			logger.Logger.Error("error while parsing uplink metadata", zap.Error(err))
+			return
+		}
+
+		data, err := decodeUplink(cmd.Context(), d, args[2], options)
+		if err != nil && !logWarnings(err) {
+			logger.Logger.Error("error while decoding data", decodeErrorFields(err)...)
+			return
+		}
+
+		printUplink(data)
+	},
+}
+
+// registerSchemas adds a device for every schema of the directory to the registry.
+func registerSchemas(registry *decoder.Registry, dir string) error {
+	schemas, err := schema.LoadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range schemas {
+		device, err := schema.NewDevice(*s)
+		if err != nil {
+			return err
+		}
+		err = registry.Register(device)
+		if err != nil {
+			return err
+		}
+		logger.Logger.Debug("loaded schema", zap.String("path", s.Path()), zap.Int("ports", len(s.Ports)))
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/truvami/decoder/internal/logger"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+func TestRegisterSchemas(t *testing.T) {
+	logger.NewLogger()
+	defer logger.Sync()
+
+	registry := decoder.NewRegistry()
+	err := registerSchemas(registry, "../pkg/schema/testdata")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	device, err := registry.Lookup("acme/v1")
+	if err != nil {
+		t.Fatalf("expected acme/v1 device, got %v", registry.Devices())
+	}
+	if len(device.Ports) == 0 {
+		t.Errorf("expected ports for acme/v1")
+	}
+
+	_, err = registry.New("acme", decoder.DeviceOptions{})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err = registry.New("beacon/v2", decoder.DeviceOptions{})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err = registry.New("unknown", decoder.DeviceOptions{})
+	if err == nil {
+		t.Error("expected error for unknown device")
+	}
+
+	err = registerSchemas(registry, "../pkg/schema/testdata")
+	if err == nil {
+		t.Error("expected error for schemas registered twice")
+	}
+
+	err = registerSchemas(decoder.NewRegistry(), "does-not-exist")
+	if err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
@@ -19,15 +19,10 @@ func main() {
 		panic(err)
 	}
 
-	// check if decoded payload has the GNSS feature
-	if !data.Is(decoder.FeatureGNSS) {
-		panic("decoded payload does not have GNSS feature")
-	}
-
-	// cast to GNSS data
-	gnssData, ok := data.Data.(decoder.UplinkFeatureGNSS)
+	// get GNSS data if the decoded payload has the GNSS feature
+	gnssData, ok := decoder.As[decoder.UplinkFeatureGNSS](data)
 	if !ok {
-		panic("failed to cast to GNSS data")
+		panic("decoded payload does not have GNSS feature")
 	}
 
 	// print GNSS data
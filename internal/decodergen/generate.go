@@ -0,0 +1,743 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Generate returns the source of the generated file for the package in dir,
+// together with the reasons for payload configs that were left to reflection.
+func Generate(dir string, output string) ([]byte, []string, error) {
+	l, err := newLoader(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	importPath, err := l.importPath(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkg, err := l.load(importPath, output)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configs, skipped := l.configs(pkg)
+
+	g := &generator{
+		pkg:     pkg,
+		imports: map[string]string{},
+		names:   map[string]int{},
+		seen:    map[string]bool{},
+	}
+	for _, config := range configs {
+		err := g.add(config)
+		if err != nil {
+			skipped = append(skipped, err.Error())
+		}
+	}
+
+	source, err := g.source()
+	return source, skipped, err
+}
+
+type generator struct {
+	pkg     *pkgInfo
+	imports map[string]string
+	names   map[string]int
+	seen    map[string]bool
+
+	registrations bytes.Buffer
+	functions     bytes.Buffer
+}
+
+// add emits the codec of a config, unless the same layout was already emitted.
+func (g *generator) add(c config) error {
+	key := fmt.Sprintf("%s.%s %v %v", c.target.pkg.path, c.target.name, c.fields, c.tags)
+	if g.seen[key] {
+		return nil
+	}
+	g.seen[key] = true
+
+	typeName, ok := g.typeName(c.target)
+	if !ok {
+		return fmt.Errorf("%w: %s is not exported", errUnsupported, c.target.name)
+	}
+
+	decode, decodeOK := g.decode(c, typeName)
+	encode, encodeOK := g.encode(c, typeName)
+	if !decodeOK && !encodeOK {
+		return fmt.Errorf("%w: %s has fields that need reflection", errUnsupported, c.target.name)
+	}
+
+	g.names[c.target.name]++
+	suffix := c.target.name
+	if count := g.names[c.target.name]; count > 1 {
+		suffix = fmt.Sprintf("%s%d", suffix, count)
+	}
+
+	fmt.Fprintf(&g.registrations, "\tcommon.RegisterGenerated(reflect.TypeOf(%s{}), common.GeneratedCodec{\n", typeName)
+	if len(c.fields) != 0 {
+		fmt.Fprintf(&g.registrations, "\t\tFields: []common.FieldLayout{\n")
+		for _, field := range c.fields {
+			fmt.Fprintf(&g.registrations, "\t\t\t%s,\n", field.literal())
+		}
+		fmt.Fprintf(&g.registrations, "\t\t},\n")
+	}
+	if len(c.tags) != 0 {
+		fmt.Fprintf(&g.registrations, "\t\tTags: []common.TagLayout{\n")
+		for _, tag := range c.tags {
+			fmt.Fprintf(&g.registrations, "\t\t\t{Name: %q, Tag: 0x%02x%s},\n", tag.name, tag.tag, tag.flags())
+		}
+		fmt.Fprintf(&g.registrations, "\t\t},\n")
+	}
+	if decodeOK {
+		fmt.Fprintf(&g.registrations, "\t\tDecode: decode%s,\n", suffix)
+		fmt.Fprintf(&g.functions, "\nfunc decode%s%s", suffix, decode)
+	}
+	if encodeOK {
+		fmt.Fprintf(&g.registrations, "\t\tEncode: encode%s,\n", suffix)
+		fmt.Fprintf(&g.functions, "\nfunc encode%s%s", suffix, encode)
+	}
+	fmt.Fprintf(&g.registrations, "\t})\n")
+	g.use("reflect")
+	g.use(commonPath)
+	if c.target.pkg != g.pkg {
+		g.use(c.target.pkg.path)
+	}
+	return nil
+}
+
+func (g *generator) source() ([]byte, error) {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by decodergen. DO NOT EDIT.\n\npackage %s\n", g.pkg.name)
+
+	if g.registrations.Len() != 0 {
+		paths := make([]string, 0, len(g.imports))
+		for p := range g.imports {
+			paths = append(paths, p)
+		}
+		sort.Slice(paths, func(i, j int) bool {
+			if isStandard(paths[i]) != isStandard(paths[j]) {
+				return isStandard(paths[i])
+			}
+			return paths[i] < paths[j]
+		})
+
+		fmt.Fprintf(&out, "\nimport (\n")
+		for i, p := range paths {
+			// standard library imports come first, separated by a blank line
+			if i > 0 && isStandard(paths[i-1]) && !isStandard(p) {
+				fmt.Fprintf(&out, "\n")
+			}
+			if g.imports[p] != path.Base(p) {
+				fmt.Fprintf(&out, "\t%s %q\n", g.imports[p], p)
+			} else {
+				fmt.Fprintf(&out, "\t%q\n", p)
+			}
+		}
+		fmt.Fprintf(&out, ")\n")
+
+		fmt.Fprintf(&out, "\nfunc init() {\n%s}\n", g.registrations.String())
+		out.Write(g.functions.Bytes())
+	}
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, out.String())
+	}
+	return source, nil
+}
+
+func isStandard(importPath string) bool {
+	return !strings.Contains(strings.Split(importPath, "/")[0], ".")
+}
+
+// literal returns the common.FieldLayout literal of a field.
+func (l layout) literal() string {
+	return fmt.Sprintf("{Name: %q, Start: %d, Length: %d%s}", l.name, l.start, l.length, l.flags())
+}
+
+// flags returns the optional keys of the layout literal.
+func (l layout) flags() string {
+	var flags string
+	if l.optional {
+		flags += ", Optional: true"
+	}
+	if l.hex {
+		flags += ", Hex: true"
+	}
+	if l.transform {
+		flags += ", Transform: true"
+	}
+	if l.bitLength != 0 || l.bitOffset != 0 {
+		flags += fmt.Sprintf(", BitOffset: %d, BitLength: %d", l.bitOffset, l.bitLength)
+	}
+	if l.byteOrder != "" {
+		flags += ", ByteOrder: common." + l.byteOrder
+	}
+	if l.signedness != "" {
+		flags += ", Signedness: common." + l.signedness
+	}
+	if l.maxCount != 0 {
+		flags += fmt.Sprintf(", MaxCount: %d", l.maxCount)
+	}
+	if l.scale != 0 {
+		flags += ", Scale: " + strconv.FormatFloat(l.scale, 'g', -1, 64)
+	}
+	if l.offset != 0 {
+		flags += ", Offset: " + strconv.FormatFloat(l.offset, 'g', -1, 64)
+	}
+	if len(l.group) != 0 {
+		members := []string{}
+		for _, member := range l.group {
+			members = append(members, member.literal())
+		}
+		flags += ", Group: []common.FieldLayout{" + strings.Join(members, ", ") + "}"
+	}
+	return flags
+}
+
+// scaled reports whether the value of the layout is converted with its scale
+// and offset, see common.FieldConfig.
+func (l layout) scaled() bool {
+	return !l.transform && (l.scale != 0 || l.offset != 0)
+}
+
+// use records an import of the generated file and returns the name to refer to it.
+func (g *generator) use(importPath string) string {
+	if name, ok := g.imports[importPath]; ok {
+		return name
+	}
+	name := defaultName(importPath)
+	if importPath == commonPath {
+		name = "common"
+	}
+	g.imports[importPath] = name
+	return name
+}
+
+// typeName returns the name of a struct type as seen from the generated package.
+func (g *generator) typeName(info *typeInfo) (string, bool) {
+	if info.pkg == g.pkg {
+		return info.name, true
+	}
+	if !ast.IsExported(info.name) {
+		return "", false
+	}
+	return defaultName(info.pkg.path) + "." + info.name, true
+}
+
+// groupType returns the record type of a repeated group field, which must be
+// a slice of a struct type declared in the package of the field.
+func (g *generator) groupType(field *fieldInfo) (*typeInfo, bool) {
+	array, ok := field.expr.(*ast.ArrayType)
+	if !ok || array.Len != nil || field.pointer {
+		return nil, false
+	}
+	ident, ok := array.Elt.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	info, ok := field.owner.pkg.types[ident.Name]
+	if !ok || info.embedded {
+		return nil, false
+	}
+	return info, true
+}
+
+// fieldType returns the element type of a field as seen from the generated package.
+// The imports are only recorded once the type is used.
+func (g *generator) fieldType(field *fieldInfo) (string, []string, bool) {
+	paths := []string{}
+	var qualify func(expr ast.Expr) (string, bool)
+	qualify = func(expr ast.Expr) (string, bool) {
+		switch expr := expr.(type) {
+		case *ast.Ident:
+			if kindOf(expr, nil) != "" || expr.Name == "any" || expr.Name == "error" || expr.Name == "rune" {
+				return expr.Name, true
+			}
+			if field.owner.pkg == g.pkg {
+				return expr.Name, true
+			}
+			if !ast.IsExported(expr.Name) {
+				return "", false
+			}
+			paths = append(paths, field.owner.pkg.path)
+			return defaultName(field.owner.pkg.path) + "." + expr.Name, true
+		case *ast.StarExpr:
+			elem, ok := qualify(expr.X)
+			return "*" + elem, ok
+		case *ast.ArrayType:
+			if expr.Len != nil {
+				return "", false
+			}
+			elem, ok := qualify(expr.Elt)
+			return "[]" + elem, ok
+		case *ast.SelectorExpr:
+			x, ok := expr.X.(*ast.Ident)
+			if !ok || field.owner.imports[x.Name] == "" {
+				return "", false
+			}
+			importPath := field.owner.imports[x.Name]
+			if importPath == g.pkg.path {
+				return expr.Sel.Name, true
+			}
+			paths = append(paths, importPath)
+			return defaultName(importPath) + "." + expr.Sel.Name, true
+		}
+		return "", false
+	}
+
+	name, ok := qualify(field.expr)
+	return name, paths, ok
+}
+
+// conflicts reports whether the type would need an import under a name already taken by another path.
+func (g *generator) conflicts(paths []string) bool {
+	for _, p := range paths {
+		for other, name := range g.imports {
+			if other != p && name == defaultName(p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *generator) decode(c config, typeName string) (string, bool) {
+	var body bytes.Buffer
+	uses := []string{}
+
+	assign := func(item layout, config string, input string, field *fieldInfo, receiver string, indent string) bool {
+		elem := ""
+		if item.transform || field.kind == "" {
+			name, paths, ok := g.fieldType(field)
+			if !ok || g.conflicts(paths) {
+				return false
+			}
+			elem = name
+			uses = append(uses, paths...)
+		}
+
+		if item.byteOrder != "" {
+			input = fmt.Sprintf("common.OrderBytes(%s, common.%s)", input, item.byteOrder)
+		}
+		if item.hex {
+			input = fmt.Sprintf("common.HexValue(%s)", input)
+		} else {
+			// tags take all of their bytes
+			bits := fmt.Sprintf("len(%s)*8", input)
+			if item.length > 0 {
+				bits = strconv.Itoa(item.length * 8)
+			}
+			if item.bitLength > 0 {
+				input = fmt.Sprintf("common.ExtractBits(%s, %d, %d)", input, item.bitOffset, item.bitLength)
+				bits = strconv.Itoa(item.bitLength)
+			}
+			if item.signedness != "" {
+				input = fmt.Sprintf("common.ExtendBytes(%s, %s, %t)", input, bits, item.signedness == "Signed")
+			}
+		}
+
+		if item.transform {
+			fmt.Fprintf(&body, "%sif value := %s.Transform(%s); value != nil {\n", indent, config, input)
+			if field.pointer {
+				fmt.Fprintf(&body, "%s\tconverted := value.(%s)\n", indent, elem)
+				fmt.Fprintf(&body, "%s\t%s.%s = &converted\n", indent, receiver, field.name)
+			} else {
+				fmt.Fprintf(&body, "%s\t%s.%s = value.(%s)\n", indent, receiver, field.name, elem)
+			}
+			fmt.Fprintf(&body, "%s}\n", indent)
+			return true
+		}
+
+		var expr string
+		switch {
+		case item.scaled():
+			scaled := fmt.Sprintf("common.ScaledValue(%s, %t, %s.Scale, %s.Offset)", input, item.signedness == "Signed", config, config)
+			switch {
+			case item.hex:
+				return false
+			case field.kind == "float32", field.kind == "float64":
+				expr = fmt.Sprintf("%s(%s)", field.kind, scaled)
+			case field.kind == "duration":
+				expr = fmt.Sprintf("time.Duration(%s * float64(time.Second))", scaled)
+				uses = append(uses, "time")
+			default:
+				return false
+			}
+		case field.kind == "bool":
+			if item.hex {
+				return false
+			}
+			expr = fmt.Sprintf("%s[0]&0x01 == 1", input)
+		case field.kind == "int8", field.kind == "int16", field.kind == "int32", field.kind == "int64",
+			field.kind == "uint8", field.kind == "uint16", field.kind == "uint32", field.kind == "uint64":
+			if item.hex {
+				return false
+			}
+			expr = fmt.Sprintf("common.BytesTo%s(%s)", strings.ToUpper(field.kind[:1])+field.kind[1:], input)
+		case field.kind == "string":
+			if item.hex {
+				expr = input
+			} else {
+				expr = fmt.Sprintf("string(%s)", input)
+			}
+		default:
+			return false
+		}
+
+		if field.pointer {
+			fmt.Fprintf(&body, "%s{\n", indent)
+			fmt.Fprintf(&body, "%s\tvalue := %s\n", indent, expr)
+			fmt.Fprintf(&body, "%s\t%s.%s = &value\n", indent, receiver, field.name)
+			fmt.Fprintf(&body, "%s}\n", indent)
+		} else {
+			fmt.Fprintf(&body, "%s%s.%s = %s\n", indent, receiver, field.name, expr)
+		}
+		return true
+	}
+
+	validate := func(field *fieldInfo, receiver string, name string, indent string) {
+		if field.validate == "" {
+			return
+		}
+		fmt.Fprintf(&body, "%sif err := common.ValidateField(%s, %s.%s, %q); err != nil {\n", indent, name, receiver, field.name, field.validate)
+		fmt.Fprintf(&body, "%s\terrs = append(errs, err)\n", indent)
+		fmt.Fprintf(&body, "%s}\n", indent)
+	}
+
+	lookup := func(name string) (*fieldInfo, bool) {
+		field, ok := c.target.fields[name]
+		if ok && !ast.IsExported(name) {
+			return nil, false
+		}
+		return field, true
+	}
+
+	group := func(index int, item layout, field *fieldInfo) bool {
+		loop := "for i := 0; ; i++ {"
+		if item.maxCount != 0 {
+			loop = fmt.Sprintf("for i := 0; i < %d; i++ {", item.maxCount)
+		}
+		optional := "i > 0"
+		if item.optional {
+			optional = "true"
+		}
+		read := fmt.Sprintf("reader.Read(%q, %d+i*%d, %d, %s)", item.name, item.start, item.length, item.length, optional)
+
+		if field == nil {
+			fmt.Fprintf(&body, "\t%s\n\t\tif _, ok := %s; !ok {\n\t\t\tbreak\n\t\t}\n\t}\n", loop, read)
+			return true
+		}
+
+		record, ok := g.groupType(field)
+		if !ok {
+			return false
+		}
+		recordName, ok := g.typeName(record)
+		if !ok {
+			return false
+		}
+		if record.pkg != g.pkg {
+			if g.conflicts([]string{record.pkg.path}) {
+				return false
+			}
+			uses = append(uses, record.pkg.path)
+		}
+
+		fmt.Fprintf(&body, "\t%s\n", loop)
+		fmt.Fprintf(&body, "\t\traw, ok := %s\n", read)
+		fmt.Fprintf(&body, "\t\tif !ok {\n\t\t\tbreak\n\t\t}\n\n")
+		fmt.Fprintf(&body, "\t\titem := %s{}\n", recordName)
+		for j, member := range item.group {
+			if member.start < 0 || member.length <= 0 || member.start+member.length > item.length {
+				return false
+			}
+			memberField, ok := record.fields[member.name]
+			if !ok {
+				continue
+			}
+			if !ast.IsExported(member.name) {
+				return false
+			}
+
+			input := fmt.Sprintf("raw[%d:%d]", member.start, member.start+member.length)
+			if !assign(member, fmt.Sprintf("config.Fields[%d].Group[%d]", index, j), input, memberField, "item", "\t\t") {
+				return false
+			}
+			validate(memberField, "item", fmt.Sprintf("common.GroupFieldName(%q, i, %q)", item.name, member.name), "\t\t")
+		}
+		fmt.Fprintf(&body, "\t\tp.%s = append(p.%s, item)\n", field.name, field.name)
+		fmt.Fprintf(&body, "\t}\n")
+		return true
+	}
+
+	if c.isTLV {
+		groups := []uint8{}
+		members := map[uint8][]int{}
+		for i, tag := range c.tags {
+			if _, ok := members[tag.tag]; !ok {
+				groups = append(groups, tag.tag)
+			}
+			members[tag.tag] = append(members[tag.tag], i)
+		}
+
+		fmt.Fprintf(&body, "\tindex := 3\n")
+		fmt.Fprintf(&body, "\tfor index < len(payload) {\n")
+		fmt.Fprintf(&body, "\t\ttag, length, ok := reader.ReadTLV(index)\n")
+		fmt.Fprintf(&body, "\t\tif !ok {\n\t\t\tbreak\n\t\t}\n")
+		fmt.Fprintf(&body, "\t\tindex += 2\n\n")
+		fmt.Fprintf(&body, "\t\traw := payload[index : index+length]\n")
+		fmt.Fprintf(&body, "\t\tswitch tag {\n")
+		for _, tag := range groups {
+			fmt.Fprintf(&body, "\t\tcase 0x%02x:\n", tag)
+			indent := "\t\t\t"
+			for n, i := range members[tag] {
+				item := c.tags[i]
+				if len(members[tag]) > 1 {
+					if n > 0 {
+						body.WriteString("\n")
+					}
+					fmt.Fprintf(&body, "\t\t\t// %s\n", item.name)
+				}
+				fmt.Fprintf(&body, "%sconfig.Features = append(config.Features, config.Tags[%d].Feature)\n", indent, i)
+
+				field, ok := lookup(item.name)
+				if !ok {
+					return "", false
+				}
+				if field == nil {
+					continue
+				}
+				if !assign(item, fmt.Sprintf("config.Tags[%d]", i), "raw", field, "p", indent) {
+					return "", false
+				}
+				validate(field, "p", strconv.Quote(field.name), indent)
+			}
+		}
+		fmt.Fprintf(&body, "\t\tdefault:\n")
+		fmt.Fprintf(&body, "\t\t\tcommon.SkipUnknownTLVTag(tag, length)\n")
+		fmt.Fprintf(&body, "\t\t}\n")
+		fmt.Fprintf(&body, "\t\tindex += length\n")
+		fmt.Fprintf(&body, "\t}\n")
+	} else {
+		for i, item := range c.fields {
+			fmt.Fprintf(&body, "\n\t// %s\n", item.name)
+			read := fmt.Sprintf("reader.Read(%q, %d, %d, %t)", item.name, item.start, item.length, item.optional)
+
+			field, ok := lookup(item.name)
+			if !ok {
+				return "", false
+			}
+			if len(item.group) != 0 {
+				if !group(i, item, field) {
+					return "", false
+				}
+				continue
+			}
+			if field == nil {
+				fmt.Fprintf(&body, "\t%s\n", read)
+				continue
+			}
+
+			fmt.Fprintf(&body, "\tif raw, ok := %s; ok {\n", read)
+			if !assign(item, fmt.Sprintf("config.Fields[%d]", i), "raw", field, "p", "\t\t") {
+				return "", false
+			}
+			validate(field, "p", strconv.Quote(field.name), "\t\t")
+			fmt.Fprintf(&body, "\t}\n")
+		}
+	}
+
+	for _, p := range uses {
+		g.use(p)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "(payload []byte, config *common.PayloadConfig) (any, error) {\n")
+	fmt.Fprintf(&out, "\tp := %s{}\n", typeName)
+	fmt.Fprintf(&out, "\terrs := []error{}\n\n")
+	fmt.Fprintf(&out, "\treader := common.NewFieldReader(payload, config)\n")
+	if c.isTLV {
+		out.WriteString("\n")
+	}
+	out.Write(body.Bytes())
+	fmt.Fprintf(&out, "\n\treturn reader.Result(p, errs)\n}\n")
+	return out.String(), true
+}
+
+func (g *generator) encode(c config, typeName string) (string, bool) {
+	if c.isTLV {
+		return "", false
+	}
+
+	var body bytes.Buffer
+	var size int
+	for _, item := range c.fields {
+		if item.length < 0 {
+			return "", false
+		}
+		if end := item.start + item.length*max(item.maxCount, 1); end > size {
+			size = end
+		}
+	}
+
+	// encodeField emits the code writing a field of the receiver at the start expression.
+	encodeField := func(item layout, config string, field *fieldInfo, receiver string, start string, indent string) bool {
+		value := receiver + "." + field.name
+		if field.pointer {
+			value = "*" + receiver + "." + field.name
+		}
+
+		var convert, set string
+		switch {
+		case item.scaled():
+			switch field.kind {
+			case "float32", "float64":
+				convert = fmt.Sprintf("common.UnscaledBytes(float64(%s), %s.Scale, %s.Offset, %d)", value, config, config, item.length)
+			case "duration":
+				convert = fmt.Sprintf("common.UnscaledBytes((%s).Seconds(), %s.Scale, %s.Offset, %d)", value, config, config, item.length)
+			default:
+				return false
+			}
+			set = value + " != 0"
+		case field.kind == "bool":
+			convert = fmt.Sprintf("common.BoolToBytes(%s, 0)", value)
+			set = "true"
+		case field.kind == "int8", field.kind == "int16", field.kind == "int32", field.kind == "int64":
+			convert = fmt.Sprintf("common.IntToBytes(int64(%s), %d)", value, item.length)
+			set = value + " != 0"
+		case field.kind == "uint8", field.kind == "uint16", field.kind == "uint32", field.kind == "uint64":
+			convert = fmt.Sprintf("common.UintToBytes(uint64(%s), %d)", value, item.length)
+			set = value + " != 0"
+		case field.kind == "float32":
+			convert = fmt.Sprintf("common.Float32ToBytes(%s)", value)
+			set = value + " != 0"
+		case field.kind == "float64":
+			convert = fmt.Sprintf("common.Float64ToBytes(%s)", value)
+			set = value + " != 0"
+		case field.kind == "bytes":
+			convert = value
+			set = fmt.Sprintf("len(%s) != 0", value)
+		case field.kind == "string":
+			set = fmt.Sprintf("len(%s) != 0", value)
+		case field.kind == "time":
+			convert = fmt.Sprintf("common.IntToBytes(%s.%s.Unix(), 8)", receiver, field.name)
+			set = fmt.Sprintf("%s.%s.Unix() != 0", receiver, field.name)
+		case field.kind == "duration":
+			convert = fmt.Sprintf("common.IntToBytes(%s.%s.Nanoseconds(), 8)", receiver, field.name)
+			set = fmt.Sprintf("%s.%s.Nanoseconds() != 0", receiver, field.name)
+		default:
+			return false
+		}
+		if set == "true" || !item.optional {
+			set = ""
+		}
+
+		write := func(bytes string) string {
+			if item.byteOrder != "" {
+				if item.bitLength > 0 {
+					bytes = fmt.Sprintf("common.PackBits(%s, %d, %d, %d)", bytes, item.length, item.bitOffset, item.bitLength)
+				}
+				bytes = fmt.Sprintf("common.OrderBytes(%s, common.%s)", bytes, item.byteOrder)
+				if item.bitLength > 0 {
+					return fmt.Sprintf("writer.Merge(%s, %d, %s)", start, item.length, bytes)
+				}
+			} else if item.bitLength > 0 {
+				return fmt.Sprintf("writer.WriteBits(%s, %d, %d, %d, %s)", start, item.length, item.bitOffset, item.bitLength, bytes)
+			}
+			return fmt.Sprintf("writer.Write(%s, %d, %s)", start, item.length, bytes)
+		}
+
+		outer := indent
+		if field.pointer {
+			fmt.Fprintf(&body, "%sif %s.%s != nil {\n", outer, receiver, field.name)
+			indent += "\t"
+		}
+
+		if field.kind == "string" {
+			fmt.Fprintf(&body, "%sbytes, err = common.HexStringToBytes(%s)\n", indent, value)
+			fmt.Fprintf(&body, "%sif err != nil {\n%s\treturn \"\", err\n%s}\n", indent, indent, indent)
+		} else {
+			fmt.Fprintf(&body, "%sbytes = %s\n", indent, convert)
+		}
+		if item.transform {
+			fmt.Fprintf(&body, "%sbytes = %s.Transform(bytes).([]byte)\n", indent, config)
+		}
+		if set != "" {
+			fmt.Fprintf(&body, "%sif %s {\n%s\t%s\n%s}\n", indent, set, indent, write("bytes"), indent)
+		} else {
+			fmt.Fprintf(&body, "%s%s\n", indent, write("bytes"))
+		}
+
+		if field.pointer {
+			if item.optional {
+				fmt.Fprintf(&body, "%s}\n", outer)
+			} else {
+				fmt.Fprintf(&body, "%s} else {\n%s\t%s\n%s}\n", outer, outer, write(fmt.Sprintf("make([]byte, %d)", item.length)), outer)
+			}
+		}
+		return true
+	}
+
+	for i, item := range c.fields {
+		field, ok := c.target.fields[item.name]
+		if !ok || !ast.IsExported(item.name) {
+			return "", false
+		}
+
+		fmt.Fprintf(&body, "\n\t// %s\n", item.name)
+		if len(item.group) == 0 {
+			if !encodeField(item, fmt.Sprintf("config.Fields[%d]", i), field, "p", strconv.Itoa(item.start), "\t") {
+				return "", false
+			}
+			continue
+		}
+
+		record, ok := g.groupType(field)
+		if !ok {
+			return "", false
+		}
+		if item.maxCount != 0 {
+			fmt.Fprintf(&body, "\tif err := common.CheckGroupCount(%q, len(p.%s), %d); err != nil {\n\t\treturn \"\", err\n\t}\n", item.name, field.name, item.maxCount)
+		}
+		if !item.optional {
+			fmt.Fprintf(&body, "\tif len(p.%s) == 0 {\n\t\twriter.Reserve(%d, %d)\n\t}\n", field.name, item.start, item.length)
+		}
+		fmt.Fprintf(&body, "\tfor i, item := range p.%s {\n", field.name)
+		fmt.Fprintf(&body, "\t\twriter.Reserve(%d+i*%d, %d)\n", item.start, item.length, item.length)
+		for j, member := range item.group {
+			memberField, ok := record.fields[member.name]
+			if !ok || !ast.IsExported(member.name) || member.length < 0 {
+				return "", false
+			}
+			start := fmt.Sprintf("%d+i*%d", item.start+member.start, item.length)
+			if !encodeField(member, fmt.Sprintf("config.Fields[%d].Group[%d]", i, j), memberField, "item", start, "\t\t") {
+				return "", false
+			}
+		}
+		fmt.Fprintf(&body, "\t}\n")
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "(data any, config common.PayloadConfig) (string, error) {\n")
+	fmt.Fprintf(&out, "\tp, ok := data.(%s)\n", typeName)
+	fmt.Fprintf(&out, "\tif !ok {\n\t\treturn common.EncodeReflect(data, config)\n\t}\n\n")
+	fmt.Fprintf(&out, "\twriter := common.NewPayloadWriter(%d)\n", size)
+	if len(c.fields) != 0 {
+		fmt.Fprintf(&out, "\tvar bytes []byte\n")
+		if strings.Contains(body.String(), "err = ") {
+			fmt.Fprintf(&out, "\tvar err error\n")
+		}
+	}
+	out.Write(body.Bytes())
+	fmt.Fprintf(&out, "\n\treturn writer.String(), nil\n}\n")
+	return out.String(), true
+}
@@ -0,0 +1,43 @@
+// Command decodergen generates reflection-free decode and encode functions for
+// the common.PayloadConfig literals of a package.
+//
+// It is meant to be run through go generate from the package directory:
+//
+//	//go:generate go run github.com/truvami/decoder/internal/decodergen
+//
+// The generated functions are registered with common.RegisterGenerated and are
+// only used while the payload layout matches the config they were generated from.
+// Configs the generator cannot translate keep using reflection.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "package directory")
+	output := flag.String("output", "codec_gen.go", "name of the generated file")
+	verbose := flag.Bool("v", false, "list payload configs that keep using reflection")
+	flag.Parse()
+
+	source, skipped, err := Generate(*dir, *output)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "decodergen: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *verbose {
+		for _, reason := range skipped {
+			fmt.Fprintf(os.Stderr, "decodergen: skipped %s\n", reason)
+		}
+	}
+
+	err = os.WriteFile(filepath.Join(*dir, *output), source, 0o644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "decodergen: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGeneratedUpToDate fails if a payload config changed without running go generate.
+func TestGeneratedUpToDate(t *testing.T) {
+	dirs, err := filepath.Glob("../../pkg/*/*/v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var count int
+	for _, dir := range dirs {
+		expected, err := os.ReadFile(filepath.Join(dir, "codec_gen.go"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+
+		t.Run(dir, func(t *testing.T) {
+			got, _, err := Generate(dir, "codec_gen.go")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, expected) {
+				t.Errorf("%s/codec_gen.go is outdated, run go generate ./...", dir)
+			}
+		})
+	}
+
+	if count == 0 {
+		t.Fatal("expected generated files")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	got, skipped, err := Generate("../../pkg/decoder/tagsl/v1", "codec_gen.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("expected no skipped configs, got %v", skipped)
+	}
+
+	for _, expected := range []string{
+		"// Code generated by decodergen. DO NOT EDIT.",
+		"common.RegisterGenerated(reflect.TypeOf(Port150Payload{}), common.GeneratedCodec{",
+		"{Name: \"ConfigId\", Start: 2, Length: 1, BitOffset: 3, BitLength: 4},",
+		"\t\tp.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))",
+		"if raw, ok := reader.Read(\"Latitude\", 1, 4, false); ok {",
+		"func decodePort150Payload(payload []byte, config *common.PayloadConfig) (any, error) {",
+		"func encodePort1Payload(data any, config common.PayloadConfig) (string, error) {",
+		"{Name: \"AccessPoints\", Start: 20, Length: 7, MaxCount: 6, Group: []common.FieldLayout{{Name: \"Mac\", Start: 0, Length: 6, Hex: true}, {Name: \"Rssi\", Start: 6, Length: 1}}},",
+		"\t\traw, ok := reader.Read(\"AccessPoints\", 20+i*7, 7, i > 0)",
+		"\t\titem.Mac = common.HexValue(raw[0:6])",
+		"common.ValidateField(common.GroupFieldName(\"AccessPoints\", i, \"Rssi\"), item.Rssi, \"gte=-120,lte=-20\")",
+		"\t\twriter.Write(26+i*7, 1, bytes)",
+		"{Name: \"Latitude\", Start: 1, Length: 4, Signedness: common.Signed, Scale: 1e-06},",
+		"\t\tp.Latitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[4].Scale, config.Fields[4].Offset))",
+		"\tbytes = common.UnscaledBytes(float64(p.Latitude), config.Fields[4].Scale, config.Fields[4].Offset, 4)",
+	} {
+		if !strings.Contains(string(got), expected) {
+			t.Errorf("expected generated code to contain %q", expected)
+		}
+	}
+}
+
+func TestGenerateByteOrder(t *testing.T) {
+	got, skipped, err := Generate("testdata/endian", "codec_gen.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("expected no skipped configs, got %v", skipped)
+	}
+
+	for _, expected := range []string{
+		"{Name: \"Temperature\", Start: 4, Length: 2, ByteOrder: common.LittleEndian, Signedness: common.Signed},",
+		"\t\tp.SystemTime = common.BytesToUint32(common.OrderBytes(raw, common.LittleEndian))",
+		"\t\tp.Temperature = common.BytesToInt16(common.ExtendBytes(common.OrderBytes(raw, common.LittleEndian), 16, true))",
+		"\t\tp.Offset = common.BytesToInt8(common.ExtendBytes(common.ExtractBits(raw, 4, 4), 4, true))",
+		"\twriter.Write(0, 4, common.OrderBytes(bytes, common.LittleEndian))",
+		"\twriter.WriteBits(6, 1, 4, 4, bytes)",
+		"{Name: \"Temperature\", Tag: 0x47, Optional: true, Signedness: common.Signed},",
+		"value := common.BytesToInt16(common.ExtendBytes(raw, len(raw)*8, true))",
+	} {
+		if !strings.Contains(string(got), expected) {
+			t.Errorf("expected generated code to contain %q", expected)
+		}
+	}
+}
+
+func TestDefaultName(t *testing.T) {
+	tests := map[string]string{
+		"reflect":                                 "reflect",
+		"github.com/truvami/decoder/pkg/common":   "common",
+		"github.com/truvami/decoder/pkg/tagsl/v1": "tagsl",
+		"go.yaml.in/yaml/v3":                      "yaml",
+		"github.com/go-playground/validator":      "validator",
+		"github.com/truvami/decoder/pkg/my-thing": "my_thing",
+	}
+
+	for path, expected := range tests {
+		if got := defaultName(path); got != expected {
+			t.Errorf("defaultName(%q) = %q, expected %q", path, got, expected)
+		}
+	}
+}
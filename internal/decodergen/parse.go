@@ -0,0 +1,590 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const commonPath = "github.com/truvami/decoder/pkg/common"
+
+var errUnsupported = errors.New("unsupported")
+
+// pkgInfo is a parsed package of the module.
+type pkgInfo struct {
+	path  string
+	name  string
+	dir   string
+	files []*ast.File
+	types map[string]*typeInfo
+}
+
+// typeInfo is a struct type declared in a package of the module.
+type typeInfo struct {
+	name     string
+	pkg      *pkgInfo
+	imports  map[string]string
+	fields   map[string]*fieldInfo
+	embedded bool
+}
+
+// fieldInfo describes a field of a struct type.
+type fieldInfo struct {
+	name     string
+	expr     ast.Expr
+	pointer  bool
+	kind     string
+	validate string
+	owner    *typeInfo
+}
+
+// layout is a field or tag of a payload config literal.
+type layout struct {
+	name      string
+	tag       uint8
+	start     int
+	length    int
+	optional  bool
+	hex       bool
+	transform bool
+	bitOffset int
+	bitLength int
+	// byteOrder and signedness hold the names of the non-default common constants.
+	byteOrder  string
+	signedness string
+	maxCount   int
+	scale      float64
+	offset     float64
+	group      []layout
+}
+
+// config is a payload config literal with a resolved target type.
+type config struct {
+	target *typeInfo
+	fields []layout
+	tags   []layout
+	isTLV  bool
+}
+
+type loader struct {
+	module   string
+	root     string
+	fset     *token.FileSet
+	packages map[string]*pkgInfo
+}
+
+func newLoader(dir string) (*loader, error) {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if module, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+					return &loader{
+						module:   strings.TrimSpace(module),
+						root:     root,
+						fset:     token.NewFileSet(),
+						packages: map[string]*pkgInfo{},
+					}, nil
+				}
+			}
+			return nil, fmt.Errorf("no module directive in %s", filepath.Join(root, "go.mod"))
+		}
+
+		parent := filepath.Dir(root)
+		if parent == root {
+			return nil, fmt.Errorf("no go.mod found for %s", dir)
+		}
+		root = parent
+	}
+}
+
+// importPath returns the import path of a directory inside the module.
+func (l *loader) importPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(l.root, abs)
+	if err != nil {
+		return "", err
+	}
+	if rel == "." {
+		return l.module, nil
+	}
+	return l.module + "/" + filepath.ToSlash(rel), nil
+}
+
+// load parses the non-test files of a package of the module.
+// The skip file, usually the generated output, is ignored.
+func (l *loader) load(importPath string, skip string) (*pkgInfo, error) {
+	if pkg, ok := l.packages[importPath]; ok {
+		return pkg, nil
+	}
+	if importPath != l.module && !strings.HasPrefix(importPath, l.module+"/") {
+		return nil, fmt.Errorf("%w: package %s is not part of module %s", errUnsupported, importPath, l.module)
+	}
+
+	dir := filepath.Join(l.root, filepath.FromSlash(strings.TrimPrefix(strings.TrimPrefix(importPath, l.module), "/")))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &pkgInfo{
+		path:  importPath,
+		dir:   dir,
+		types: map[string]*typeInfo{},
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == skip {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		file, err := parser.ParseFile(l.fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if pkg.name == "" {
+			pkg.name = file.Name.Name
+		}
+		pkg.files = append(pkg.files, file)
+
+		imports := fileImports(file)
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok || typeSpec.TypeParams != nil {
+					continue
+				}
+				pkg.types[typeSpec.Name.Name] = newTypeInfo(typeSpec.Name.Name, pkg, imports, structType)
+			}
+		}
+	}
+
+	if pkg.name == "" {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+
+	l.packages[importPath] = pkg
+	return pkg, nil
+}
+
+func newTypeInfo(name string, pkg *pkgInfo, imports map[string]string, structType *ast.StructType) *typeInfo {
+	info := &typeInfo{
+		name:    name,
+		pkg:     pkg,
+		imports: imports,
+		fields:  map[string]*fieldInfo{},
+	}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			info.embedded = true
+			continue
+		}
+
+		var validate string
+		if field.Tag != nil {
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err == nil {
+				validate = reflect.StructTag(tag).Get("validate")
+			}
+		}
+
+		expr := field.Type
+		pointer := false
+		if star, ok := expr.(*ast.StarExpr); ok {
+			pointer = true
+			expr = star.X
+		}
+
+		for _, fieldName := range field.Names {
+			info.fields[fieldName.Name] = &fieldInfo{
+				name:     fieldName.Name,
+				expr:     expr,
+				pointer:  pointer,
+				kind:     kindOf(expr, imports),
+				validate: validate,
+				owner:    info,
+			}
+		}
+	}
+
+	return info
+}
+
+// kindOf returns the kind of value common.Decode and common.Encode can convert
+// without a transform, or an empty string for any other type.
+func kindOf(expr ast.Expr, imports map[string]string) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		switch expr.Name {
+		case "bool", "string", "float32", "float64",
+			"int8", "int16", "int32", "int64",
+			"uint8", "uint16", "uint32", "uint64":
+			return expr.Name
+		case "byte":
+			return "uint8"
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := expr.X.(*ast.Ident); ok && imports[pkg.Name] == "time" {
+			switch expr.Sel.Name {
+			case "Time":
+				return "time"
+			case "Duration":
+				return "duration"
+			}
+		}
+	case *ast.ArrayType:
+		if elt, ok := expr.Elt.(*ast.Ident); ok && expr.Len == nil && (elt.Name == "byte" || elt.Name == "uint8") {
+			return "bytes"
+		}
+	}
+	return ""
+}
+
+// fileImports maps the names used in a file to import paths.
+func fileImports(file *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := defaultName(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = importPath
+	}
+	return imports
+}
+
+// defaultName guesses the package name of an import path, skipping major version suffixes.
+func defaultName(importPath string) string {
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = path.Base(path.Dir(importPath))
+	}
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+// configs returns every payload config literal of the package whose target type could be resolved.
+func (l *loader) configs(pkg *pkgInfo) ([]config, []string) {
+	configs := []config{}
+	skipped := []string{}
+
+	for _, file := range pkg.files {
+		imports := fileImports(file)
+
+		var commonName string
+		for name, importPath := range imports {
+			if importPath == commonPath {
+				commonName = name
+			}
+		}
+		if commonName == "" && pkg.path != commonPath {
+			continue
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			literal, ok := node.(*ast.CompositeLit)
+			if !ok || !isSelector(literal.Type, commonName, "PayloadConfig") {
+				return true
+			}
+			if len(literal.Elts) == 0 {
+				// empty configs are returned alongside errors
+				return false
+			}
+
+			config, err := l.parseConfig(pkg, imports, commonName, literal)
+			if err != nil {
+				position := l.fset.Position(literal.Pos())
+				skipped = append(skipped, fmt.Sprintf("%s:%d: %v", filepath.Base(position.Filename), position.Line, err))
+				return false
+			}
+			configs = append(configs, config)
+			return false
+		})
+	}
+
+	return configs, skipped
+}
+
+func (l *loader) parseConfig(pkg *pkgInfo, imports map[string]string, commonName string, literal *ast.CompositeLit) (config, error) {
+	result := config{}
+
+	for _, element := range literal.Elts {
+		keyValue, ok := element.(*ast.KeyValueExpr)
+		if !ok {
+			return config{}, fmt.Errorf("%w: unkeyed payload config", errUnsupported)
+		}
+		key, ok := keyValue.Key.(*ast.Ident)
+		if !ok {
+			return config{}, fmt.Errorf("%w: payload config key", errUnsupported)
+		}
+
+		var err error
+		switch key.Name {
+		case "Fields":
+			result.fields, err = parseLayouts(keyValue.Value, commonName, "FieldConfig")
+		case "Tags":
+			result.tags, err = parseLayouts(keyValue.Value, commonName, "TagConfig")
+			result.isTLV = len(result.tags) != 0
+		case "TargetType":
+			result.target, err = l.parseTarget(pkg, imports, keyValue.Value)
+		case "Features":
+			// features are copied from the config at runtime
+		default:
+			err = fmt.Errorf("%w: payload config key %s", errUnsupported, key.Name)
+		}
+		if err != nil {
+			return config{}, err
+		}
+	}
+
+	if result.target == nil {
+		return config{}, fmt.Errorf("%w: payload config without target type", errUnsupported)
+	}
+
+	return result, nil
+}
+
+// parseTarget resolves reflect.TypeOf(T{}) to the struct type T.
+func (l *loader) parseTarget(pkg *pkgInfo, imports map[string]string, expr ast.Expr) (*typeInfo, error) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !isSelector(call.Fun, nameOf(imports, "reflect"), "TypeOf") {
+		return nil, fmt.Errorf("%w: target type must be reflect.TypeOf(T{})", errUnsupported)
+	}
+	literal, ok := call.Args[0].(*ast.CompositeLit)
+	if !ok || len(literal.Elts) != 0 {
+		return nil, fmt.Errorf("%w: target type must be reflect.TypeOf(T{})", errUnsupported)
+	}
+
+	target := pkg
+	var name string
+	switch typeExpr := literal.Type.(type) {
+	case *ast.Ident:
+		name = typeExpr.Name
+	case *ast.SelectorExpr:
+		x, ok := typeExpr.X.(*ast.Ident)
+		if !ok || imports[x.Name] == "" {
+			return nil, fmt.Errorf("%w: target type %v", errUnsupported, typeExpr)
+		}
+		var err error
+		target, err = l.load(imports[x.Name], "")
+		if err != nil {
+			return nil, err
+		}
+		name = typeExpr.Sel.Name
+		if !ast.IsExported(name) {
+			return nil, fmt.Errorf("%w: target type %s is not exported", errUnsupported, name)
+		}
+	default:
+		return nil, fmt.Errorf("%w: target type", errUnsupported)
+	}
+
+	info, ok := target.types[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: struct type %s not found in %s", errUnsupported, name, target.path)
+	}
+	if info.embedded {
+		return nil, fmt.Errorf("%w: struct type %s embeds fields", errUnsupported, name)
+	}
+	return info, nil
+}
+
+// parseLayouts reads a []common.FieldConfig or []common.TagConfig literal.
+func parseLayouts(expr ast.Expr, commonName string, typeName string) ([]layout, error) {
+	literal, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be a slice literal", errUnsupported, typeName)
+	}
+	if array, ok := literal.Type.(*ast.ArrayType); !ok || array.Len != nil || !isSelector(array.Elt, commonName, typeName) {
+		return nil, fmt.Errorf("%w: %s must be a slice literal", errUnsupported, typeName)
+	}
+
+	layouts := []layout{}
+	for _, element := range literal.Elts {
+		item, ok := element.(*ast.CompositeLit)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s element", errUnsupported, typeName)
+		}
+
+		var result layout
+		for _, element := range item.Elts {
+			keyValue, ok := element.(*ast.KeyValueExpr)
+			if !ok {
+				return nil, fmt.Errorf("%w: unkeyed %s", errUnsupported, typeName)
+			}
+			key, ok := keyValue.Key.(*ast.Ident)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s key", errUnsupported, typeName)
+			}
+
+			var err error
+			switch key.Name {
+			case "Name":
+				result.name, err = stringValue(keyValue.Value)
+			case "Tag":
+				var tag int
+				tag, err = intValue(keyValue.Value)
+				result.tag = uint8(tag)
+			case "Start":
+				result.start, err = intValue(keyValue.Value)
+			case "Length":
+				result.length, err = intValue(keyValue.Value)
+			case "Optional":
+				result.optional, err = boolValue(keyValue.Value)
+			case "Hex":
+				result.hex, err = boolValue(keyValue.Value)
+			case "BitOffset":
+				result.bitOffset, err = intValue(keyValue.Value)
+			case "BitLength":
+				result.bitLength, err = intValue(keyValue.Value)
+			case "ByteOrder":
+				result.byteOrder, err = constValue(keyValue.Value, commonName, "BigEndian", "LittleEndian")
+			case "Signedness":
+				result.signedness, err = constValue(keyValue.Value, commonName, "SignednessFromType", "Signed", "Unsigned")
+			case "MaxCount":
+				result.maxCount, err = intValue(keyValue.Value)
+			case "Scale":
+				result.scale, err = floatValue(keyValue.Value)
+			case "Offset":
+				result.offset, err = floatValue(keyValue.Value)
+			case "Unit":
+				// units are read from the config at runtime
+				_, err = stringValue(keyValue.Value)
+			case "Group":
+				result.group, err = parseLayouts(keyValue.Value, commonName, typeName)
+			case "Transform":
+				if ident, ok := keyValue.Value.(*ast.Ident); !ok || ident.Name != "nil" {
+					result.transform = true
+				}
+			case "Feature":
+				// features are read from the config at runtime
+			default:
+				err = fmt.Errorf("%w: %s key %s", errUnsupported, typeName, key.Name)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		layouts = append(layouts, result)
+	}
+
+	return layouts, nil
+}
+
+func stringValue(expr ast.Expr) (string, error) {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return "", fmt.Errorf("%w: expected string literal", errUnsupported)
+	}
+	return strconv.Unquote(literal.Value)
+}
+
+func intValue(expr ast.Expr) (int, error) {
+	sign := 1
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.SUB {
+		sign = -1
+		expr = unary.X
+	}
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.INT {
+		return 0, fmt.Errorf("%w: expected integer literal", errUnsupported)
+	}
+	value, err := strconv.ParseInt(literal.Value, 0, 64)
+	if err != nil {
+		return 0, err
+	}
+	return sign * int(value), nil
+}
+
+func floatValue(expr ast.Expr) (float64, error) {
+	sign := 1.0
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.SUB {
+		sign = -1
+		expr = unary.X
+	}
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || (literal.Kind != token.INT && literal.Kind != token.FLOAT) {
+		return 0, fmt.Errorf("%w: expected number literal", errUnsupported)
+	}
+	value, err := strconv.ParseFloat(literal.Value, 64)
+	if err != nil {
+		return 0, err
+	}
+	return sign * value, nil
+}
+
+func boolValue(expr ast.Expr) (bool, error) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok || (ident.Name != "true" && ident.Name != "false") {
+		return false, fmt.Errorf("%w: expected boolean literal", errUnsupported)
+	}
+	return ident.Name == "true", nil
+}
+
+// constValue returns the name of one of the given constants of the common
+// package, or an empty string for the first one, which is the default.
+func constValue(expr ast.Expr, commonName string, names ...string) (string, error) {
+	for i, name := range names {
+		if isSelector(expr, commonName, name) {
+			if i == 0 {
+				return "", nil
+			}
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("%w: expected one of the constants %s", errUnsupported, strings.Join(names, ", "))
+}
+
+func isSelector(expr ast.Expr, pkg string, name string) bool {
+	if pkg == "" {
+		ident, ok := expr.(*ast.Ident)
+		return ok && ident.Name == name
+	}
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	x, ok := selector.X.(*ast.Ident)
+	return ok && x.Name == pkg && selector.Sel.Name == name
+}
+
+// nameOf returns the name a file uses for an import path.
+func nameOf(imports map[string]string, importPath string) string {
+	for name, p := range imports {
+		if p == importPath {
+			return name
+		}
+	}
+	return "\x00"
+}
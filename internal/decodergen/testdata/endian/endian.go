@@ -0,0 +1,35 @@
+// Package endian holds payload configs with little-endian and signed fields
+// and tags for the tests of the generator.
+package endian
+
+import (
+	"reflect"
+
+	"github.com/truvami/decoder/pkg/common"
+)
+
+type Payload struct {
+	SystemTime  uint32 `json:"systemTime"`
+	Temperature int16  `json:"temperature"`
+	Offset      int8   `json:"offset"`
+}
+
+var config = common.PayloadConfig{
+	Fields: []common.FieldConfig{
+		{Name: "SystemTime", Start: 0, Length: 4, ByteOrder: common.LittleEndian},
+		{Name: "Temperature", Start: 4, Length: 2, ByteOrder: common.LittleEndian, Signedness: common.Signed},
+		{Name: "Offset", Start: 6, Length: 1, BitOffset: 4, BitLength: 4, Signedness: common.Signed},
+	},
+	TargetType: reflect.TypeOf(Payload{}),
+}
+
+type TagPayload struct {
+	Temperature *int16 `json:"temperature"`
+}
+
+var tagConfig = common.PayloadConfig{
+	Tags: []common.TagConfig{
+		{Name: "Temperature", Tag: 0x47, Optional: true, Signedness: common.Signed},
+	},
+	TargetType: reflect.TypeOf(TagPayload{}),
+}
@@ -0,0 +1,27 @@
+// Package fuzztest holds the checks shared by the fuzz targets of the decoders.
+package fuzztest
+
+import "testing"
+
+const (
+	// BaseAllocations is the number of allocations any decode may make.
+	BaseAllocations = 1024
+	// AllocationsPerByte is the number of allocations a decode may make in
+	// addition for every byte of the payload.
+	AllocationsPerByte = 16
+)
+
+// CheckAllocations runs the decode and fails the test if it allocates more
+// often than BaseAllocations plus AllocationsPerByte for every byte of the
+// payload. The allocations are counted with testing.AllocsPerRun, which
+// measures a single call with GOMAXPROCS set to 1. Panics fail the fuzz target
+// on their own.
+func CheckAllocations(t *testing.T, payload []byte, decode func()) {
+	t.Helper()
+
+	allocations := testing.AllocsPerRun(1, decode)
+	limit := float64(BaseAllocations + AllocationsPerByte*len(payload))
+	if allocations > limit {
+		t.Errorf("decoding %d bytes allocated %.0f times, expected at most %.0f", len(payload), allocations, limit)
+	}
+}
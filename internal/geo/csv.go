@@ -0,0 +1,61 @@
+package geo
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// NormalizeMAC returns the MAC address in lower case without separators, like
+// the decoders report it.
+func NormalizeMAC(mac string) string {
+	return strings.NewReplacer(":", "", "-", "", ".", "").Replace(strings.ToLower(strings.TrimSpace(mac)))
+}
+
+// ReadCSV reads a row of the CSV data with parse per line. A first row parse
+// fails for is skipped as header, lines starting with # are comments.
+func ReadCSV[T any](r io.Reader, parse func(record []string) (T, error)) ([]T, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	rows := []T{}
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := parse(record)
+		if err != nil {
+			if row == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		rows = append(rows, value)
+	}
+}
+
+// ParsePosition parses the latitude and longitude in degrees.
+func ParsePosition(latitude string, longitude string) (float64, float64, error) {
+	lat, err := strconv.ParseFloat(strings.TrimSpace(latitude), 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(longitude), 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return 0, 0, fmt.Errorf("invalid position %v, %v", latitude, longitude)
+	}
+	return lat, lon, nil
+}
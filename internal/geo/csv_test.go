@@ -0,0 +1,75 @@
+package geo
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeMAC(t *testing.T) {
+	for _, mac := range []string{"F0:52:FA:B9:20:FE", "f0-52-fa-b9-20-fe", " f052.fab9.20fe ", "f052fab920fe"} {
+		if normalized := NormalizeMAC(mac); normalized != "f052fab920fe" {
+			t.Errorf("expected f052fab920fe for %q, got %q", mac, normalized)
+		}
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	type row struct {
+		mac       string
+		latitude  float64
+		longitude float64
+	}
+
+	parse := func(record []string) (row, error) {
+		if len(record) != 3 {
+			return row{}, errors.New("expected 3 fields")
+		}
+		latitude, longitude, err := ParsePosition(record[1], record[2])
+		if err != nil {
+			return row{}, err
+		}
+		return row{mac: NormalizeMAC(record[0]), latitude: latitude, longitude: longitude}, nil
+	}
+
+	tests := []struct {
+		name     string
+		csv      string
+		expected []row
+		err      bool
+	}{
+		{
+			name:     "header and comments",
+			csv:      "mac,latitude,longitude\n# lobby\nF0:52:FA:B9:20:FE, 47.5, 8.25\n",
+			expected: []row{{mac: "f052fab920fe", latitude: 47.5, longitude: 8.25}},
+		},
+		{
+			name:     "without header",
+			csv:      "f052fab920fe,47.5,8.25\n",
+			expected: []row{{mac: "f052fab920fe", latitude: 47.5, longitude: 8.25}},
+		},
+		{
+			name: "invalid row",
+			csv:  "mac,latitude,longitude\nf052fab920fe,47.5\n",
+			err:  true,
+		},
+		{
+			name: "invalid position",
+			csv:  "mac,latitude,longitude\nf052fab920fe,97.5,8.25\n",
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rows, err := ReadCSV(strings.NewReader(test.csv), parse)
+			if (err != nil) != test.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.err && !reflect.DeepEqual(rows, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, rows)
+			}
+		})
+	}
+}
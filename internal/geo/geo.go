@@ -0,0 +1,63 @@
+package geo
+
+import "math"
+
+const (
+	earthRadius = 6371000
+
+	// defaultRssi is the RSSI in dBm of signals reported without one.
+	defaultRssi = -90
+)
+
+// Point is a known position weighted for a centroid.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+	// Accuracy is the radius in meters around the position.
+	Accuracy float64
+	Weight   float64
+}
+
+// Weight returns the received power 10^(RSSI/10) in mW of the RSSI in dBm,
+// assuming -90 dBm if the RSSI is nil.
+func Weight(rssi *int8) float64 {
+	value := defaultRssi
+	if rssi != nil {
+		value = int(*rssi)
+	}
+	return math.Pow(10, float64(value)/10)
+}
+
+// Centroid returns the weighted centroid of the points. Its accuracy is the
+// weighted root mean square of the distances to the points combined with their
+// accuracy. Centroid returns zeros for points without weight.
+func Centroid(points []Point) (latitude float64, longitude float64, accuracy float64) {
+	var totalWeight float64
+	for _, point := range points {
+		totalWeight += point.Weight
+	}
+	if totalWeight == 0 {
+		return 0, 0, 0
+	}
+
+	for _, point := range points {
+		latitude += point.Latitude * point.Weight / totalWeight
+		longitude += point.Longitude * point.Weight / totalWeight
+	}
+
+	var variance float64
+	for _, point := range points {
+		distance := Distance(latitude, longitude, point.Latitude, point.Longitude)
+		variance += (distance*distance + point.Accuracy*point.Accuracy) * point.Weight / totalWeight
+	}
+
+	return latitude, longitude, math.Sqrt(variance)
+}
+
+// Distance returns the distance in meters between the positions, accurate for
+// the short distances between access points or beacons.
+func Distance(latitude1, longitude1, latitude2, longitude2 float64) float64 {
+	x := (longitude2 - longitude1) * math.Cos((latitude1+latitude2)/2*math.Pi/180)
+	y := latitude2 - latitude1
+	return math.Sqrt(x*x+y*y) * math.Pi / 180 * earthRadius
+}
@@ -0,0 +1,54 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCentroid(t *testing.T) {
+	tests := []struct {
+		name      string
+		points    []Point
+		latitude  float64
+		longitude float64
+		accuracy  float64
+	}{
+		{
+			name:      "single",
+			points:    []Point{{Latitude: 47, Longitude: 8, Accuracy: 10, Weight: 1}},
+			latitude:  47,
+			longitude: 8,
+			accuracy:  10,
+		},
+		{
+			name:      "equal weights",
+			points:    []Point{{Latitude: 47, Longitude: 8, Accuracy: 10, Weight: 1}, {Latitude: 47, Longitude: 8.001, Accuracy: 10, Weight: 1}},
+			latitude:  47,
+			longitude: 8.0005,
+			accuracy:  39.21,
+		},
+		{
+			name:   "no weight",
+			points: []Point{{Latitude: 47, Longitude: 8, Accuracy: 10}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			latitude, longitude, accuracy := Centroid(test.points)
+			if math.Abs(latitude-test.latitude) > 1e-9 || math.Abs(longitude-test.longitude) > 1e-9 || math.Abs(accuracy-test.accuracy) > 0.01 {
+				t.Errorf("expected %v %v %v, got %v %v %v", test.latitude, test.longitude, test.accuracy, latitude, longitude, accuracy)
+			}
+		})
+	}
+}
+
+func TestWeight(t *testing.T) {
+	rssi := int8(-60)
+	if Weight(&rssi) != 1e-6 {
+		t.Errorf("expected 1e-6, got %v", Weight(&rssi))
+	}
+	if Weight(nil) != 1e-9 {
+		t.Errorf("expected 1e-9, got %v", Weight(nil))
+	}
+}
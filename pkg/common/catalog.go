@@ -0,0 +1,63 @@
+package common
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+// DescribePort returns the catalog entry of the payload config of a port. The
+// version is nil for ports without versions.
+func DescribePort(port uint8, version *uint8, config PayloadConfig, docs string) decoder.PortInfo {
+	info := decoder.PortInfo{
+		Port:        port,
+		Version:     version,
+		PayloadType: config.TargetType,
+		Features:    append([]decoder.Feature{}, config.Features...),
+		Fields:      []decoder.FieldInfo{},
+		Docs:        docs,
+	}
+	if config.TargetType != nil && config.TargetType.Name() != "" {
+		info.Payload = config.TargetType.String()
+	}
+
+	for _, field := range config.Fields {
+		info.Fields = append(info.Fields, describeField(field, ""))
+		for _, member := range field.Group {
+			info.Fields = append(info.Fields, describeField(member, field.Name+"."))
+		}
+	}
+
+	for _, tag := range config.Tags {
+		info.Fields = append(info.Fields, decoder.FieldInfo{
+			Name:      tag.Name,
+			Tag:       fmt.Sprintf("0x%02x", tag.Tag),
+			BitOffset: tag.BitOffset,
+			BitLength: tag.BitLength,
+			Optional:  tag.Optional,
+			Unit:      tag.Unit,
+			Scale:     tag.Scale,
+			Offset:    tag.Offset,
+		})
+		if tag.Feature != "" && !slices.Contains(info.Features, tag.Feature) {
+			info.Features = append(info.Features, tag.Feature)
+		}
+	}
+
+	return info
+}
+
+func describeField(field FieldConfig, prefix string) decoder.FieldInfo {
+	return decoder.FieldInfo{
+		Name:      prefix + field.Name,
+		Start:     field.Start,
+		Length:    field.Length,
+		BitOffset: field.BitOffset,
+		BitLength: field.BitLength,
+		Optional:  field.Optional,
+		Unit:      field.Unit,
+		Scale:     field.Scale,
+		Offset:    field.Offset,
+	}
+}
@@ -0,0 +1,107 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+func TestDescribePort(t *testing.T) {
+	type record struct {
+		Mac  string
+		Rssi int8
+	}
+	type fieldsPayload struct {
+		Moving       bool
+		Level        float64
+		AccessPoints []record
+	}
+	type tagsPayload struct {
+		Battery *float32
+	}
+
+	unnamed := reflect.StructOf([]reflect.StructField{{Name: "Value", Type: reflect.TypeOf(uint8(0))}})
+	version := uint8(2)
+
+	tests := []struct {
+		name     string
+		version  *uint8
+		config   PayloadConfig
+		expected decoder.PortInfo
+	}{
+		{
+			name:    "Fields",
+			version: &version,
+			config: PayloadConfig{
+				Fields: []FieldConfig{
+					{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+					{Name: "Level", Start: 8, Length: 1, Scale: 0.5, Offset: -10, Unit: "dB"},
+					{Name: "AccessPoints", Start: 1, Length: 7, Optional: true, Group: []FieldConfig{
+						{Name: "Mac", Start: 0, Length: 6, Hex: true},
+						{Name: "Rssi", Start: 6, Length: 1, Signedness: Signed, Unit: "dBm"},
+					}},
+				},
+				TargetType: reflect.TypeOf(fieldsPayload{}),
+				Features:   []decoder.Feature{decoder.FeatureMoving, decoder.FeatureWiFi},
+			},
+			expected: decoder.PortInfo{
+				Port:        5,
+				Version:     &version,
+				Payload:     "common.fieldsPayload",
+				PayloadType: reflect.TypeOf(fieldsPayload{}),
+				Features:    []decoder.Feature{decoder.FeatureMoving, decoder.FeatureWiFi},
+				Fields: []decoder.FieldInfo{
+					{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+					{Name: "Level", Start: 8, Length: 1, Unit: "dB", Scale: 0.5, Offset: -10},
+					{Name: "AccessPoints", Start: 1, Length: 7, Optional: true},
+					{Name: "AccessPoints.Mac", Start: 0, Length: 6},
+					{Name: "AccessPoints.Rssi", Start: 6, Length: 1, Unit: "dBm"},
+				},
+				Docs: "https://docs.truvami.com",
+			},
+		},
+		{
+			name: "Tags",
+			config: PayloadConfig{
+				Tags: []TagConfig{
+					{Name: "Battery", Tag: 0x45, Optional: true, Feature: decoder.FeatureBattery, Unit: "V"},
+				},
+				TargetType: reflect.TypeOf(tagsPayload{}),
+				Features:   []decoder.Feature{},
+			},
+			expected: decoder.PortInfo{
+				Port:        5,
+				Payload:     "common.tagsPayload",
+				PayloadType: reflect.TypeOf(tagsPayload{}),
+				Features:    []decoder.Feature{decoder.FeatureBattery},
+				Fields: []decoder.FieldInfo{
+					{Name: "Battery", Tag: "0x45", Optional: true, Unit: "V"},
+				},
+				Docs: "https://docs.truvami.com",
+			},
+		},
+		{
+			name: "Unnamed",
+			config: PayloadConfig{
+				TargetType: unnamed,
+			},
+			expected: decoder.PortInfo{
+				Port:        5,
+				PayloadType: unnamed,
+				Features:    []decoder.Feature{},
+				Fields:      []decoder.FieldInfo{},
+				Docs:        "https://docs.truvami.com",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info := DescribePort(5, test.version, test.config, "https://docs.truvami.com")
+			if !reflect.DeepEqual(info, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, info)
+			}
+		})
+	}
+}
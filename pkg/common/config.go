@@ -13,8 +13,60 @@ type TagConfig struct {
 	Feature   decoder.Feature
 	Transform func(any) any
 	Hex       bool
+	// Unit is the unit of the decoded value, e.g. "V".
+	Unit string
+	// BitOffset and BitLength address a bit range inside the tag value,
+	// counted from the least significant bit. A BitLength of 0 uses the whole value.
+	BitOffset int
+	BitLength int
+	// Signedness describes the integer stored in the tag, or in its bit range, like
+	// the Signedness of a FieldConfig.
+	Signedness Signedness
+	// Scale and Offset declare the physical value of the integer stored in the
+	// tag like the Scale and Offset of a FieldConfig, e.g. a Scale of 0.001 for a
+	// battery voltage in millivolts.
+	Scale  float64
+	Offset float64
 }
 
+// field returns the field config the value of the tag is converted with.
+func (t TagConfig) field() FieldConfig {
+	return FieldConfig{
+		Name:       t.Name,
+		Transform:  t.Transform,
+		Hex:        t.Hex,
+		BitOffset:  t.BitOffset,
+		BitLength:  t.BitLength,
+		Signedness: t.Signedness,
+		Unit:       t.Unit,
+		Scale:      t.Scale,
+		Offset:     t.Offset,
+	}
+}
+
+// ByteOrder is the order of the bytes of a field.
+type ByteOrder uint8
+
+const (
+	// BigEndian stores the most significant byte first. It is the default.
+	BigEndian ByteOrder = iota
+	// LittleEndian stores the least significant byte first.
+	LittleEndian
+)
+
+// Signedness declares how the bytes of an integer field are interpreted.
+type Signedness uint8
+
+const (
+	// SignednessFromType converts the bytes as they are, so a field shorter than
+	// its integer type is zero extended. It is the default.
+	SignednessFromType Signedness = iota
+	// Signed interprets the bytes, or the bit range, as two's complement number.
+	Signed
+	// Unsigned interprets the bytes, or the bit range, as unsigned number.
+	Unsigned
+)
+
 type FieldConfig struct {
 	Name      string
 	Start     int
@@ -22,6 +74,34 @@ type FieldConfig struct {
 	Transform func(any) any
 	Optional  bool
 	Hex       bool
+	// BitOffset and BitLength address a bit range inside the field bytes,
+	// counted from the least significant bit. A BitLength of 0 uses the whole field.
+	// Several fields may share the same bytes as long as their bit ranges do not overlap.
+	BitOffset int
+	BitLength int
+	// ByteOrder and Signedness describe the number stored in the field bytes. The
+	// bytes are put in big-endian order before bit ranges are taken, the transform
+	// is called or the value is converted. A Signed or Unsigned field is extended
+	// to 8 bytes first, so it fits any integer type.
+	ByteOrder  ByteOrder
+	Signedness Signedness
+	// Group turns the field into a repeated group. A record of Length bytes made of
+	// the Group fields, positioned relative to the start of the record, is repeated
+	// from Start until the payload ends or MaxCount records have been read. The
+	// records are decoded into a slice of structs. A group which is not Optional
+	// needs at least one record, a MaxCount of 0 does not limit the records.
+	Group    []FieldConfig
+	MaxCount int
+	// Unit is the unit of the decoded value, e.g. "m" or "°C".
+	Unit string
+	// Scale and Offset declare the physical value of the integer stored in the
+	// field, integer * Scale + Offset, e.g. a Scale of 0.000001 for a latitude in
+	// micro degrees. Encode applies the inverse and rounds. The field is unsigned
+	// unless its Signedness is Signed and may be a float, an integer, a
+	// time.Time in unix seconds or a time.Duration in seconds. A Transform
+	// takes precedence, a Scale of 0 keeps the integer unscaled.
+	Scale  float64
+	Offset float64
 }
 
 // PayloadConfig defines the overall structure of the payload, including the target struct type
@@ -30,4 +110,13 @@ type PayloadConfig struct {
 	Fields     []FieldConfig
 	TargetType reflect.Type
 	Features   []decoder.Feature
+	// Lenient fills every field the payload has bytes for instead of failing on the
+	// first missing one. The missing fields are reported as a PartialDecodeError.
+	Lenient bool
+}
+
+// PayloadConfigProvider is implemented by encoders which lay out their payloads
+// along a PayloadConfig, e.g. to check their round trip with RoundTrip.
+type PayloadConfigProvider interface {
+	PayloadConfig(port uint8) (PayloadConfig, error)
 }
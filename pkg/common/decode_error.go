@@ -0,0 +1,156 @@
+package common
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// rawContext is the number of bytes a DecodeError keeps on each side of its offset.
+const rawContext = 8
+
+// DecodeError describes where decoding a payload failed. Its message is the one
+// of the wrapped error, which remains available to errors.Is and errors.As.
+type DecodeError struct {
+	err error
+
+	device string
+	port   uint8
+	field  string
+
+	offset    int
+	hasOffset bool
+	tag       uint8
+	hasTag    bool
+
+	expectedLength int
+	actualLength   int
+
+	raw       []byte
+	rawOffset int
+	hasRaw    bool
+}
+
+func (e *DecodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.err
+}
+
+// Device returns the device and version of the decoder, e.g. tagsl/v1.
+func (e *DecodeError) Device() string {
+	return e.device
+}
+
+// Port returns the LoRaWAN port of the payload.
+func (e *DecodeError) Port() uint8 {
+	return e.port
+}
+
+// Field returns the name of the field that could not be decoded, if any.
+func (e *DecodeError) Field() string {
+	return e.field
+}
+
+// Offset returns the byte offset in the payload at which decoding failed.
+func (e *DecodeError) Offset() (int, bool) {
+	return e.offset, e.hasOffset
+}
+
+// Tag returns the TLV tag that could not be decoded.
+func (e *DecodeError) Tag() (uint8, bool) {
+	return e.tag, e.hasTag
+}
+
+// ExpectedLength returns the number of bytes required at the offset, or the
+// minimum or maximum payload length for length errors. It is 0 if not known.
+func (e *DecodeError) ExpectedLength() int {
+	return e.expectedLength
+}
+
+// ActualLength returns the number of bytes that were available.
+func (e *DecodeError) ActualLength() int {
+	return e.actualLength
+}
+
+// Raw returns up to 8 bytes of the payload on each side of the offset.
+func (e *DecodeError) Raw() []byte {
+	return e.raw
+}
+
+// RawOffset returns the offset of the first byte of Raw in the payload.
+func (e *DecodeError) RawOffset() int {
+	return e.rawOffset
+}
+
+func (e *DecodeError) setRaw(payload []byte) {
+	start := max(min(e.offset, len(payload))-rawContext, 0)
+	end := min(e.offset+rawContext, len(payload))
+
+	e.raw = append([]byte{}, payload[start:end]...)
+	e.rawOffset = start
+	e.hasRaw = true
+}
+
+func (e *DecodeError) MarshalJSON() ([]byte, error) {
+	type diagnostics struct {
+		Error          string `json:"error"`
+		Device         string `json:"device,omitempty"`
+		Port           uint8  `json:"port,omitempty"`
+		Field          string `json:"field,omitempty"`
+		Offset         *int   `json:"offset,omitempty"`
+		Tag            string `json:"tag,omitempty"`
+		ExpectedLength int    `json:"expectedLength,omitempty"`
+		ActualLength   int    `json:"actualLength"`
+		Raw            string `json:"raw"`
+		RawOffset      int    `json:"rawOffset"`
+	}
+
+	d := diagnostics{
+		Error:          e.Error(),
+		Device:         e.device,
+		Port:           e.port,
+		Field:          e.field,
+		ExpectedLength: e.expectedLength,
+		ActualLength:   e.actualLength,
+		Raw:            hex.EncodeToString(e.raw),
+		RawOffset:      e.rawOffset,
+	}
+	if e.hasOffset {
+		d.Offset = &e.offset
+	}
+	if e.hasTag {
+		d.Tag = fmt.Sprintf("0x%02x", e.tag)
+	}
+	return json.Marshal(d)
+}
+
+// WrapDecodeError adds the device, port and payload to the DecodeError in err,
+// or wraps err into a new DecodeError. Validation errors and the missing fields
+// of a lenient decode are returned as they are, since they come with data.
+func WrapDecodeError(err error, device string, port uint8, payload []byte) error {
+	if err == nil {
+		return nil
+	}
+
+	var decodeError *DecodeError
+	if !errors.As(err, &decodeError) {
+		var partial *PartialDecodeError
+		if errors.Is(err, ErrValidationFailed) || errors.As(err, &partial) {
+			return err
+		}
+
+		decodeError = &DecodeError{err: err, actualLength: len(payload)}
+		err = decodeError
+	}
+
+	decodeError.device = device
+	decodeError.port = port
+	if !decodeError.hasRaw && payload != nil {
+		decodeError.setRaw(payload)
+	}
+	return err
+}
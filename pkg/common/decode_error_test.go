@@ -0,0 +1,169 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeError(t *testing.T) {
+	config := PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "Battery", Start: 0, Length: 2},
+			{Name: "Latitude", Start: 2, Length: 1},
+		},
+		TargetType: reflect.TypeOf(lenientPayload{}),
+	}
+	tlvConfig := PayloadConfig{
+		Tags: []TagConfig{
+			{Name: "Battery", Tag: 0x45, Optional: true},
+		},
+		TargetType: reflect.TypeOf(lenientPayload{}),
+	}
+
+	tests := []struct {
+		name      string
+		err       func() error
+		sentinel  error
+		field     string
+		offset    int
+		tag       *uint8
+		expected  int
+		actual    int
+		raw       []byte
+		rawOffset int
+	}{
+		{
+			name: "PayloadTooShort",
+			err: func() error {
+				return ValidateBytesLength([]byte{0x0e, 0x10}, &config)
+			},
+			sentinel: ErrPayloadTooShort,
+			offset:   2,
+			expected: 3,
+			actual:   2,
+		},
+		{
+			name: "PayloadTooLong",
+			err: func() error {
+				return ValidateBytesLength([]byte{0x0e, 0x10, 0x2a, 0x00}, &config)
+			},
+			sentinel: ErrPayloadTooLong,
+			offset:   3,
+			expected: 3,
+			actual:   4,
+		},
+		{
+			name: "FieldOutOfBounds",
+			err: func() error {
+				_, err := DecodeBytes([]byte{0x0e, 0x10}, &config)
+				return err
+			},
+			sentinel: ErrFieldOutOfBounds,
+			field:    "Latitude",
+			offset:   2,
+			expected: 1,
+			actual:   0,
+			raw:      []byte{0x0e, 0x10},
+		},
+		{
+			name: "TLVTruncated",
+			err: func() error {
+				_, err := DecodeBytes([]byte{0x00, 0x00, 0x00, 0x45, 0x02, 0x0e}, &tlvConfig)
+				return err
+			},
+			offset:   3,
+			tag:      Uint8Ptr(0x45),
+			expected: 2,
+			actual:   1,
+			raw:      []byte{0x00, 0x00, 0x00, 0x45, 0x02, 0x0e},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.err()
+
+			var decodeError *DecodeError
+			if !errors.As(err, &decodeError) {
+				t.Fatalf("expected decode error, got %v", err)
+			}
+			if test.sentinel != nil && !errors.Is(err, test.sentinel) {
+				t.Errorf("expected error to match %v", test.sentinel)
+			}
+
+			if decodeError.Field() != test.field {
+				t.Errorf("expected field %q, got %q", test.field, decodeError.Field())
+			}
+			if offset, ok := decodeError.Offset(); !ok || offset != test.offset {
+				t.Errorf("expected offset %d, got %d", test.offset, offset)
+			}
+			if tag, ok := decodeError.Tag(); ok != (test.tag != nil) || (ok && tag != *test.tag) {
+				t.Errorf("expected tag %v, got 0x%02x", test.tag, tag)
+			}
+			if decodeError.ExpectedLength() != test.expected || decodeError.ActualLength() != test.actual {
+				t.Errorf("expected length %d of %d, got %d of %d", test.actual, test.expected, decodeError.ActualLength(), decodeError.ExpectedLength())
+			}
+			if !reflect.DeepEqual(decodeError.Raw(), test.raw) || decodeError.RawOffset() != test.rawOffset {
+				t.Errorf("expected raw %x at %d, got %x at %d", test.raw, test.rawOffset, decodeError.Raw(), decodeError.RawOffset())
+			}
+		})
+	}
+}
+
+func TestWrapDecodeError(t *testing.T) {
+	if WrapDecodeError(nil, "tagsl/v1", 1, nil) != nil {
+		t.Error("expected nil error")
+	}
+
+	validation := errors.Join(ErrValidationFailed)
+	if WrapDecodeError(validation, "tagsl/v1", 1, nil) != validation {
+		t.Error("expected validation errors to be returned as they are")
+	}
+
+	partial := errors.Join(&PartialDecodeError{})
+	if WrapDecodeError(partial, "tagsl/v1", 1, nil) != partial {
+		t.Error("expected partial decode errors to be returned as they are")
+	}
+
+	payload := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09}
+	err := WrapDecodeError(ErrPortNotSupported, "tagsl/v1", 42, payload)
+
+	var decodeError *DecodeError
+	if !errors.As(err, &decodeError) || !errors.Is(err, ErrPortNotSupported) {
+		t.Fatalf("expected decode error for port not supported, got %v", err)
+	}
+	if err.Error() != ErrPortNotSupported.Error() {
+		t.Errorf("expected message %q, got %q", ErrPortNotSupported.Error(), err.Error())
+	}
+	if decodeError.Device() != "tagsl/v1" || decodeError.Port() != 42 {
+		t.Errorf("expected tagsl/v1 on port 42, got %s on port %d", decodeError.Device(), decodeError.Port())
+	}
+	if _, ok := decodeError.Offset(); ok {
+		t.Error("expected no offset")
+	}
+	if !reflect.DeepEqual(decodeError.Raw(), payload[:8]) {
+		t.Errorf("expected raw %x, got %x", payload[:8], decodeError.Raw())
+	}
+
+	// existing decode errors keep their diagnostics
+	config := PayloadConfig{
+		Fields:     []FieldConfig{{Name: "Battery", Start: 0, Length: 2}},
+		TargetType: reflect.TypeOf(lenientPayload{}),
+	}
+	_, err = DecodeBytes(payload[:1], &config)
+	err = WrapDecodeError(err, "nomadxs/v1", 1, payload[:1])
+	if !errors.As(err, &decodeError) || decodeError.Field() != "Battery" || decodeError.Device() != "nomadxs/v1" {
+		t.Errorf("expected decode error of Battery for nomadxs/v1, got %v", err)
+	}
+
+	marshaled, err := json.Marshal(decodeError)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"error":"field out of bounds","device":"nomadxs/v1","port":1,"field":"Battery","offset":0,"expectedLength":2,"actualLength":1,"raw":"00","rawOffset":0}`
+	if string(marshaled) != expected {
+		t.Errorf("expected %s, got %s", expected, marshaled)
+	}
+}
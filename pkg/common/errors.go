@@ -3,6 +3,7 @@ package common
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -14,9 +15,14 @@ var (
 
 	ErrValidationFailed = errors.New("validation failed")
 
+	ErrFieldOutOfBounds = errors.New("field out of bounds")
+
 	ErrSolverFailed = errors.New("solver failed")
 
 	ErrGNSSNGHeaderByteMissing = errors.New("GNSS-NG header byte missing")
+
+	ErrMissingDevEUI       = errors.New("DevEUI missing")
+	ErrMissingFrameCounter = errors.New("frame counter missing")
 )
 
 // WrapError wraps two errors into a single error, combining the parent and child errors.
@@ -49,6 +55,45 @@ func WrapErrorWithMessage(parent error, child error, message string) error {
 	return fmt.Errorf("%s: %w: %w", message, parent, child)
 }
 
+// FieldError describes a field that a lenient decode could not read,
+// because the payload ends before the field does.
+type FieldError struct {
+	Name      string `json:"name"`
+	Offset    int    `json:"offset"`
+	Length    int    `json:"length"`
+	Available int    `json:"available"`
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("field %s out of bounds at offset %d: need %d bytes but only %d available", e.Name, e.Offset, e.Length, e.Available)
+}
+
+func (e FieldError) Unwrap() error {
+	return ErrFieldOutOfBounds
+}
+
+// PartialDecodeError is returned by a lenient decode that left fields empty.
+// Use errors.As to get the list of missing fields.
+type PartialDecodeError struct {
+	Fields []FieldError `json:"fields"`
+}
+
+func (e *PartialDecodeError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Error()
+	}
+	return fmt.Sprintf("partial payload, %d fields missing: %s", len(e.Fields), strings.Join(messages, ", "))
+}
+
+func (e *PartialDecodeError) Unwrap() []error {
+	errs := make([]error, len(e.Fields))
+	for i, field := range e.Fields {
+		errs[i] = field
+	}
+	return errs
+}
+
 func UnwrapError(err error) []error {
 	var errs = []error{}
 	if err, ok := err.(interface{ Unwrap() []error }); ok {
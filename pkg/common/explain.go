@@ -0,0 +1,221 @@
+package common
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+// Explain describes how each field of the config is decoded from the payload.
+// Unlike Decode it continues after missing fields and failed validations, so
+// the explanation of a broken payload shows where it stops making sense.
+func Explain(payload []byte, config *PayloadConfig) ([]decoder.FieldExplanation, error) {
+	explanations := []decoder.FieldExplanation{}
+
+	if len(config.Tags) != 0 {
+		var index = 3
+		for index < len(payload) {
+			tag, length, err := ReadTLVHeader(payload, index)
+			if err != nil {
+				return explanations, err
+			}
+			index += 2
+
+			var found bool
+			for _, tagConfig := range config.Tags {
+				if tagConfig.Tag != tag {
+					continue
+				}
+				found = true
+
+				explanation := decoder.FieldExplanation{
+					Name:      tagConfig.Name,
+					Tag:       fmt.Sprintf("0x%02x", tag),
+					Start:     index,
+					Length:    length,
+					BitOffset: tagConfig.BitOffset,
+					BitLength: tagConfig.BitLength,
+					Unit:      tagConfig.Unit,
+					Valid:     true,
+				}
+				structField, ok := config.TargetType.FieldByName(tagConfig.Name)
+				explainValue(&explanation, payload[index:index+length], structField, ok, tagConfig.field())
+				explanations = append(explanations, explanation)
+			}
+			if !found {
+				explanations = append(explanations, decoder.FieldExplanation{
+					Tag:    fmt.Sprintf("0x%02x", tag),
+					Start:  index,
+					Length: length,
+					Hex:    hex.EncodeToString(payload[index : index+length]),
+					Valid:  true,
+					Error:  "unknown tag",
+				})
+			}
+			index += length
+		}
+
+		return explanations, nil
+	}
+
+	for _, field := range config.Fields {
+		if len(field.Group) != 0 {
+			explanations = append(explanations, explainGroup(payload, field, config.TargetType)...)
+			continue
+		}
+
+		explanation := decoder.FieldExplanation{
+			Name:      field.Name,
+			Start:     field.Start,
+			Length:    field.Length,
+			BitOffset: field.BitOffset,
+			BitLength: field.BitLength,
+			Unit:      field.Unit,
+			Valid:     true,
+		}
+
+		raw, err := FieldBytes(payload, field.Start, field.Length, field.Optional)
+		switch {
+		case err != nil:
+			explanation.Missing = true
+			explanation.Valid = false
+			explanation.Error = FieldError{
+				Name:      field.Name,
+				Offset:    field.Start,
+				Length:    max(field.Length, 1),
+				Available: max(len(payload)-field.Start, 0),
+			}.Error()
+		case raw == nil:
+			explanation.Missing = true
+		default:
+			explanation.Length = len(raw)
+			structField, ok := config.TargetType.FieldByName(field.Name)
+			explainValue(&explanation, raw, structField, ok, field)
+		}
+
+		explanations = append(explanations, explanation)
+	}
+
+	return explanations, nil
+}
+
+// explainGroup describes the fields of every record of a repeated group.
+func explainGroup(payload []byte, field FieldConfig, targetType reflect.Type) []decoder.FieldExplanation {
+	explanations := []decoder.FieldExplanation{}
+
+	var itemType reflect.Type
+	if structField, ok := targetType.FieldByName(field.Name); ok && structField.IsExported() && structField.Type.Kind() == reflect.Slice {
+		itemType = structField.Type.Elem()
+	}
+
+	for i := 0; field.MaxCount == 0 || i < field.MaxCount; i++ {
+		start := GroupStart(field, i)
+
+		raw, err := FieldBytes(payload, start, field.Length, i > 0 || field.Optional)
+		if err != nil {
+			explanations = append(explanations, decoder.FieldExplanation{
+				Name:    field.Name,
+				Start:   start,
+				Length:  field.Length,
+				Missing: true,
+				Error: FieldError{
+					Name:      field.Name,
+					Offset:    start,
+					Length:    field.Length,
+					Available: max(len(payload)-start, 0),
+				}.Error(),
+			})
+			break
+		}
+		if raw == nil {
+			if i == 0 {
+				explanations = append(explanations, decoder.FieldExplanation{
+					Name:    field.Name,
+					Start:   start,
+					Length:  field.Length,
+					Missing: true,
+					Valid:   true,
+				})
+			}
+			break
+		}
+
+		for _, member := range field.Group {
+			explanation := decoder.FieldExplanation{
+				Name:      GroupFieldName(field.Name, i, member.Name),
+				Start:     start + member.Start,
+				Length:    member.Length,
+				BitOffset: member.BitOffset,
+				BitLength: member.BitLength,
+				Unit:      member.Unit,
+				Valid:     true,
+			}
+
+			var structField reflect.StructField
+			var ok bool
+			if itemType != nil {
+				structField, ok = itemType.FieldByName(member.Name)
+			}
+			explainValue(&explanation, raw[member.Start:member.Start+member.Length], structField, ok, member)
+			explanations = append(explanations, explanation)
+		}
+	}
+
+	return explanations
+}
+
+func explainValue(explanation *decoder.FieldExplanation, raw []byte, structField reflect.StructField, ok bool, field FieldConfig) {
+	explanation.Hex = hex.EncodeToString(raw)
+
+	value := rawFieldValue(raw, field)
+
+	bits := OrderBytes(raw, field.ByteOrder)
+	if field.BitLength > 0 {
+		bits = ExtractBits(bits, field.BitOffset, field.BitLength)
+	}
+	if len(bits) <= 8 {
+		integer := BytesToUint64(bits)
+		explanation.Integer = &integer
+	}
+
+	if !ok || !structField.IsExported() {
+		// the field is read but not stored by Decode
+		if field.Transform != nil {
+			explanation.Value = field.Transform(value)
+		} else if bytes, ok := value.([]byte); ok && field.scaled() {
+			explanation.Value = ScaledValue(bytes, field.Signedness == Signed, field.Scale, field.Offset)
+		}
+		return
+	}
+
+	converted, err := convertField(value, structField.Type, field)
+	if err == nil && converted != nil && !reflect.TypeOf(converted).AssignableTo(structField.Type) {
+		err = fmt.Errorf("cannot assign %T to field of type %v", converted, structField.Type)
+	}
+	if err != nil {
+		explanation.Valid = false
+		explanation.Error = err.Error()
+		return
+	}
+
+	fieldValue := reflect.New(structField.Type).Elem()
+	if converted != nil {
+		fieldValue.Set(reflect.ValueOf(converted))
+	}
+
+	if fieldValue.Kind() == reflect.Ptr {
+		if !fieldValue.IsNil() {
+			explanation.Value = fieldValue.Elem().Interface()
+		}
+	} else {
+		explanation.Value = fieldValue.Interface()
+	}
+
+	err = validateRules(fieldValue, structField.Tag.Get("validate"))
+	if err != nil {
+		explanation.Valid = false
+		explanation.Error = fmt.Errorf("%w for %s %v", ErrValidationFailed, explanation.Name, explanation.Value).Error()
+	}
+}
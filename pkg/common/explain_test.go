@@ -0,0 +1,95 @@
+package common
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+func TestExplain(t *testing.T) {
+	config := PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "Battery", Start: 0, Length: 2},
+			{Name: "Moving", Start: 2, Length: 1, BitOffset: 7, BitLength: 1, Transform: func(v any) any {
+				return v.([]byte)[0] == 1
+			}},
+			{Name: "Latitude", Start: 3, Length: 4},
+			{Name: "Longitude", Start: 7, Length: 2, Optional: true},
+		},
+		TargetType: reflect.TypeOf(lenientPayload{}),
+	}
+	tlvConfig := PayloadConfig{
+		Tags: []TagConfig{
+			{Name: "Battery", Tag: 0x45, Optional: true},
+		},
+		TargetType: reflect.TypeOf(lenientPayload{}),
+	}
+
+	tests := []struct {
+		payload  []byte
+		config   *PayloadConfig
+		expected []decoder.FieldExplanation
+		err      bool
+	}{
+		{
+			payload: []byte{0x0e, 0x10, 0x80, 0x00, 0x00, 0x00, 0x2a, 0x00, 0x07},
+			config:  &config,
+			expected: []decoder.FieldExplanation{
+				{Name: "Battery", Start: 0, Length: 2, Hex: "0e10", Integer: Uint64Ptr(3600), Value: uint16(3600), Valid: true},
+				{Name: "Moving", Start: 2, Length: 1, BitOffset: 7, BitLength: 1, Hex: "80", Integer: Uint64Ptr(1), Value: true, Valid: true},
+				{Name: "Latitude", Start: 3, Length: 4, Hex: "0000002a", Integer: Uint64Ptr(42), Value: int32(42), Valid: true},
+				{Name: "Longitude", Start: 7, Length: 2, Hex: "0007", Integer: Uint64Ptr(7), Value: uint16(7), Valid: true},
+			},
+		},
+		{
+			payload: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2a},
+			config:  &config,
+			expected: []decoder.FieldExplanation{
+				{Name: "Battery", Start: 0, Length: 2, Hex: "0000", Integer: Uint64Ptr(0), Value: uint16(0), Error: "validation failed for Battery 0"},
+				{Name: "Moving", Start: 2, Length: 1, BitOffset: 7, BitLength: 1, Hex: "00", Integer: Uint64Ptr(0), Value: false, Valid: true},
+				{Name: "Latitude", Start: 3, Length: 4, Hex: "0000002a", Integer: Uint64Ptr(42), Value: int32(42), Valid: true},
+				{Name: "Longitude", Start: 7, Length: 2, Missing: true, Valid: true},
+			},
+		},
+		{
+			payload: []byte{0x0e, 0x10, 0x00, 0x00},
+			config:  &config,
+			expected: []decoder.FieldExplanation{
+				{Name: "Battery", Start: 0, Length: 2, Hex: "0e10", Integer: Uint64Ptr(3600), Value: uint16(3600), Valid: true},
+				{Name: "Moving", Start: 2, Length: 1, BitOffset: 7, BitLength: 1, Hex: "00", Integer: Uint64Ptr(0), Value: false, Valid: true},
+				{Name: "Latitude", Start: 3, Length: 4, Missing: true, Error: "field Latitude out of bounds at offset 3: need 4 bytes but only 1 available"},
+				{Name: "Longitude", Start: 7, Length: 2, Missing: true, Valid: true},
+			},
+		},
+		{
+			payload: []byte{0x00, 0x00, 0x00, 0x45, 0x02, 0x0e, 0x10, 0x50, 0x01, 0xff},
+			config:  &tlvConfig,
+			expected: []decoder.FieldExplanation{
+				{Name: "Battery", Tag: "0x45", Start: 5, Length: 2, Hex: "0e10", Integer: Uint64Ptr(3600), Value: uint16(3600), Valid: true},
+				{Tag: "0x50", Start: 9, Length: 1, Hex: "ff", Valid: true, Error: "unknown tag"},
+			},
+		},
+		{
+			payload: []byte{0x00, 0x00, 0x00, 0x45, 0x02, 0x0e, 0x10, 0x50, 0x02, 0xff},
+			config:  &tlvConfig,
+			expected: []decoder.FieldExplanation{
+				{Name: "Battery", Tag: "0x45", Start: 5, Length: 2, Hex: "0e10", Integer: Uint64Ptr(3600), Value: uint16(3600), Valid: true},
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(hex.EncodeToString(test.payload), func(t *testing.T) {
+			explanations, err := Explain(test.payload, test.config)
+			if (err != nil) != test.err {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(explanations, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, explanations)
+			}
+		})
+	}
+}
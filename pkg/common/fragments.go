@@ -0,0 +1,117 @@
+package common
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FragmentGroup holds the fragments of a message which a device split across
+// several uplinks, e.g. the messages of a BLE scan.
+type FragmentGroup struct {
+	// Total is the number of fragments of the message, 0 while unknown.
+	Total int `json:"total"`
+	// Fragments maps the index of a fragment to its data.
+	Fragments map[int][]byte `json:"fragments"`
+	// ReceivedAt is the time the first fragment of the group was received.
+	ReceivedAt time.Time `json:"receivedAt"`
+}
+
+// FragmentStore keeps fragment groups between uplinks. A store shared by
+// several decoder instances, e.g. in a database, makes the fragments of a
+// device available to all of them. Implementations must be safe for concurrent use.
+type FragmentStore interface {
+	// Load returns the group of the key and whether it exists.
+	Load(ctx context.Context, key string) (FragmentGroup, bool, error)
+	// Save stores the group under the key, replacing an existing group.
+	Save(ctx context.Context, key string, group FragmentGroup) error
+	// Delete removes the group of the key, if any.
+	Delete(ctx context.Context, key string) error
+	// Keys returns the sorted keys of the stored groups starting with the prefix.
+	Keys(ctx context.Context, prefix string) ([]string, error)
+	// Update calls update with the group of the key and whether it exists and
+	// stores the group it returns, or deletes the group if it returns nil.
+	// Nothing changes if update fails. Update must be atomic for every decoder
+	// instance sharing the store, e.g. run in a transaction, so fragments of
+	// the same group received at the same time are not lost. The update
+	// function must not call the store.
+	Update(ctx context.Context, key string, update func(group FragmentGroup, ok bool) (*FragmentGroup, error)) error
+}
+
+// MemoryFragmentStore is a FragmentStore keeping the groups in memory.
+type MemoryFragmentStore struct {
+	mutex  sync.Mutex
+	groups map[string]FragmentGroup
+}
+
+var _ FragmentStore = &MemoryFragmentStore{}
+
+func NewMemoryFragmentStore() *MemoryFragmentStore {
+	return &MemoryFragmentStore{
+		groups: map[string]FragmentGroup{},
+	}
+}
+
+func (s *MemoryFragmentStore) Load(ctx context.Context, key string) (FragmentGroup, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	group, ok := s.groups[key]
+	group.Fragments = maps.Clone(group.Fragments)
+	return group, ok, nil
+}
+
+func (s *MemoryFragmentStore) Save(ctx context.Context, key string, group FragmentGroup) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	group.Fragments = maps.Clone(group.Fragments)
+	s.groups[key] = group
+	return nil
+}
+
+func (s *MemoryFragmentStore) Delete(ctx context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.groups, key)
+	return nil
+}
+
+func (s *MemoryFragmentStore) Keys(ctx context.Context, prefix string) ([]string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	keys := []string{}
+	for key := range s.groups {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func (s *MemoryFragmentStore) Update(ctx context.Context, key string, update func(group FragmentGroup, ok bool) (*FragmentGroup, error)) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	group, ok := s.groups[key]
+	group.Fragments = maps.Clone(group.Fragments)
+
+	updated, err := update(group, ok)
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		delete(s.groups, key)
+		return nil
+	}
+
+	updated.Fragments = maps.Clone(updated.Fragments)
+	s.groups[key] = *updated
+	return nil
+}
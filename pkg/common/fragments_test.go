@@ -0,0 +1,104 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMemoryFragmentStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryFragmentStore()
+
+	group := FragmentGroup{
+		Total:      2,
+		Fragments:  map[int][]byte{1: {0x01}},
+		ReceivedAt: time.Unix(1700000000, 0).UTC(),
+	}
+	for _, key := range []string{"a/2", "b/1", "a/1"} {
+		if err := store.Save(ctx, key, group); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// changing the loaded group must not change the stored group
+	loaded, ok, err := store.Load(ctx, "a/1")
+	if err != nil || !ok {
+		t.Fatalf("expected group, got %v %v", ok, err)
+	}
+	loaded.Fragments[2] = []byte{0x02}
+	loaded, _, _ = store.Load(ctx, "a/1")
+	if !reflect.DeepEqual(loaded, group) {
+		t.Errorf("expected %+v, got %+v", group, loaded)
+	}
+
+	keys, _ := store.Keys(ctx, "a/")
+	if !reflect.DeepEqual(keys, []string{"a/1", "a/2"}) {
+		t.Errorf("unexpected keys %v", keys)
+	}
+
+	if err := store.Delete(ctx, "a/1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, _ := store.Load(ctx, "a/1"); ok {
+		t.Error("expected deleted group")
+	}
+}
+
+func TestMemoryFragmentStoreUpdate(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryFragmentStore()
+
+	// concurrent fragments of the same group are not lost
+	var wg sync.WaitGroup
+	for i := 1; i <= 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := store.Update(ctx, "a", func(group FragmentGroup, ok bool) (*FragmentGroup, error) {
+				if !ok {
+					group = FragmentGroup{Total: 50, Fragments: map[int][]byte{}}
+				}
+				group.Fragments[i] = []byte{byte(i)}
+				return &group, nil
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	group, _, _ := store.Load(ctx, "a")
+	if len(group.Fragments) != 50 {
+		t.Errorf("expected 50 fragments, got %d", len(group.Fragments))
+	}
+
+	// a failed update changes nothing
+	failed := errors.New("failed")
+	err := store.Update(ctx, "a", func(group FragmentGroup, ok bool) (*FragmentGroup, error) {
+		group.Fragments[51] = []byte{0x33}
+		return nil, failed
+	})
+	if !errors.Is(err, failed) {
+		t.Errorf("expected %v, got %v", failed, err)
+	}
+	group, _, _ = store.Load(ctx, "a")
+	if len(group.Fragments) != 50 {
+		t.Errorf("expected 50 fragments, got %d", len(group.Fragments))
+	}
+
+	// returning nil deletes the group
+	err = store.Update(ctx, "a", func(group FragmentGroup, ok bool) (*FragmentGroup, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, _ := store.Load(ctx, "a"); ok {
+		t.Error("expected deleted group")
+	}
+}
@@ -0,0 +1,120 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/truvami/decoder/internal/fuzztest"
+)
+
+type fuzzPayload struct {
+	Moving   bool
+	Level    int8 `validate:"gte=-10"`
+	Interval uint16
+	Time     uint32
+	Mac      string
+	Name     *string
+	Records  []groupRecord
+}
+
+// fuzzConfigs cover bit ranges, byte order and signedness, repeated groups,
+// fields of dynamic length and TLV tags.
+var fuzzConfigs = []PayloadConfig{
+	{
+		Fields: []FieldConfig{
+			{Name: "Moving", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "Level", Start: 0, Length: 1, BitOffset: 0, BitLength: 5, Signedness: Signed},
+			{Name: "Interval", Start: 1, Length: 2, ByteOrder: LittleEndian},
+			{Name: "Time", Start: 3, Length: 4},
+			{Name: "Mac", Start: 7, Length: 2, Hex: true},
+			{Name: "Records", Start: 9, Length: 3, MaxCount: 3, Optional: true, Group: []FieldConfig{
+				{Name: "Mac", Start: 0, Length: 2, Hex: true},
+				{Name: "Rssi", Start: 2, Length: 1},
+			}},
+		},
+		TargetType: reflect.TypeOf(fuzzPayload{}),
+	},
+	{
+		Fields: []FieldConfig{
+			{Name: "Level", Start: 0, Length: 1},
+			{Name: "Name", Start: 1, Length: -1, Optional: true, Hex: true},
+		},
+		TargetType: reflect.TypeOf(fuzzPayload{}),
+	},
+	{
+		Tags: []TagConfig{
+			{Name: "Interval", Tag: 0x01},
+			{Name: "Time", Tag: 0x02},
+			{Name: "Mac", Tag: 0x03, Hex: true},
+			{Name: "Name", Tag: 0x04, Optional: true},
+			{Name: "Moving", Tag: 0x05, BitOffset: 0, BitLength: 1},
+		},
+		TargetType: reflect.TypeOf(fuzzPayload{}),
+	},
+}
+
+func addFuzzSeeds(f *testing.F) {
+	seeds := []struct {
+		config  uint8
+		payload []byte
+	}{
+		{config: 0, payload: []byte{0x83, 0x2c, 0x01, 0x66, 0xc4, 0x5e, 0xd8, 0xaa, 0xbb}},
+		{config: 0, payload: []byte{0x1f, 0x2c, 0x01, 0x66, 0xc4, 0x5e, 0xd8, 0xaa, 0xbb, 0xcc, 0xdd, 0xce, 0xee, 0xff, 0xb0}},
+		{config: 1, payload: []byte{0x05, 't', 'a', 'g'}},
+		{config: 1, payload: []byte{0x05}},
+		{config: 2, payload: []byte{0x4c, 0x0a, 0x03, 0x01, 0x02, 0x01, 0x2c, 0x03, 0x02, 0xaa, 0xbb, 0x05, 0x01, 0x01}},
+		{config: 2, payload: []byte{0x4c, 0x0a, 0x03, 0x04, 0x10, 'x'}},
+	}
+	for _, seed := range seeds {
+		f.Add(seed.config, seed.payload)
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	addFuzzSeeds(f)
+
+	f.Fuzz(func(t *testing.T, index uint8, payload []byte) {
+		for _, lenient := range []bool{false, true} {
+			config := fuzzConfigs[int(index)%len(fuzzConfigs)]
+			config.Lenient = lenient
+
+			fuzztest.CheckAllocations(t, payload, func() {
+				_ = ValidateBytesLength(payload, &config)
+				_, _ = DecodeBytes(payload, &config)
+				_, _ = Explain(payload, &config)
+			})
+		}
+	})
+}
+
+// FuzzEncode encodes the structs decoded from random payloads. As the configs
+// have no transforms, a payload which decodes without error has to decode into
+// the same struct after encoding it again.
+func FuzzEncode(f *testing.F) {
+	addFuzzSeeds(f)
+
+	f.Fuzz(func(t *testing.T, index uint8, payload []byte) {
+		config := fuzzConfigs[int(index)%len(fuzzConfigs)]
+		if len(config.Tags) != 0 {
+			return
+		}
+
+		data, err := DecodeBytes(payload, &config)
+		if data == nil {
+			return
+		}
+
+		encoded, encodeErr := Encode(data, config)
+		if err != nil || encodeErr != nil {
+			return
+		}
+
+		roundTrip, err := Decode(&encoded, &config)
+		if err != nil {
+			t.Fatalf("decoding encoded payload %s: %v", encoded, err)
+		}
+		if !reflect.DeepEqual(roundTrip, data) {
+			t.Errorf("expected %+v after round trip, got %+v", data, roundTrip)
+		}
+	})
+}
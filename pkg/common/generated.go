@@ -0,0 +1,292 @@
+package common
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/go-playground/validator"
+	"github.com/truvami/decoder/internal/logger"
+	"go.uber.org/zap"
+)
+
+// FieldLayout is the comparable part of a FieldConfig.
+// Transform only records whether a transform function is set, the Unit is not
+// part of the layout.
+type FieldLayout struct {
+	Name       string
+	Start      int
+	Length     int
+	Optional   bool
+	Hex        bool
+	Transform  bool
+	BitOffset  int
+	BitLength  int
+	ByteOrder  ByteOrder
+	Signedness Signedness
+	MaxCount   int
+	Scale      float64
+	Offset     float64
+	Group      []FieldLayout
+}
+
+// TagLayout is the comparable part of a TagConfig.
+// Transform only records whether a transform function is set.
+type TagLayout struct {
+	Name       string
+	Tag        uint8
+	Optional   bool
+	Hex        bool
+	Transform  bool
+	BitOffset  int
+	BitLength  int
+	Signedness Signedness
+	Scale      float64
+	Offset     float64
+}
+
+// GeneratedCodec holds reflection-free decode and encode functions for a single
+// payload layout. Codecs are emitted by internal/decodergen, see `go generate`.
+type GeneratedCodec struct {
+	Fields []FieldLayout
+	Tags   []TagLayout
+	Decode func(payload []byte, config *PayloadConfig) (any, error)
+	Encode func(data any, config PayloadConfig) (string, error)
+}
+
+var generated = struct {
+	sync.RWMutex
+	codecs map[reflect.Type][]GeneratedCodec
+}{
+	codecs: map[reflect.Type][]GeneratedCodec{},
+}
+
+// RegisterGenerated registers generated code for the target type.
+// Decode and Encode only use the codec if the layout still matches the config,
+// otherwise they fall back to reflection.
+func RegisterGenerated(targetType reflect.Type, codec GeneratedCodec) {
+	generated.Lock()
+	defer generated.Unlock()
+
+	generated.codecs[targetType] = append(generated.codecs[targetType], codec)
+}
+
+// HasGenerated reports whether Decode and Encode use generated code for the config.
+func HasGenerated(config *PayloadConfig) bool {
+	return lookupGenerated(config) != nil
+}
+
+func lookupGenerated(config *PayloadConfig) *GeneratedCodec {
+	generated.RLock()
+	defer generated.RUnlock()
+
+	codecs := generated.codecs[config.TargetType]
+	for i := range codecs {
+		if codecs[i].matches(config) {
+			return &codecs[i]
+		}
+	}
+	return nil
+}
+
+func (c GeneratedCodec) matches(config *PayloadConfig) bool {
+	if len(c.Fields) != len(config.Fields) || len(c.Tags) != len(config.Tags) {
+		return false
+	}
+
+	if !matchFields(c.Fields, config.Fields) {
+		return false
+	}
+
+	for i, tag := range config.Tags {
+		layout := TagLayout{
+			Name:       tag.Name,
+			Tag:        tag.Tag,
+			Optional:   tag.Optional,
+			Hex:        tag.Hex,
+			Transform:  tag.Transform != nil,
+			BitOffset:  tag.BitOffset,
+			BitLength:  tag.BitLength,
+			Signedness: tag.Signedness,
+			Scale:      tag.Scale,
+			Offset:     tag.Offset,
+		}
+		if c.Tags[i] != layout {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchFields(layouts []FieldLayout, fields []FieldConfig) bool {
+	if len(layouts) != len(fields) {
+		return false
+	}
+
+	for i, field := range fields {
+		layout := layouts[i]
+		if layout.Name != field.Name ||
+			layout.Start != field.Start ||
+			layout.Length != field.Length ||
+			layout.Optional != field.Optional ||
+			layout.Hex != field.Hex ||
+			layout.Transform != (field.Transform != nil) ||
+			layout.BitOffset != field.BitOffset ||
+			layout.BitLength != field.BitLength ||
+			layout.ByteOrder != field.ByteOrder ||
+			layout.Signedness != field.Signedness ||
+			layout.MaxCount != field.MaxCount ||
+			layout.Scale != field.Scale ||
+			layout.Offset != field.Offset ||
+			!matchFields(layout.Group, field.Group) {
+			return false
+		}
+	}
+	return true
+}
+
+// FieldBytes returns the bytes of a field, or nil if an optional field is missing.
+func FieldBytes(payload []byte, start int, length int, optional bool) ([]byte, error) {
+	value, err := extractFieldValue(payload, start, length, optional, false)
+	if value == nil || err != nil {
+		return nil, err
+	}
+	return value.([]byte), nil
+}
+
+// HexValue returns the value passed to transforms of fields declared with Hex.
+func HexValue(value []byte) string {
+	return hex.EncodeToString(value)
+}
+
+// ReadTLVHeader reads the tag and length of the TLV value at index.
+func ReadTLVHeader(payload []byte, index int) (uint8, int, error) {
+	if len(payload)-index < 2 {
+		return 0, 0, newTLVError(payload, index, 2, len(payload)-index,
+			fmt.Errorf("incomplete TLV header at offset %d: need 2 bytes but only %d remain", index, len(payload)-index))
+	}
+
+	var tag = payload[index]
+	var length = int(payload[index+1])
+
+	if index+2+length > len(payload) {
+		return 0, 0, newTLVError(payload, index, length, len(payload)-index-2,
+			fmt.Errorf("TLV tag 0x%02x at offset %d declares length %d, but only %d bytes remain", tag, index, length, len(payload)-index-2))
+	}
+
+	return tag, length, nil
+}
+
+func newTLVError(payload []byte, index int, expected int, actual int, err error) *DecodeError {
+	decodeError := &DecodeError{
+		err:            err,
+		offset:         index,
+		hasOffset:      true,
+		expectedLength: expected,
+		actualLength:   actual,
+	}
+	if index >= 0 && index < len(payload) {
+		decodeError.tag = payload[index]
+		decodeError.hasTag = true
+	}
+	decodeError.setRaw(payload)
+	return decodeError
+}
+
+// SkipUnknownTLVTag records a TLV tag that is not part of the payload config.
+func SkipUnknownTLVTag(tag uint8, length int) {
+	tagHex := fmt.Sprintf("0x%02x", tag)
+	unknownTLVTagsTotal.WithLabelValues(tagHex).Inc()
+	if logger.Logger != nil {
+		logger.Logger.Warn("skipping unknown tag", zap.String("tag", tagHex), zap.Int("length", length))
+	}
+}
+
+var fieldValidator = validator.New()
+
+// ValidateField checks the value against the validate struct tag rules of the field.
+// The returned error matches the ones collected by Decode.
+func ValidateField(name string, value any, rules string) error {
+	if rules == "" {
+		return nil
+	}
+
+	err := fieldValidator.Var(value, rules)
+	if err != nil {
+		return fmt.Errorf("%w for %s %v", ErrValidationFailed, name, DerefValue(reflect.ValueOf(value)))
+	}
+	return nil
+}
+
+// EncodedLength returns the buffer size needed to encode the fields.
+func EncodedLength(fields []FieldConfig) int {
+	var maxLength int
+	for _, field := range fields {
+		end := field.Start + field.Length*max(field.MaxCount, 1)
+		if end > maxLength {
+			maxLength = end
+		}
+	}
+	return maxLength
+}
+
+// PayloadWriter collects encoded fields. Its length is the number of distinct
+// bytes written, so fields sharing bytes through bit ranges are counted once.
+type PayloadWriter struct {
+	payload []byte
+	written []bool
+	length  int
+}
+
+func NewPayloadWriter(size int) *PayloadWriter {
+	return &PayloadWriter{
+		payload: make([]byte, size),
+		written: make([]bool, size),
+	}
+}
+
+// Write copies the bytes of a field to the payload.
+func (w *PayloadWriter) Write(start int, length int, bytes []byte) {
+	w.Reserve(start, length)
+	copy(w.payload[start:start+length], bytes)
+}
+
+// WriteBits merges the bits of a field into the payload.
+func (w *PayloadWriter) WriteBits(start int, length int, bitOffset int, bitLength int, bytes []byte) {
+	w.Merge(start, length, PackBits(bytes, length, bitOffset, bitLength))
+}
+
+// Merge combines already packed bits of a field with the bits in the payload.
+func (w *PayloadWriter) Merge(start int, length int, bytes []byte) {
+	w.Reserve(start, length)
+	for i, b := range bytes[:min(len(bytes), length)] {
+		w.payload[start+i] |= b
+	}
+}
+
+// Reserve adds the bytes to the payload without writing them, so they stay zero
+// unless a field is written to them. The payload grows as needed.
+func (w *PayloadWriter) Reserve(start int, length int) {
+	if start+length > len(w.payload) {
+		w.payload = append(w.payload, make([]byte, start+length-len(w.payload))...)
+		w.written = append(w.written, make([]bool, start+length-len(w.written))...)
+	}
+	for i := start; i < start+length; i++ {
+		if !w.written[i] {
+			w.written[i] = true
+			w.length++
+		}
+	}
+}
+
+// Bytes returns the binary payload.
+func (w *PayloadWriter) Bytes() []byte {
+	return w.payload[0:w.length]
+}
+
+// String returns the hex encoded payload.
+func (w *PayloadWriter) String() string {
+	return hex.EncodeToString(w.payload[0:w.length])
+}
@@ -0,0 +1,169 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type generatedPayload struct {
+	Value uint16 `validate:"lte=1000"`
+}
+
+func TestRegisterGenerated(t *testing.T) {
+	config := PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "Value", Start: 0, Length: 2},
+		},
+		TargetType: reflect.TypeOf(generatedPayload{}),
+	}
+
+	if HasGenerated(&config) {
+		t.Fatal("expected no generated codec")
+	}
+
+	var decoded, encoded int
+	RegisterGenerated(reflect.TypeOf(generatedPayload{}), GeneratedCodec{
+		Fields: []FieldLayout{
+			{Name: "Value", Start: 0, Length: 2},
+		},
+		Decode: func(payload []byte, config *PayloadConfig) (any, error) {
+			decoded++
+			return generatedPayload{Value: BytesToUint16(payload)}, nil
+		},
+		Encode: func(data any, config PayloadConfig) (string, error) {
+			encoded++
+			return EncodeReflect(data, config)
+		},
+	})
+
+	if !HasGenerated(&config) {
+		t.Fatal("expected generated codec")
+	}
+
+	payload := "002a"
+	got, err := Decode(&payload, &config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != (generatedPayload{Value: 42}) || decoded != 1 {
+		t.Errorf("expected generated decode, got %v after %d calls", got, decoded)
+	}
+
+	_, err = DecodeReflect(&payload, &config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded != 1 {
+		t.Errorf("expected DecodeReflect to skip generated code")
+	}
+
+	hex, err := Encode(generatedPayload{Value: 42}, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hex != "002a" || encoded != 1 {
+		t.Errorf("expected generated encode, got %s after %d calls", hex, encoded)
+	}
+
+	// a changed layout must not use the generated code
+	changed := config
+	changed.Fields = []FieldConfig{
+		{Name: "Value", Start: 0, Length: 1},
+	}
+	if HasGenerated(&changed) {
+		t.Error("expected no generated codec for a changed layout")
+	}
+
+	changed.Fields = []FieldConfig{
+		{Name: "Value", Start: 0, Length: 2, Transform: func(v any) any { return uint16(0) }},
+	}
+	if HasGenerated(&changed) {
+		t.Error("expected no generated codec for a field with transform")
+	}
+}
+
+func TestFieldBytes(t *testing.T) {
+	payload := []byte{0x01, 0x02, 0x03}
+
+	tests := []struct {
+		start    int
+		length   int
+		optional bool
+		expected []byte
+		err      bool
+	}{
+		{start: 0, length: 2, expected: []byte{0x01, 0x02}},
+		{start: 1, length: -1, expected: []byte{0x02, 0x03}},
+		{start: 2, length: 2, optional: true, expected: nil},
+		{start: 2, length: 2, err: true},
+		{start: 3, length: -1, err: true},
+	}
+
+	for _, test := range tests {
+		got, err := FieldBytes(payload, test.start, test.length, test.optional)
+		if (err != nil) != test.err {
+			t.Errorf("FieldBytes(%d, %d) unexpected error %v", test.start, test.length, err)
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("FieldBytes(%d, %d) = %v, expected %v", test.start, test.length, got, test.expected)
+		}
+	}
+}
+
+func TestReadTLVHeader(t *testing.T) {
+	tag, length, err := ReadTLVHeader([]byte{0x00, 0x40, 0x01, 0x0f}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tag != 0x40 || length != 1 {
+		t.Errorf("expected tag 0x40 with length 1, got 0x%02x with length %d", tag, length)
+	}
+
+	_, _, err = ReadTLVHeader([]byte{0x00, 0x40}, 1)
+	if err == nil || err.Error() != "incomplete TLV header at offset 1: need 2 bytes but only 1 remain" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, _, err = ReadTLVHeader([]byte{0x00, 0x40, 0x02, 0x0f}, 1)
+	if err == nil || err.Error() != "TLV tag 0x40 at offset 1 declares length 2, but only 1 bytes remain" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateField(t *testing.T) {
+	err := ValidateField("Value", uint16(10), "lte=1000")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err = ValidateField("Value", Uint16Ptr(1001), "lte=1000")
+	if !errors.Is(err, ErrValidationFailed) || err.Error() != "validation failed for Value 1001" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err = ValidateField("Value", uint16(1001), "")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPayloadWriter(t *testing.T) {
+	fields := []FieldConfig{
+		{Name: "A", Start: 0, Length: 1, BitOffset: 4, BitLength: 4},
+		{Name: "B", Start: 0, Length: 1, BitOffset: 0, BitLength: 4},
+		{Name: "C", Start: 1, Length: 2},
+	}
+
+	writer := NewPayloadWriter(EncodedLength(fields))
+	writer.WriteBits(0, 1, 4, 4, []byte{0x0a})
+	writer.WriteBits(0, 1, 0, 4, []byte{0x05})
+	if got := writer.String(); got != "a5" {
+		t.Errorf("expected a5, got %s", got)
+	}
+
+	writer.Write(1, 2, []byte{0x01, 0x02})
+	if got := writer.String(); got != "a50102" {
+		t.Errorf("expected a50102, got %s", got)
+	}
+}
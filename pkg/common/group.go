@@ -0,0 +1,111 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// GroupStart returns the offset of a record of a repeated group in the payload.
+func GroupStart(field FieldConfig, index int) int {
+	return field.Start + index*field.Length
+}
+
+// GroupFieldName returns the name of a field of a record of a repeated group,
+// e.g. AccessPoints[1].Rssi, as used in validation errors and explanations.
+func GroupFieldName(group string, index int, name string) string {
+	return fmt.Sprintf("%s[%d].%s", group, index, name)
+}
+
+// CheckGroupCount returns an error if there are more records than the payload holds.
+func CheckGroupCount(group string, count int, maxCount int) error {
+	if maxCount != 0 && count > maxCount {
+		return fmt.Errorf("field %s has %d records but the payload holds at most %d", group, count, maxCount)
+	}
+	return nil
+}
+
+// decodeGroup appends the records of a repeated group to the slice field and
+// returns the fields of the records to validate.
+func decodeGroup(reader *FieldReader, field FieldConfig, fieldValue reflect.Value) ([]validationCheck, error) {
+	checks := []validationCheck{}
+
+	store := fieldValue.IsValid() && fieldValue.CanSet() && fieldValue.Kind() == reflect.Slice
+	for i := 0; field.MaxCount == 0 || i < field.MaxCount; i++ {
+		raw, ok := reader.Read(field.Name, GroupStart(field, i), field.Length, i > 0 || field.Optional)
+		if !ok {
+			break
+		}
+		if !store {
+			continue
+		}
+
+		item := reflect.New(fieldValue.Type().Elem()).Elem()
+		for _, member := range field.Group {
+			bytes, err := FieldBytes(raw, member.Start, member.Length, member.Optional)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s of %s", err, member.Name, field.Name)
+			}
+			if bytes == nil {
+				continue
+			}
+			value := rawFieldValue(bytes, member)
+
+			memberValue := item.FieldByName(member.Name)
+			if !memberValue.IsValid() || !memberValue.CanSet() {
+				continue
+			}
+
+			convertedValue, err := convertField(value, memberValue.Type(), member)
+			if err != nil {
+				return nil, err
+			}
+			if convertedValue != nil {
+				memberValue.Set(reflect.ValueOf(convertedValue))
+			}
+
+			checks = append(checks, validationCheck{field: field.Name, item: i, member: member.Name})
+		}
+		fieldValue.Set(reflect.Append(fieldValue, item))
+	}
+
+	return checks, nil
+}
+
+// encodeGroup writes the records of the slice field. A group which is not
+// optional is written as a single record of zeros if the slice is empty.
+func encodeGroup(writer *PayloadWriter, field FieldConfig, fieldValue reflect.Value) error {
+	if fieldValue.Kind() != reflect.Slice {
+		return fmt.Errorf("field %s must be a slice", field.Name)
+	}
+	if err := CheckGroupCount(field.Name, fieldValue.Len(), field.MaxCount); err != nil {
+		return err
+	}
+
+	if fieldValue.Len() == 0 && !field.Optional {
+		writer.Reserve(field.Start, field.Length)
+	}
+
+	for i := 0; i < fieldValue.Len(); i++ {
+		item := fieldValue.Index(i)
+		start := GroupStart(field, i)
+
+		writer.Reserve(start, field.Length)
+		for _, member := range field.Group {
+			memberValue := item.FieldByName(member.Name)
+			if !memberValue.IsValid() {
+				return fmt.Errorf("field %s not found in %s", member.Name, field.Name)
+			}
+
+			set, bytes, err := insertField(memberValue, member)
+			if err != nil {
+				return err
+			}
+
+			if set || !member.Optional {
+				writeField(writer, member, start+member.Start, bytes)
+			}
+		}
+	}
+
+	return nil
+}
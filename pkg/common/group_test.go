@@ -0,0 +1,242 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type groupRecord struct {
+	Mac  string `json:"mac"`
+	Rssi int8   `json:"rssi" validate:"gte=-120,lte=-20"`
+}
+
+type groupPayload struct {
+	Moving  bool          `json:"moving"`
+	Records []groupRecord `json:"records"`
+}
+
+func groupConfig(optional bool, maxCount int) PayloadConfig {
+	return PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "Moving", Start: 0, Length: 1},
+			{Name: "Records", Start: 1, Length: 3, Optional: optional, MaxCount: maxCount, Group: []FieldConfig{
+				{Name: "Mac", Start: 0, Length: 2, Hex: true},
+				{Name: "Rssi", Start: 2, Length: 1},
+			}},
+		},
+		TargetType: reflect.TypeOf(groupPayload{}),
+	}
+}
+
+func TestGroup(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  string
+		config   PayloadConfig
+		expected any
+		encoded  string
+		err      error
+	}{
+		{
+			name:     "SingleRecord",
+			payload:  "01aabbce",
+			config:   groupConfig(false, 3),
+			expected: groupPayload{Moving: true, Records: []groupRecord{{Mac: "aabb", Rssi: -50}}},
+			encoded:  "01aabbce",
+		},
+		{
+			name:    "MaxCount",
+			payload: "01aabbceccddb0eeffc4",
+			config:  groupConfig(false, 3),
+			expected: groupPayload{Moving: true, Records: []groupRecord{
+				{Mac: "aabb", Rssi: -50},
+				{Mac: "ccdd", Rssi: -80},
+				{Mac: "eeff", Rssi: -60},
+			}},
+			encoded: "01aabbceccddb0eeffc4",
+		},
+		{
+			name:    "Unlimited",
+			payload: "00aabbceccddb0eeffc4112233",
+			config:  groupConfig(false, 0),
+			expected: groupPayload{Records: []groupRecord{
+				{Mac: "aabb", Rssi: -50},
+				{Mac: "ccdd", Rssi: -80},
+				{Mac: "eeff", Rssi: -60},
+				{Mac: "1122", Rssi: 51},
+			}},
+			err: ErrValidationFailed,
+		},
+		{
+			name:     "PartialRecord",
+			payload:  "01aabbceccdd",
+			config:   groupConfig(false, 3),
+			expected: groupPayload{Moving: true, Records: []groupRecord{{Mac: "aabb", Rssi: -50}}},
+			encoded:  "01aabbce",
+		},
+		{
+			name:     "OptionalGroup",
+			payload:  "01",
+			config:   groupConfig(true, 3),
+			expected: groupPayload{Moving: true},
+			encoded:  "01",
+		},
+		{
+			name:    "MissingGroup",
+			payload: "01",
+			config:  groupConfig(false, 3),
+			err:     ErrFieldOutOfBounds,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := Decode(&test.payload, &test.config)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			if err != nil && !errors.Is(err, ErrValidationFailed) {
+				return
+			}
+
+			if !reflect.DeepEqual(data, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, data)
+			}
+
+			if test.encoded != "" {
+				encoded, err := EncodeReflect(data, test.config)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if encoded != test.encoded {
+					t.Errorf("expected encoded payload %s, got %s", test.encoded, encoded)
+				}
+			}
+		})
+	}
+}
+
+func TestGroupLength(t *testing.T) {
+	tests := []struct {
+		payload string
+		config  PayloadConfig
+		err     error
+	}{
+		{payload: "01", config: groupConfig(false, 3), err: ErrPayloadTooShort},
+		{payload: "01", config: groupConfig(true, 3)},
+		{payload: "01aabbceccddb0eeffc4", config: groupConfig(false, 3)},
+		{payload: "01aabbceccddb0eeffc4112233", config: groupConfig(false, 3), err: ErrPayloadTooLong},
+		{payload: "01aabbceccddb0eeffc4112233", config: groupConfig(false, 0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.payload, func(t *testing.T) {
+			err := ValidateLength(&test.payload, &test.config)
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestGroupValidation(t *testing.T) {
+	payload := "01aabbceccdd05"
+	config := groupConfig(false, 3)
+
+	_, err := Decode(&payload, &config)
+	if !errors.Is(err, ErrValidationFailed) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	if err.Error() != "validation failed for Records[1].Rssi 5" {
+		t.Errorf("unexpected error %q", err.Error())
+	}
+}
+
+func TestGroupLenient(t *testing.T) {
+	payload := "01aabb"
+	config := groupConfig(false, 3)
+	config.Lenient = true
+
+	data, err := Decode(&payload, &config)
+
+	var partial *PartialDecodeError
+	if !errors.As(err, &partial) || len(partial.Fields) != 1 || partial.Fields[0].Name != "Records" {
+		t.Fatalf("expected the records to be missing, got %v", err)
+	}
+	if !reflect.DeepEqual(data, groupPayload{Moving: true}) {
+		t.Errorf("unexpected data %+v", data)
+	}
+}
+
+func TestGroupEncode(t *testing.T) {
+	config := groupConfig(false, 2)
+
+	encoded, err := EncodeReflect(groupPayload{Moving: true}, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if encoded != "01000000" {
+		t.Errorf("expected an empty record for a required group, got %s", encoded)
+	}
+
+	_, err = EncodeReflect(groupPayload{Records: make([]groupRecord, 3)}, config)
+	if err == nil {
+		t.Error("expected error for too many records")
+	}
+}
+
+func TestGroupExplain(t *testing.T) {
+	config := groupConfig(false, 3)
+
+	explanations, err := Explain([]byte{0x01, 0xaa, 0xbb, 0xce, 0xcc, 0xdd, 0x05}, &config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := []string{}
+	for _, explanation := range explanations {
+		names = append(names, explanation.Name)
+	}
+	expected := []string{"Moving", "Records[0].Mac", "Records[0].Rssi", "Records[1].Mac", "Records[1].Rssi"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected fields %v, got %v", expected, names)
+	}
+
+	rssi := explanations[4]
+	if rssi.Start != 6 || rssi.Value != int8(5) || rssi.Valid || rssi.Error != "validation failed for Records[1].Rssi 5" {
+		t.Errorf("unexpected explanation %+v", rssi)
+	}
+}
+
+func TestMarshalNumbered(t *testing.T) {
+	tests := []struct {
+		payload  any
+		expected string
+	}{
+		{
+			payload:  groupPayload{Moving: true, Records: []groupRecord{{Mac: "aabb", Rssi: -50}, {Mac: "ccdd", Rssi: -80}}},
+			expected: `{"moving":true,"mac1":"aabb","rssi1":-50,"mac2":"ccdd","rssi2":-80}`,
+		},
+		{
+			payload:  &groupPayload{},
+			expected: `{"moving":false}`,
+		},
+		{
+			payload:  map[string]int{"a": 1},
+			expected: `{"a":1}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			marshaled, err := MarshalNumbered(test.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(marshaled) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, marshaled)
+			}
+		})
+	}
+}
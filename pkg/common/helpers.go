@@ -2,17 +2,12 @@ package common
 
 import (
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"math"
 	"unsafe"
 
 	"reflect"
 	"time"
-
-	"github.com/go-playground/validator"
-	"github.com/truvami/decoder/internal/logger"
-	"go.uber.org/zap"
 )
 
 func HexStringToBytes(hexString string) ([]byte, error) {
@@ -25,12 +20,20 @@ func HexStringToBytes(hexString string) ([]byte, error) {
 }
 
 func ValidateLength(payload *string, config *PayloadConfig) error {
-	var payloadLength = len(*payload) / 2
+	return validateLength(len(*payload)/2, config)
+}
+
+// ValidateBytesLength checks the length of a binary payload like ValidateLength does for hex payloads.
+func ValidateBytesLength(payload []byte, config *PayloadConfig) error {
+	return validateLength(len(payload), config)
+}
+
+func validateLength(payloadLength int, config *PayloadConfig) error {
 
 	if len(config.Tags) != 0 {
 		var minLength = 3
 		if payloadLength < minLength {
-			return WrapErrorWithMessage(ErrInvalidPayloadLength, ErrPayloadTooShort, fmt.Sprintf("payload length %d is less than minimum required length %d", payloadLength, minLength))
+			return newLengthError(payloadLength, minLength, WrapErrorWithMessage(ErrInvalidPayloadLength, ErrPayloadTooShort, fmt.Sprintf("payload length %d is less than minimum required length %d", payloadLength, minLength)))
 		} else {
 			return nil
 		}
@@ -47,37 +50,51 @@ func ValidateLength(payload *string, config *PayloadConfig) error {
 	for _, field := range config.Fields {
 		if field.Length == -1 {
 			maxLength = 50
+		} else if len(field.Group) != 0 && field.MaxCount == 0 {
+			maxLength = math.MaxInt
 		} else {
-			maxLength = field.Start + field.Length
+			maxLength = field.Start + field.Length*max(field.MaxCount, 1)
 		}
 	}
 
-	if payloadLength < minLength {
-		return WrapErrorWithMessage(ErrInvalidPayloadLength, ErrPayloadTooShort, fmt.Sprintf("payload length %d is less than minimum required length %d", payloadLength, minLength))
+	// a lenient decode reports the missing fields instead
+	if payloadLength < minLength && !config.Lenient {
+		return newLengthError(payloadLength, minLength, WrapErrorWithMessage(ErrInvalidPayloadLength, ErrPayloadTooShort, fmt.Sprintf("payload length %d is less than minimum required length %d", payloadLength, minLength)))
 	}
 
 	if payloadLength > maxLength {
-		return WrapErrorWithMessage(ErrInvalidPayloadLength, ErrPayloadTooLong, fmt.Sprintf("payload length %d is greater than maximum allowed length %d", payloadLength, maxLength))
+		return newLengthError(payloadLength, maxLength, WrapErrorWithMessage(ErrInvalidPayloadLength, ErrPayloadTooLong, fmt.Sprintf("payload length %d is greater than maximum allowed length %d", payloadLength, maxLength)))
 	}
 
 	return nil
 }
 
+// newLengthError points at the first missing or superfluous byte of the payload.
+func newLengthError(payloadLength int, expected int, err error) *DecodeError {
+	return &DecodeError{
+		err:            err,
+		offset:         min(payloadLength, expected),
+		hasOffset:      true,
+		expectedLength: expected,
+		actualLength:   payloadLength,
+	}
+}
+
 func extractFieldValue(payloadBytes []byte, start int, length int, optional bool, hexadecimal bool) (any, error) {
 	if length == -1 {
-		if start >= len(payloadBytes) && !optional {
+		if start >= len(payloadBytes) {
+			if optional {
+				return nil, nil
+			}
 			return nil, fmt.Errorf("field start out of bounds")
 		}
 		// Dynamic length: read until the end of the payload
 		length = len(payloadBytes) - start
-		if length == 0 {
-			return nil, nil
-		}
 	} else if start+length > len(payloadBytes) {
 		if optional {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("field out of bounds")
+		return nil, ErrFieldOutOfBounds
 	}
 
 	// Extract the field value based on its length
@@ -89,6 +106,107 @@ func extractFieldValue(payloadBytes []byte, start int, length int, optional bool
 	return value, nil
 }
 
+// ExtractBits returns bitLength bits of the big-endian value starting bitOffset bits
+// above the least significant bit, right aligned in as few bytes as can hold them.
+func ExtractBits(value []byte, bitOffset int, bitLength int) []byte {
+	var bits uint64
+	for i := 0; i < bitLength && i < 64; i++ {
+		position := bitOffset + i
+		index := len(value) - 1 - position/8
+		if index < 0 {
+			break
+		}
+		if (value[index]>>(position%8))&0x01 == 1 {
+			bits |= 1 << i
+		}
+	}
+	return UintToBytes(bits, (bitLength+7)/8)
+}
+
+// PackBits places the low bitLength bits of the big-endian value bitOffset bits
+// above the least significant bit of a zeroed buffer of the given length.
+func PackBits(value []byte, length int, bitOffset int, bitLength int) []byte {
+	buf := make([]byte, length)
+	for i := 0; i < bitLength; i++ {
+		source := len(value) - 1 - i/8
+		if source < 0 {
+			break
+		}
+		if (value[source]>>(i%8))&0x01 == 0 {
+			continue
+		}
+		position := bitOffset + i
+		index := length - 1 - position/8
+		if index < 0 {
+			break
+		}
+		buf[index] |= 1 << (position % 8)
+	}
+	return buf
+}
+
+// OrderBytes returns the bytes of a field of the given byte order in big-endian order.
+// As reversing is its own inverse, it also turns big-endian bytes into the byte order.
+func OrderBytes(value []byte, order ByteOrder) []byte {
+	if order != LittleEndian || len(value) < 2 {
+		return value
+	}
+	ordered := make([]byte, len(value))
+	for i, b := range value {
+		ordered[len(value)-1-i] = b
+	}
+	return ordered
+}
+
+// ExtendBytes returns the low bits of the big-endian value as 8 bytes. If signed,
+// the highest of these bits is the sign and is copied into the bits above.
+func ExtendBytes(value []byte, bits int, signed bool) []byte {
+	number := BytesToUint64(value)
+	if bits > 0 && bits < 64 {
+		number &= 1<<bits - 1
+		if signed && number&(1<<(bits-1)) != 0 {
+			number |= ^uint64(0) << bits
+		}
+	}
+	return UintToBytes(number, 8)
+}
+
+// rawFieldValue returns the value of the raw field bytes passed to convertFieldValue.
+func rawFieldValue(raw []byte, field FieldConfig) any {
+	raw = OrderBytes(raw, field.ByteOrder)
+	if field.Hex {
+		return HexValue(raw)
+	}
+
+	if field.BitLength > 0 {
+		raw = ExtractBits(raw, field.BitOffset, field.BitLength)
+	}
+	if field.Signedness != SignednessFromType {
+		bits := field.BitLength
+		if bits == 0 {
+			bits = len(raw) * 8
+		}
+		raw = ExtendBytes(raw, bits, field.Signedness == Signed)
+	}
+	return raw
+}
+
+// writeField writes the encoded bytes of a field, or of its bit range, in the
+// byte order of the field.
+func writeField(writer *PayloadWriter, field FieldConfig, start int, bytes []byte) {
+	length := field.Length
+	if length == -1 {
+		// dynamic length: the field takes all of its bytes
+		length = len(bytes)
+	}
+
+	if field.BitLength > 0 {
+		writer.Merge(start, length, OrderBytes(PackBits(bytes, length, field.BitOffset, field.BitLength), field.ByteOrder))
+	} else {
+		writer.Write(start, length, OrderBytes(bytes, field.ByteOrder))
+	}
+}
+
 func convertFieldValue(rawValue any, fieldType reflect.Type, transform func(v any) any) (any, error) {
 	var ptr = false
 	var value any = nil
@@ -137,40 +255,51 @@ func convertFieldValue(rawValue any, fieldType reflect.Type, transform func(v an
 	return value, err
 }
 
-func validateFieldValue(field reflect.StructField, fieldValue reflect.Value) error {
-	structType := reflect.StructOf([]reflect.StructField{field})
+// Decode decodes the hex encoded payload into a new value of config.TargetType.
+// Payload layouts with generated code (see RegisterGenerated) skip reflection.
+func Decode(payloadHex *string, config *PayloadConfig) (any, error) {
+	payloadBytes, err := HexStringToBytes(*payloadHex)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeBytes(payloadBytes, config)
+}
 
-	structValue := reflect.New(structType).Elem()
-	structValue.FieldByName(field.Name).Set(fieldValue)
+// DecodeBytes decodes the binary payload into a new value of config.TargetType.
+func DecodeBytes(payload []byte, config *PayloadConfig) (any, error) {
+	if codec := lookupGenerated(config); codec != nil && codec.Decode != nil {
+		return codec.Decode(payload, config)
+	}
 
-	return validator.New().Struct(structValue.Interface())
+	return decodeReflect(payload, config)
 }
 
-func Decode(payloadHex *string, config *PayloadConfig) (any, error) {
+// DecodeReflect decodes the payload like Decode but never uses generated code.
+func DecodeReflect(payloadHex *string, config *PayloadConfig) (any, error) {
 	payloadBytes, err := HexStringToBytes(*payloadHex)
 	if err != nil {
 		return nil, err
 	}
 
+	return decodeReflect(payloadBytes, config)
+}
+
+func decodeReflect(payloadBytes []byte, config *PayloadConfig) (any, error) {
 	targetValue := reflect.New(config.TargetType).Elem()
-	errs := []error{}
+	checks := []validationCheck{}
+
+	reader := NewFieldReader(payloadBytes, config)
 
 	if len(config.Tags) != 0 {
 		var index = 3
-		var payloadLength = len(payloadBytes)
-		for index < payloadLength {
-			if payloadLength-index < 2 {
-				return nil, fmt.Errorf("incomplete TLV header at offset %d: need 2 bytes but only %d remain", index, payloadLength-index)
+		for index < len(payloadBytes) {
+			tag, length, ok := reader.ReadTLV(index)
+			if !ok {
+				break
 			}
-
-			var tag = payloadBytes[index]
-			var length = int(payloadBytes[index+1])
 			index += 2
 
-			if index+length > payloadLength {
-				return nil, fmt.Errorf("TLV tag 0x%02x at offset %d declares length %d, but only %d bytes remain", tag, index-2, length, payloadLength-index)
-			}
-
 			var found bool
 			for _, tagConfig := range config.Tags {
 				if tagConfig.Tag == tag {
@@ -181,6 +310,9 @@ func Decode(payloadHex *string, config *PayloadConfig) (any, error) {
 					if err != nil {
 						return nil, err
 					}
+					if raw, ok := value.([]byte); ok {
+						value = rawFieldValue(raw, tagConfig.field())
+					}
 
 					fieldValue := targetValue.FieldByName(tagConfig.Name)
 					if fieldValue.IsValid() && fieldValue.CanSet() {
@@ -188,7 +320,7 @@ func Decode(payloadHex *string, config *PayloadConfig) (any, error) {
 							continue
 						}
 
-						convertedValue, err := convertFieldValue(value, fieldValue.Type(), tagConfig.Transform)
+						convertedValue, err := convertField(value, fieldValue.Type(), tagConfig.field())
 						if err != nil {
 							return nil, err
 						}
@@ -198,41 +330,39 @@ func Decode(payloadHex *string, config *PayloadConfig) (any, error) {
 						}
 					}
 
-					fieldName, ok := targetValue.Type().FieldByName(tagConfig.Name)
-					if ok {
-						err := validateFieldValue(fieldName, fieldValue)
-						if err != nil {
-							errs = append(errs, fmt.Errorf("%w for %s %v", ErrValidationFailed, fieldName.Name, DerefValue(fieldValue)))
-						}
-					}
+					checks = append(checks, validationCheck{field: tagConfig.Name})
 				}
 			}
 			if !found {
-				tagHex := fmt.Sprintf("0x%02x", tag)
-				unknownTLVTagsTotal.WithLabelValues(tagHex).Inc()
-				if logger.Logger != nil {
-					logger.Logger.Warn("skipping unknown tag", zap.String("tag", tagHex), zap.Int("length", length))
-				}
+				SkipUnknownTLVTag(tag, length)
 			}
 			index += length
 		}
 
-		return targetValue.Interface(), errors.Join(errs...)
+		errs := planValidation(config.TargetType).validate(targetValue, checks)
+		return reader.Result(targetValue.Interface(), errs)
 	}
 
 	for _, field := range config.Fields {
-		value, err := extractFieldValue(payloadBytes, field.Start, field.Length, field.Optional, field.Hex)
-		if err != nil {
-			return nil, err
+		if len(field.Group) != 0 {
+			groupChecks, err := decodeGroup(&reader, field, targetValue.FieldByName(field.Name))
+			if err != nil {
+				return nil, err
+			}
+			checks = append(checks, groupChecks...)
+			continue
 		}
 
+		raw, ok := reader.Read(field.Name, field.Start, field.Length, field.Optional)
+		if !ok {
+			continue
+		}
+
+		value := rawFieldValue(raw, field)
+
 		fieldValue := targetValue.FieldByName(field.Name)
 		if fieldValue.IsValid() && fieldValue.CanSet() {
-			if value == nil && field.Optional {
-				continue
-			}
-
-			convertedValue, err := convertFieldValue(value, fieldValue.Type(), field.Transform)
+			convertedValue, err := convertField(value, fieldValue.Type(), field)
 			if err != nil {
 				return nil, err
 			}
@@ -242,16 +372,11 @@ func Decode(payloadHex *string, config *PayloadConfig) (any, error) {
 			}
 		}
 
-		fieldName, ok := targetValue.Type().FieldByName(field.Name)
-		if ok {
-			err := validateFieldValue(fieldName, fieldValue)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("%w for %s %v", ErrValidationFailed, fieldName.Name, DerefValue(fieldValue)))
-			}
-		}
+		checks = append(checks, validationCheck{field: field.Name})
 	}
 
-	return targetValue.Interface(), errors.Join(errs...)
+	errs := planValidation(config.TargetType).validate(targetValue, checks)
+	return reader.Result(targetValue.Interface(), errs)
 }
 
 func insertFieldBytes(fieldValue reflect.Value, length int, transform func(v any) any) (bool, []byte, error) {
@@ -263,7 +388,7 @@ func insertFieldBytes(fieldValue reflect.Value, length int, transform func(v any
 	if fieldValue.Kind() == reflect.Ptr {
 		if fieldValue.IsNil() {
 			null = true
-			bytes = make([]byte, length)
+			bytes = make([]byte, max(length, 0))
 		} else {
 			fieldValue = fieldValue.Elem()
 		}
@@ -318,22 +443,25 @@ func insertFieldBytes(fieldValue reflect.Value, length int, transform func(v any
 	return set, bytes, err
 }
 
+// Encode encodes the struct data into a hex encoded payload.
+// Payload layouts with generated code (see RegisterGenerated) skip reflection.
 func Encode(data any, config PayloadConfig) (string, error) {
+	if codec := lookupGenerated(&config); codec != nil && codec.Encode != nil {
+		return codec.Encode(data, config)
+	}
+
+	return EncodeReflect(data, config)
+}
+
+// EncodeReflect encodes the data like Encode but never uses generated code.
+func EncodeReflect(data any, config PayloadConfig) (string, error) {
 	v := reflect.ValueOf(data)
 
 	if v.Kind() != reflect.Struct {
 		return "", fmt.Errorf("data must be a struct")
 	}
 
-	var maxLength int
-	for _, field := range config.Fields {
-		if field.Start+field.Length > maxLength {
-			maxLength = field.Start + field.Length
-		}
-	}
-	payload := make([]byte, maxLength)
-
-	var actualLength int
+	writer := NewPayloadWriter(EncodedLength(config.Fields))
 	for _, field := range config.Fields {
 		fieldValue := v.FieldByName(field.Name)
 
@@ -341,18 +469,25 @@ func Encode(data any, config PayloadConfig) (string, error) {
 			return "", fmt.Errorf("field %s not found in data", field.Name)
 		}
 
-		set, bytes, err := insertFieldBytes(fieldValue, field.Length, field.Transform)
+		if len(field.Group) != 0 {
+			err := encodeGroup(writer, field, fieldValue)
+			if err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		set, bytes, err := insertField(fieldValue, field)
 		if err != nil {
 			return "", err
 		}
 
 		if set || !field.Optional {
-			copy(payload[field.Start:field.Start+field.Length], bytes)
-			actualLength += field.Length
+			writeField(writer, field, field.Start, bytes)
 		}
 	}
 
-	return hex.EncodeToString(payload[0:actualLength]), nil
+	return writer.String(), nil
 }
 
 func BoolToBytes(value bool, bit uint8) []byte {
@@ -440,6 +575,10 @@ func Uint32Ptr(value uint32) *uint32 {
 	return &value
 }
 
+func Uint64Ptr(value uint64) *uint64 {
+	return &value
+}
+
 func Int8Ptr(value int8) *int8 {
 	return &value
 }
@@ -52,6 +52,42 @@ func TestValidateLength_WithFields(t *testing.T) {
 	}
 }
 
+func TestValidateBytesLength(t *testing.T) {
+	cfg := &PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "A", Start: 0, Length: 2, Optional: false},
+			{Name: "B", Start: 2, Length: 2, Optional: true},
+		},
+	}
+
+	if err := ValidateBytesLength([]byte{0x00}, cfg); err == nil || !errors.Is(err, ErrPayloadTooShort) {
+		t.Fatalf("expected ErrPayloadTooShort, got %v", err)
+	}
+	if err := ValidateBytesLength([]byte{0x00, 0x00, 0x00}, cfg); err != nil {
+		t.Fatalf("unexpected error for valid length: %v", err)
+	}
+	if err := ValidateBytesLength(make([]byte, 5), cfg); err == nil || !errors.Is(err, ErrPayloadTooLong) {
+		t.Fatalf("expected ErrPayloadTooLong, got %v", err)
+	}
+}
+
+func TestDecodeBytes(t *testing.T) {
+	cfg := &PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "Power", Start: 0, Length: 2, Optional: true},
+		},
+		TargetType: reflect.TypeOf(Port2Payload{}),
+	}
+
+	got, err := DecodeBytes([]byte{0x0a, 0x00}, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, Port2Payload{Power: Uint16Ptr(2560)}) {
+		t.Fatalf("unexpected result %+v", got)
+	}
+}
+
 func TestBoolToBytesAndBytesToBool(t *testing.T) {
 	if got := BoolToBytes(true, 0); len(got) != 1 || got[0] != 0x01 {
 		t.Fatalf("BoolToBytes(true,0) = %v want [1]", got)
@@ -251,6 +251,13 @@ func TestExtractFieldValue(t *testing.T) {
 			expected:    nil,
 			expectedErr: "field start out of bounds",
 		},
+		{
+			payload:  []byte{0x73, 0x6f, 0x72, 0x65, 0x6e},
+			start:    8,
+			length:   -1,
+			optional: true,
+			expected: nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -273,6 +280,271 @@ func TestExtractFieldValue(t *testing.T) {
 	}
 }
 
+func TestExtractBits(t *testing.T) {
+	tests := []struct {
+		value     []byte
+		bitOffset int
+		bitLength int
+		expected  []byte
+	}{
+		{
+			value:     []byte{0x80},
+			bitOffset: 7,
+			bitLength: 1,
+			expected:  []byte{0x01},
+		},
+		{
+			value:     []byte{0xbd},
+			bitOffset: 3,
+			bitLength: 4,
+			expected:  []byte{0x07},
+		},
+		{
+			value:     []byte{0xbd},
+			bitOffset: 0,
+			bitLength: 1,
+			expected:  []byte{0x01},
+		},
+		{
+			value:     []byte{0x01, 0x2c, 0x0e, 0x10},
+			bitOffset: 16,
+			bitLength: 16,
+			expected:  []byte{0x01, 0x2c},
+		},
+		{
+			value:     []byte{0x01, 0x2c, 0x0e, 0x10},
+			bitOffset: 4,
+			bitLength: 12,
+			expected:  []byte{0x00, 0xe1},
+		},
+		{
+			value:     []byte{0x0e, 0x10},
+			bitOffset: 16,
+			bitLength: 16,
+			expected:  []byte{0x00, 0x00},
+		},
+		{
+			value:     []byte{},
+			bitOffset: 0,
+			bitLength: 1,
+			expected:  []byte{0x00},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%x_%v_%v", test.value, test.bitOffset, test.bitLength), func(t *testing.T) {
+			result := ExtractBits(test.value, test.bitOffset, test.bitLength)
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Fatalf("expected: %x received: %x", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestPackBits(t *testing.T) {
+	tests := []struct {
+		value     []byte
+		length    int
+		bitOffset int
+		bitLength int
+		expected  []byte
+	}{
+		{
+			value:     []byte{0x01},
+			length:    1,
+			bitOffset: 7,
+			bitLength: 1,
+			expected:  []byte{0x80},
+		},
+		{
+			value:     []byte{0x07},
+			length:    1,
+			bitOffset: 3,
+			bitLength: 4,
+			expected:  []byte{0x38},
+		},
+		{
+			value:     []byte{0xff},
+			length:    1,
+			bitOffset: 3,
+			bitLength: 4,
+			expected:  []byte{0x78},
+		},
+		{
+			value:     []byte{0x01, 0x2c},
+			length:    4,
+			bitOffset: 16,
+			bitLength: 16,
+			expected:  []byte{0x01, 0x2c, 0x00, 0x00},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%x_%v_%v_%v", test.value, test.length, test.bitOffset, test.bitLength), func(t *testing.T) {
+			result := PackBits(test.value, test.length, test.bitOffset, test.bitLength)
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Fatalf("expected: %x received: %x", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestBitFields(t *testing.T) {
+	type Flags struct {
+		DutyCycle    bool
+		ConfigId     uint8 `validate:"lte=15"`
+		ConfigChange bool
+		Moving       bool
+		Interval     uint16
+	}
+
+	config := PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "Interval", Start: 1, Length: 2},
+		},
+		TargetType: reflect.TypeOf(Flags{}),
+	}
+
+	tests := []struct {
+		payload  string
+		expected Flags
+	}{
+		{
+			payload:  "000000",
+			expected: Flags{},
+		},
+		{
+			payload:  "bd012c",
+			expected: Flags{DutyCycle: true, ConfigId: 7, ConfigChange: true, Moving: true, Interval: 300},
+		},
+		{
+			payload:  "500e10",
+			expected: Flags{ConfigId: 10, Interval: 3600},
+		},
+		{
+			payload:  "810001",
+			expected: Flags{DutyCycle: true, Moving: true, Interval: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.payload, func(t *testing.T) {
+			decoded, err := Decode(&test.payload, &config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, test.expected) {
+				t.Fatalf("expected: %+v received: %+v", test.expected, decoded)
+			}
+
+			encoded, err := Encode(test.expected, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if encoded != test.payload {
+				t.Fatalf("expected: %s received: %s", test.payload, encoded)
+			}
+		})
+	}
+}
+
+func TestByteOrder(t *testing.T) {
+	type Sensor struct {
+		SystemTime  uint32
+		Temperature int32
+		Level       int8
+		Counter     uint32
+		Mac         string
+	}
+
+	config := PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "SystemTime", Start: 0, Length: 4, ByteOrder: LittleEndian},
+			{Name: "Temperature", Start: 4, Length: 2, ByteOrder: LittleEndian, Signedness: Signed},
+			{Name: "Level", Start: 6, Length: 2, BitOffset: 2, BitLength: 6, ByteOrder: LittleEndian, Signedness: Signed},
+			{Name: "Counter", Start: 8, Length: 3, ByteOrder: LittleEndian, Signedness: Unsigned},
+			{Name: "Mac", Start: 11, Length: 2, Hex: true, ByteOrder: LittleEndian},
+		},
+		TargetType: reflect.TypeOf(Sensor{}),
+	}
+
+	tests := []struct {
+		payload  string
+		expected Sensor
+	}{
+		{
+			payload:  "00000000000000000000000000",
+			expected: Sensor{Mac: "0000"},
+		},
+		{
+			payload:  "78563412ecff0000feffffaabb",
+			expected: Sensor{SystemTime: 0x12345678, Temperature: -20, Counter: 0xfffffe, Mac: "bbaa"},
+		},
+		{
+			payload:  "01000000f40180000100000102",
+			expected: Sensor{SystemTime: 1, Temperature: 500, Level: -32, Counter: 1, Mac: "0201"},
+		},
+		{
+			payload:  "00000000000074000000000000",
+			expected: Sensor{Level: 29, Mac: "0000"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.payload, func(t *testing.T) {
+			decoded, err := Decode(&test.payload, &config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, test.expected) {
+				t.Fatalf("expected: %+v received: %+v", test.expected, decoded)
+			}
+
+			encoded, err := Encode(test.expected, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if encoded != test.payload {
+				t.Fatalf("expected: %s received: %s", test.payload, encoded)
+			}
+		})
+	}
+}
+
+func TestExtendBytes(t *testing.T) {
+	tests := []struct {
+		value    []byte
+		bits     int
+		signed   bool
+		expected int64
+	}{
+		{value: []byte{0xff}, bits: 8, signed: true, expected: -1},
+		{value: []byte{0xff}, bits: 8, signed: false, expected: 255},
+		{value: []byte{0x80, 0x00}, bits: 16, signed: true, expected: -32768},
+		{value: []byte{0x7f, 0xff}, bits: 16, signed: true, expected: 32767},
+		{value: []byte{0x0d}, bits: 4, signed: true, expected: -3},
+		{value: []byte{0xfd}, bits: 4, signed: false, expected: 13},
+		{value: []byte{0xff, 0xff, 0xfe}, bits: 24, signed: true, expected: -2},
+		{value: []byte{}, bits: 0, signed: true, expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%x/%d/%t", test.value, test.bits, test.signed), func(t *testing.T) {
+			extended := ExtendBytes(test.value, test.bits, test.signed)
+			if len(extended) != 8 {
+				t.Fatalf("expected 8 bytes, got %d", len(extended))
+			}
+			if got := BytesToInt64(extended); got != test.expected {
+				t.Errorf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
 func TestConvertFieldValue(t *testing.T) {
 	tests := []struct {
 		value       any
@@ -0,0 +1,136 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+// MarshalNumbered marshals the payload like json.Marshal but writes the records of
+// its repeated groups as numbered fields, e.g. accessPoints as mac1, rssi1, mac2,
+// rssi2 and so on. This is the layout payloads had before repeated groups.
+func MarshalNumbered(payload any) ([]byte, error) {
+	return MarshalNumberedProfile(payload, decoder.ProfileDisplay)
+}
+
+// MarshalNumberedProfile is MarshalNumbered with the payload in the output profile.
+func MarshalNumberedProfile(payload any, profile decoder.OutputProfile) ([]byte, error) {
+	data, err := decoder.MarshalProfile(payload, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := numberedGroups(reflect.TypeOf(payload))
+	if len(groups) == 0 {
+		return data, nil
+	}
+
+	tokens := json.NewDecoder(bytes.NewReader(data))
+	token, err := tokens.Token()
+	if err != nil || token != json.Delim('{') {
+		return data, err
+	}
+
+	var out bytes.Buffer
+	out.WriteByte('{')
+	write := func(key string, value json.RawMessage) {
+		if out.Len() > 1 {
+			out.WriteByte(',')
+		}
+		name, _ := json.Marshal(key)
+		out.Write(name)
+		out.WriteByte(':')
+		out.Write(value)
+	}
+
+	for tokens.More() {
+		token, err := tokens.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := token.(string)
+
+		var value json.RawMessage
+		err = tokens.Decode(&value)
+		if err != nil {
+			return nil, err
+		}
+
+		names, ok := groups[key]
+		if !ok {
+			write(key, value)
+			continue
+		}
+
+		var records []map[string]json.RawMessage
+		err = json.Unmarshal(value, &records)
+		if err != nil {
+			return nil, err
+		}
+		for i, record := range records {
+			for _, name := range names {
+				if value, ok := record[name]; ok {
+					write(fmt.Sprintf("%s%d", name, i+1), value)
+				}
+			}
+		}
+	}
+	out.WriteByte('}')
+
+	return out.Bytes(), nil
+}
+
+// numberedGroups maps the JSON names of the repeated groups of a struct type
+// to the JSON names of the fields of their records.
+func numberedGroups(t reflect.Type) map[string][]string {
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	groups := map[string][]string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Type.Kind() != reflect.Slice || field.Type.Elem().Kind() != reflect.Struct {
+			continue
+		}
+
+		name, ok := jsonName(field)
+		if !ok {
+			continue
+		}
+
+		names := []string{}
+		for j := 0; j < field.Type.Elem().NumField(); j++ {
+			if member, ok := jsonName(field.Type.Elem().Field(j)); ok {
+				names = append(names, member)
+			}
+		}
+		groups[name] = names
+	}
+	return groups
+}
+
+func jsonName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	}
+	return name, true
+}
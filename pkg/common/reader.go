@@ -0,0 +1,127 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FieldReader reads the fields of a payload for Decode and the generated code.
+// A strict reader stops at the first field the payload is too short for, a
+// lenient one (see PayloadConfig.Lenient) records it and continues.
+type FieldReader struct {
+	payload []byte
+	tags    []TagConfig
+	lenient bool
+	err     error
+	missing []FieldError
+}
+
+func NewFieldReader(payload []byte, config *PayloadConfig) FieldReader {
+	return FieldReader{
+		payload: payload,
+		tags:    config.Tags,
+		lenient: config.Lenient,
+	}
+}
+
+// Read returns the bytes of a field and whether the field is present.
+// Missing optional fields and fields that could not be read are not present.
+func (r *FieldReader) Read(name string, start int, length int, optional bool) ([]byte, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	value, err := extractFieldValue(r.payload, start, length, optional, false)
+	if err != nil {
+		// a field with dynamic length needs at least one byte
+		if length == -1 {
+			length = 1
+		}
+		missing := FieldError{
+			Name:      name,
+			Offset:    start,
+			Length:    length,
+			Available: max(len(r.payload)-start, 0),
+		}
+
+		if !r.lenient {
+			decodeError := &DecodeError{
+				err:            err,
+				field:          name,
+				offset:         start,
+				hasOffset:      true,
+				expectedLength: missing.Length,
+				actualLength:   missing.Available,
+			}
+			decodeError.setRaw(r.payload)
+			r.err = decodeError
+			return nil, false
+		}
+
+		r.missing = append(r.missing, missing)
+		return nil, false
+	}
+
+	if value == nil {
+		return nil, false
+	}
+	return value.([]byte), true
+}
+
+// ReadTLV reads the header of the TLV value at index, see ReadTLVHeader.
+// It reports false once no further value can be read.
+func (r *FieldReader) ReadTLV(index int) (uint8, int, bool) {
+	if r.err != nil {
+		return 0, 0, false
+	}
+
+	tag, length, err := ReadTLVHeader(r.payload, index)
+	if err == nil {
+		return tag, length, true
+	}
+
+	if !r.lenient {
+		r.err = err
+		return 0, 0, false
+	}
+
+	// an incomplete header is missing the length byte, otherwise the value is truncated
+	tag = r.payload[index]
+	missing := FieldError{Offset: index + 1, Length: 1}
+	if len(r.payload)-index >= 2 {
+		missing = FieldError{
+			Offset:    index + 2,
+			Length:    int(r.payload[index+1]),
+			Available: len(r.payload) - index - 2,
+		}
+	}
+
+	var found bool
+	for _, tagConfig := range r.tags {
+		if tagConfig.Tag == tag {
+			found = true
+			missing.Name = tagConfig.Name
+			r.missing = append(r.missing, missing)
+		}
+	}
+	if !found {
+		missing.Name = fmt.Sprintf("0x%02x", tag)
+		r.missing = append(r.missing, missing)
+	}
+
+	return 0, 0, false
+}
+
+// Result returns the decoded value together with the validation errors and the
+// fields a lenient reader could not read. A strict reader that failed returns
+// its error without a value.
+func (r *FieldReader) Result(value any, errs []error) (any, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if len(r.missing) != 0 {
+		errs = append(errs, &PartialDecodeError{Fields: r.missing})
+	}
+	return value, errors.Join(errs...)
+}
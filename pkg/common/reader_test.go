@@ -0,0 +1,171 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type lenientPayload struct {
+	Battery   uint16  `validate:"gte=1"`
+	Latitude  int32   `validate:"gte=-90"`
+	Longitude *uint16 `validate:"omitempty,gte=1"`
+	Name      *string `validate:"omitempty"`
+}
+
+func TestFieldReader(t *testing.T) {
+	config := PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "Battery", Start: 0, Length: 2},
+			{Name: "Latitude", Start: 2, Length: 1},
+			{Name: "Longitude", Start: 3, Length: 2},
+			{Name: "Name", Start: 5, Length: -1, Hex: true},
+		},
+		TargetType: reflect.TypeOf(lenientPayload{}),
+	}
+
+	tests := []struct {
+		payload  []byte
+		lenient  bool
+		expected any
+		missing  []FieldError
+		err      error
+	}{
+		{
+			payload:  []byte{0x0e, 0x10, 0x2a, 0x00, 0x07, 0xab},
+			expected: lenientPayload{Battery: 3600, Latitude: 42, Longitude: Uint16Ptr(7), Name: StringPtr("ab")},
+		},
+		{
+			payload: []byte{0x0e, 0x10, 0x2a},
+			err:     ErrFieldOutOfBounds,
+		},
+		{
+			payload:  []byte{0x0e, 0x10, 0x2a},
+			lenient:  true,
+			expected: lenientPayload{Battery: 3600, Latitude: 42},
+			missing: []FieldError{
+				{Name: "Longitude", Offset: 3, Length: 2, Available: 0},
+				{Name: "Name", Offset: 5, Length: 1, Available: 0},
+			},
+		},
+		{
+			payload:  []byte{0x0e},
+			lenient:  true,
+			expected: lenientPayload{},
+			missing: []FieldError{
+				{Name: "Battery", Offset: 0, Length: 2, Available: 1},
+				{Name: "Latitude", Offset: 2, Length: 1, Available: 0},
+				{Name: "Longitude", Offset: 3, Length: 2, Available: 0},
+				{Name: "Name", Offset: 5, Length: 1, Available: 0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		config.Lenient = test.lenient
+		got, err := DecodeBytes(test.payload, &config)
+
+		if test.err != nil {
+			if !errors.Is(err, test.err) || got != nil {
+				t.Errorf("expected error %v without data, got %v and %v", test.err, got, err)
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("expected: %v\ngot: %v", test.expected, got)
+		}
+
+		var partial *PartialDecodeError
+		if test.missing == nil {
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			continue
+		}
+		if !errors.As(err, &partial) || !errors.Is(err, ErrFieldOutOfBounds) {
+			t.Fatalf("expected partial decode error, got %v", err)
+		}
+		if !reflect.DeepEqual(partial.Fields, test.missing) {
+			t.Errorf("expected missing fields %v, got %v", test.missing, partial.Fields)
+		}
+	}
+}
+
+func TestFieldReaderTLV(t *testing.T) {
+	config := PayloadConfig{
+		Tags: []TagConfig{
+			{Name: "Battery", Tag: 0x45, Optional: true},
+			{Name: "Latitude", Tag: 0x46, Optional: true},
+		},
+		TargetType: reflect.TypeOf(lenientPayload{}),
+	}
+
+	tests := []struct {
+		payload  []byte
+		expected any
+		missing  []FieldError
+	}{
+		{
+			payload:  []byte{0x00, 0x00, 0x00, 0x45, 0x02, 0x0e, 0x10, 0x46, 0x04, 0x00},
+			expected: lenientPayload{Battery: 3600},
+			missing: []FieldError{
+				{Name: "Latitude", Offset: 9, Length: 4, Available: 1},
+			},
+		},
+		{
+			payload:  []byte{0x00, 0x00, 0x00, 0x45, 0x02, 0x0e, 0x10, 0x46},
+			expected: lenientPayload{Battery: 3600},
+			missing: []FieldError{
+				{Name: "Latitude", Offset: 8, Length: 1, Available: 0},
+			},
+		},
+		{
+			payload:  []byte{0x00, 0x00, 0x00, 0x45, 0x02, 0x0e, 0x10, 0x99, 0x02, 0x00},
+			expected: lenientPayload{Battery: 3600},
+			missing: []FieldError{
+				{Name: "0x99", Offset: 9, Length: 2, Available: 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		config.Lenient = false
+		_, err := DecodeBytes(test.payload, &config)
+		if err == nil {
+			t.Errorf("expected error for strict decode of %x", test.payload)
+		}
+
+		config.Lenient = true
+		got, err := DecodeBytes(test.payload, &config)
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("expected: %v\ngot: %v", test.expected, got)
+		}
+
+		var partial *PartialDecodeError
+		if !errors.As(err, &partial) {
+			t.Fatalf("expected partial decode error, got %v", err)
+		}
+		if !reflect.DeepEqual(partial.Fields, test.missing) {
+			t.Errorf("expected missing fields %v, got %v", test.missing, partial.Fields)
+		}
+	}
+}
+
+func TestPartialDecodeError(t *testing.T) {
+	err := &PartialDecodeError{
+		Fields: []FieldError{
+			{Name: "Longitude", Offset: 3, Length: 2, Available: 1},
+		},
+	}
+
+	expected := "partial payload, 1 fields missing: field Longitude out of bounds at offset 3: need 2 bytes but only 1 available"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+
+	var fieldError FieldError
+	if !errors.As(err, &fieldError) || fieldError.Name != "Longitude" {
+		t.Errorf("expected field error for Longitude, got %v", fieldError)
+	}
+}
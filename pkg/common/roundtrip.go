@@ -0,0 +1,311 @@
+package common
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// RoundTrip checks that a payload struct survives encoding and decoding. It
+// generates random payloads laid out along the fields of Config, keeps the ones
+// which decode into a valid struct and compares that struct with the result of
+// encoding and decoding it again.
+type RoundTrip struct {
+	// Config is the payload config of the encoder.
+	Config PayloadConfig
+	// Decode decodes a binary payload into a value of Config.TargetType.
+	Decode func(payload []byte) (any, error)
+	// Encode encodes a value of Config.TargetType into a hex encoded payload.
+	Encode func(data any) (string, error)
+}
+
+// RoundTripLoss is a field whose value changed during the round trip.
+type RoundTripLoss struct {
+	Field    string
+	Payload  string
+	Expected any
+	Received any
+}
+
+func (l RoundTripLoss) String() string {
+	return fmt.Sprintf("%s: expected %v, received %v (payload %s)", l.Field, l.Expected, l.Received, l.Payload)
+}
+
+// roundTripSlot is a field of a generated payload.
+type roundTripSlot struct {
+	field FieldConfig
+	start int
+	check validationCheck
+	bytes []byte
+}
+
+// maxRoundTripAttempts limits how often the fields of a payload are sampled
+// again until the payload decodes into a valid struct.
+const maxRoundTripAttempts = 1000
+
+// Check runs the round trip for the given number of random payloads and returns
+// the first loss of every field which changed, in the order of the fields.
+func (r RoundTrip) Check(rng *rand.Rand, runs int) ([]RoundTripLoss, error) {
+	losses := []RoundTripLoss{}
+	seen := map[string]bool{}
+
+	for run := 0; run < runs; run++ {
+		payload, expected, err := r.generate(rng)
+		if err != nil {
+			return losses, err
+		}
+
+		encoded, err := r.Encode(expected)
+		if err != nil {
+			return losses, fmt.Errorf("encoding payload %x: %w", payload, err)
+		}
+		bytes, err := hex.DecodeString(encoded)
+		if err != nil {
+			return losses, fmt.Errorf("encoding payload %x: %w", payload, err)
+		}
+
+		received, err := r.Decode(bytes)
+		if err != nil && !errors.Is(err, ErrValidationFailed) {
+			return losses, fmt.Errorf("decoding encoded payload %s: %w", encoded, err)
+		}
+
+		for _, loss := range compareRoundTrip(reflect.ValueOf(expected), reflect.ValueOf(received), "") {
+			if seen[loss.Field] {
+				continue
+			}
+			seen[loss.Field] = true
+			loss.Payload = hex.EncodeToString(payload)
+			losses = append(losses, loss)
+		}
+	}
+
+	return losses, nil
+}
+
+// generate returns a random payload and the valid struct it decodes into.
+func (r RoundTrip) generate(rng *rand.Rand) ([]byte, any, error) {
+	if len(r.Config.Tags) != 0 {
+		return nil, nil, fmt.Errorf("round trip of TLV payloads is not supported")
+	}
+
+	slots := r.layout(rng)
+	for _, slot := range slots {
+		slot.bytes = r.sample(rng, slot)
+	}
+
+	plan := planValidation(r.Config.TargetType)
+	for range maxRoundTripAttempts {
+		writer := NewPayloadWriter(0)
+		for _, slot := range slots {
+			writeField(writer, slot.field, slot.start, slot.bytes)
+		}
+		payload := writer.Bytes()
+
+		data, err := r.Decode(payload)
+		if err == nil {
+			return payload, data, nil
+		}
+		if !errors.Is(err, ErrValidationFailed) {
+			return nil, nil, fmt.Errorf("decoding payload %x: %w", payload, err)
+		}
+
+		value := reflect.ValueOf(data)
+		if value.Type() != r.Config.TargetType {
+			return nil, nil, fmt.Errorf("decoded %v instead of %v", value.Type(), r.Config.TargetType)
+		}
+		for _, slot := range slots {
+			if len(plan.validate(value, []validationCheck{slot.check})) != 0 {
+				slot.bytes = r.sample(rng, slot)
+			}
+		}
+	}
+
+	return nil, nil, fmt.Errorf("no valid %v found after %d attempts", r.Config.TargetType, maxRoundTripAttempts)
+}
+
+// layout returns the fields of a random payload. Optional fields are left out
+// from a random field on, repeated groups get a random number of records.
+func (r RoundTrip) layout(rng *rand.Rand) []*roundTripSlot {
+	slots := []*roundTripSlot{}
+	optional := true
+
+	for _, field := range r.Config.Fields {
+		if field.Optional && (!optional || rng.IntN(2) == 0) {
+			optional = false
+			continue
+		}
+
+		if len(field.Group) == 0 {
+			slots = append(slots, &roundTripSlot{field: field, start: field.Start, check: validationCheck{field: field.Name}})
+			continue
+		}
+
+		count := field.MaxCount
+		if count == 0 {
+			count = 8
+		}
+		count = rng.IntN(count) + 1
+		if field.Optional {
+			count--
+		}
+		for i := 0; i < count; i++ {
+			for _, member := range field.Group {
+				slots = append(slots, &roundTripSlot{
+					field: member,
+					start: GroupStart(field, i) + member.Start,
+					check: validationCheck{field: field.Name, item: i, member: member.Name},
+				})
+			}
+		}
+	}
+
+	return slots
+}
+
+// sample returns random bytes for the field. Integers without transform are
+// taken from the range of their validate tag, everything else favors small
+// numbers, which are more likely to be valid after the transform of the decoder.
+func (r RoundTrip) sample(rng *rand.Rand, slot *roundTripSlot) []byte {
+	bits := slot.field.BitLength
+	if bits == 0 {
+		bits = slot.field.Length * 8
+	}
+	size := (bits + 7) / 8
+
+	var number uint64
+	if low, high, ok := r.bounds(slot, bits); ok {
+		number = uint64(low + rng.Int64N(high-low+1))
+	} else {
+		number = rng.Uint64()
+		if !slot.field.Hex {
+			significant := rng.IntN(bits + 1)
+			if significant < 64 {
+				number &= 1<<significant - 1
+			}
+			if rng.IntN(4) == 0 {
+				number = ^number
+			}
+		}
+	}
+
+	bytes := make([]byte, size)
+	for i := size - 1; i >= 0 && i >= size-8; i-- {
+		bytes[i] = byte(number)
+		number >>= 8
+	}
+	if size > 8 {
+		for i := 0; i < size-8; i++ {
+			bytes[i] = byte(rng.Uint32())
+		}
+	}
+	if bits%8 != 0 {
+		bytes[0] &= 1<<(bits%8) - 1
+	}
+	return bytes
+}
+
+// bounds returns the range of an integer field without transform, limited by
+// its validate tag and the bits of the field.
+func (r RoundTrip) bounds(slot *roundTripSlot, bits int) (int64, int64, bool) {
+	if slot.field.Transform != nil || slot.field.scaled() || slot.field.Hex || bits > 62 {
+		return 0, 0, false
+	}
+
+	structType := r.Config.TargetType
+	if slot.check.member != "" {
+		group, ok := structType.FieldByName(slot.check.field)
+		if !ok || group.Type.Kind() != reflect.Slice {
+			return 0, 0, false
+		}
+		structType = group.Type.Elem()
+	}
+	field, ok := structType.FieldByName(slot.field.Name)
+	if !ok {
+		return 0, 0, false
+	}
+
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	var low, high int64
+	switch fieldType.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		low, high = -(1 << (min(bits, fieldType.Bits()) - 1)), 1<<(min(bits, fieldType.Bits())-1)-1
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		low, high = 0, 1<<min(bits, fieldType.Bits())-1
+	default:
+		return 0, 0, false
+	}
+
+	for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+		key, value, _ := strings.Cut(rule, "=")
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch key {
+		case "gte", "min":
+			low = max(low, number)
+		case "gt":
+			low = max(low, number+1)
+		case "lte", "max":
+			high = min(high, number)
+		case "lt":
+			high = min(high, number-1)
+		}
+	}
+
+	if low > high {
+		return 0, 0, false
+	}
+	if low < 0 {
+		// the bytes of negative numbers are their two's complement
+		return low, high, bits == fieldType.Bits()
+	}
+	return low, high, true
+}
+
+// compareRoundTrip returns the exported fields of the structs which differ. The
+// fields of the records of repeated groups are named like AccessPoints.Rssi.
+func compareRoundTrip(expected reflect.Value, received reflect.Value, prefix string) []RoundTripLoss {
+	if !received.IsValid() || expected.Type() != received.Type() {
+		var value any
+		if received.IsValid() {
+			value = received.Interface()
+		}
+		return []RoundTripLoss{{Field: strings.TrimSuffix(prefix, "."), Expected: expected.Interface(), Received: value}}
+	}
+
+	losses := []RoundTripLoss{}
+	for i := 0; i < expected.NumField(); i++ {
+		field := expected.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := prefix + field.Name
+		a, b := expected.Field(i), received.Field(i)
+
+		if field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Struct {
+			if a.Len() != b.Len() {
+				losses = append(losses, RoundTripLoss{Field: name, Expected: a.Len(), Received: b.Len()})
+				continue
+			}
+			for j := 0; j < a.Len(); j++ {
+				losses = append(losses, compareRoundTrip(a.Index(j), b.Index(j), name+".")...)
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			losses = append(losses, RoundTripLoss{Field: name, Expected: DerefValue(a), Received: DerefValue(b)})
+		}
+	}
+	return losses
+}
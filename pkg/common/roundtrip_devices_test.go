@@ -0,0 +1,82 @@
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+	_ "github.com/truvami/decoder/pkg/devices"
+)
+
+// lossyFields lists by device and port the fields which change when a decoded
+// payload is encoded and decoded again.
+var lossyFields = map[string]map[uint8][]string{
+	// an optional temperature of zero is not encoded, which moves the pressure
+	// into its place
+	"nomadxs/v1": {1: {"Pressure"}},
+	// optional fields holding zero are not encoded
+	"tagsl/v1": {4: {"BatchSize", "BufferSize"}},
+}
+
+func TestDeviceRoundTrip(t *testing.T) {
+	for _, device := range decoder.Devices() {
+		if device.NewEncoder == nil {
+			continue
+		}
+		provider, ok := device.NewEncoder().(common.PayloadConfigProvider)
+		if !ok {
+			continue
+		}
+		d, err := decoder.New(device.Path(), decoder.DeviceOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, port := range device.Ports {
+			config, err := provider.PayloadConfig(port)
+			if err != nil {
+				// the encoder does not support every uplink
+				continue
+			}
+
+			t.Run(fmt.Sprintf("%s/%s", device.Path(), config.TargetType.Name()), func(t *testing.T) {
+				roundTrip := common.RoundTrip{
+					Config: config,
+					Decode: func(payload []byte) (any, error) {
+						uplink, err := d.DecodeBytes(context.TODO(), payload, port)
+						if uplink == nil {
+							return nil, err
+						}
+						return uplink.Data, err
+					},
+					Encode: func(data any) (string, error) {
+						return common.Encode(data, config)
+					},
+				}
+
+				losses, err := roundTrip.Check(rand.New(rand.NewPCG(1, uint64(port))), 500)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				expected := lossyFields[device.Path()][port]
+				fields := []string{}
+				for _, loss := range losses {
+					fields = append(fields, loss.Field)
+					if !slices.Contains(expected, loss.Field) {
+						t.Errorf("port %d loses %v", port, loss)
+					}
+				}
+				for _, field := range expected {
+					if !slices.Contains(fields, field) {
+						t.Errorf("port %d no longer loses %s, remove it from lossyFields", port, field)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	type Status struct {
+		DutyCycle bool
+		ConfigId  uint8 `validate:"lte=12"`
+		Interval  uint16
+		Level     int8 `validate:"gte=-20,lte=60"`
+		Records   []groupRecord
+		Battery   *uint16 `validate:"gte=1000"`
+	}
+
+	config := PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "Interval", Start: 1, Length: 2},
+			{Name: "Level", Start: 3, Length: 1},
+			{Name: "Records", Start: 4, Length: 3, MaxCount: 2, Group: []FieldConfig{
+				{Name: "Mac", Start: 0, Length: 2, Hex: true},
+				{Name: "Rssi", Start: 2, Length: 1},
+			}},
+			{Name: "Battery", Start: 10, Length: 2, Optional: true},
+		},
+		TargetType: reflect.TypeOf(Status{}),
+	}
+
+	// drops the duty cycle bit and the rssi of every record
+	lossy := config
+	lossy.Fields = []FieldConfig{config.Fields[1], config.Fields[2], config.Fields[3], {
+		Name: "Records", Start: 4, Length: 3, MaxCount: 2, Group: []FieldConfig{{Name: "Mac", Start: 0, Length: 2, Hex: true}},
+	}, config.Fields[5]}
+
+	tests := []struct {
+		name     string
+		encode   PayloadConfig
+		expected []string
+	}{
+		{name: "Lossless", encode: config, expected: []string{}},
+		{name: "Lossy", encode: lossy, expected: []string{"DutyCycle", "Records.Rssi"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			roundTrip := RoundTrip{
+				Config: config,
+				Decode: func(payload []byte) (any, error) {
+					return decodeReflect(payload, &config)
+				},
+				Encode: func(data any) (string, error) {
+					return EncodeReflect(data, test.encode)
+				},
+			}
+
+			losses, err := roundTrip.Check(rand.New(rand.NewPCG(1, 2)), 200)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			fields := []string{}
+			for _, loss := range losses {
+				fields = append(fields, loss.Field)
+			}
+			if !reflect.DeepEqual(fields, test.expected) {
+				t.Errorf("expected losses %v, got %v", test.expected, losses)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package common
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// scaled reports whether Decode and Encode convert the field with its Scale and
+// Offset. A Transform takes precedence.
+func (f FieldConfig) scaled() bool {
+	return f.Transform == nil && (f.Scale != 0 || f.Offset != 0)
+}
+
+// ScaledValue returns the physical value of the big-endian integer bytes, the
+// integer times scale plus offset. A scale of 0 leaves the integer unscaled.
+func ScaledValue(raw []byte, signed bool, scale float64, offset float64) float64 {
+	var value float64
+	if signed {
+		value = float64(int64(BytesToUint64(ExtendBytes(raw, len(raw)*8, true))))
+	} else {
+		value = float64(BytesToUint64(raw))
+	}
+
+	if scale != 0 {
+		// dividing by the inverse keeps decimal scales such as 0.001 exact
+		if inverse, ok := inverseScale(scale); ok {
+			value /= inverse
+		} else {
+			value *= scale
+		}
+	}
+
+	return value + offset
+}
+
+// UnscaledBytes returns the big-endian integer bytes of the physical value, the
+// inverse of ScaledValue. The integer is rounded to the nearest whole number.
+func UnscaledBytes(value float64, scale float64, offset float64, length int) []byte {
+	value -= offset
+
+	if scale != 0 {
+		if inverse, ok := inverseScale(scale); ok {
+			value *= inverse
+		} else {
+			value /= scale
+		}
+	}
+
+	return IntToBytes(int64(math.Round(value)), length)
+}
+
+// inverseScale returns the inverse of the scale if it is a whole number.
+func inverseScale(scale float64) (float64, bool) {
+	inverse := 1 / scale
+	rounded := math.Round(inverse)
+	return rounded, math.Abs(inverse-rounded) < 1e-9
+}
+
+// convertField converts the raw value of the field like convertFieldValue, but
+// applies the Scale and Offset of scaled fields.
+func convertField(rawValue any, fieldType reflect.Type, field FieldConfig) (any, error) {
+	if !field.scaled() {
+		return convertFieldValue(rawValue, fieldType, field.Transform)
+	}
+
+	raw, ok := rawValue.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unsupported value %T of scaled field %s", rawValue, field.Name)
+	}
+	value := ScaledValue(raw, field.Signedness == Signed, field.Scale, field.Offset)
+
+	elemType := fieldType
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+
+	result := reflect.New(elemType).Elem()
+	switch {
+	case elemType == reflect.TypeOf(time.Time{}):
+		result.Set(reflect.ValueOf(time.Unix(int64(value), 0).UTC()))
+	case elemType == reflect.TypeOf(time.Duration(0)):
+		result.SetInt(int64(value * float64(time.Second)))
+	case elemType.Kind() == reflect.Float32 || elemType.Kind() == reflect.Float64:
+		result.SetFloat(value)
+	case elemType.Kind() >= reflect.Int && elemType.Kind() <= reflect.Int64:
+		result.SetInt(int64(math.Round(value)))
+	case elemType.Kind() >= reflect.Uint && elemType.Kind() <= reflect.Uint64:
+		result.SetUint(uint64(math.Round(value)))
+	default:
+		return nil, fmt.Errorf("unsupported field type of scaled field %s: %v", field.Name, fieldType)
+	}
+
+	if fieldType.Kind() == reflect.Ptr {
+		return result.Addr().Interface(), nil
+	}
+	return result.Interface(), nil
+}
+
+// insertField returns the encoded bytes of the field like insertFieldBytes, but
+// applies the Scale and Offset of scaled fields.
+func insertField(fieldValue reflect.Value, field FieldConfig) (bool, []byte, error) {
+	if !field.scaled() {
+		return insertFieldBytes(fieldValue, field.Length, field.Transform)
+	}
+
+	if fieldValue.Kind() == reflect.Ptr {
+		if fieldValue.IsNil() {
+			return false, make([]byte, max(field.Length, 0)), nil
+		}
+		fieldValue = fieldValue.Elem()
+	}
+
+	var value float64
+	switch {
+	case fieldValue.Type() == reflect.TypeOf(time.Time{}):
+		value = float64(fieldValue.Interface().(time.Time).Unix())
+	case fieldValue.Type() == reflect.TypeOf(time.Duration(0)):
+		value = time.Duration(fieldValue.Int()).Seconds()
+	case fieldValue.CanFloat():
+		value = fieldValue.Float()
+	case fieldValue.CanInt():
+		value = float64(fieldValue.Int())
+	case fieldValue.CanUint():
+		value = float64(fieldValue.Uint())
+	default:
+		return false, nil, fmt.Errorf("unsupported field type of scaled field %s: %v", field.Name, fieldValue.Type())
+	}
+
+	return value != 0, UnscaledBytes(value, field.Scale, field.Offset, field.Length), nil
+}
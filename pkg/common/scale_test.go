@@ -0,0 +1,155 @@
+package common
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestScaledValue(t *testing.T) {
+	tests := []struct {
+		raw      string
+		signed   bool
+		scale    float64
+		offset   float64
+		expected float64
+	}{
+		{raw: "02cdcd14", signed: true, scale: 0.000001, expected: 47.041812},
+		{raw: "fd3232f8", signed: true, scale: 0.000001, expected: -47.0418},
+		{raw: "fd3232f8", signed: false, scale: 0.000001, expected: 4247.925496},
+		{raw: "1125", scale: 0.1, expected: 438.9},
+		{raw: "0ede", scale: 0.001, expected: 3.806},
+		{raw: "05", scale: 0.5, expected: 2.5},
+		{raw: "64", scale: 0.5, offset: -40, expected: 10},
+		{raw: "0a", scale: 0, offset: 1, expected: 11},
+		{raw: "03", scale: 3, expected: 9},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s/%t/%v/%v", test.raw, test.signed, test.scale, test.offset), func(t *testing.T) {
+			raw, _ := hex.DecodeString(test.raw)
+			if got := ScaledValue(raw, test.signed, test.scale, test.offset); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+
+			bytes := UnscaledBytes(test.expected, test.scale, test.offset, len(raw))
+			if hex.EncodeToString(bytes) != test.raw {
+				t.Errorf("expected %s, got %x", test.raw, bytes)
+			}
+		})
+	}
+}
+
+func TestScaledFields(t *testing.T) {
+	type Position struct {
+		Latitude    float64
+		Altitude    *float64
+		Temperature float32
+		Level       int8
+		TTF         time.Duration
+		Timestamp   time.Time
+	}
+
+	config := PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "Latitude", Start: 0, Length: 4, Signedness: Signed, Scale: 0.000001, Unit: "°"},
+			{Name: "Altitude", Start: 4, Length: 2, Scale: 0.1, Unit: "m"},
+			{Name: "Temperature", Start: 6, Length: 1, Scale: 0.5, Offset: -40, Unit: "°C"},
+			{Name: "Level", Start: 7, Length: 1, BitOffset: 4, BitLength: 4, Signedness: Signed, Scale: 10},
+			{Name: "TTF", Start: 8, Length: 1, Scale: 1, Unit: "s"},
+			{Name: "Timestamp", Start: 9, Length: 4, Scale: 1, Unit: "s"},
+		},
+		TargetType: reflect.TypeOf(Position{}),
+	}
+
+	tests := []struct {
+		payload  string
+		expected Position
+	}{
+		{
+			payload:  "02cdcd1411256400196553f100",
+			expected: Position{Latitude: 47.041812, Altitude: Float64Ptr(438.9), Temperature: 10, TTF: 25 * time.Second, Timestamp: time.Unix(1700000000, 0).UTC()},
+		},
+		{
+			payload:  "fd3232f8000000e0000000000a",
+			expected: Position{Latitude: -47.0418, Altitude: Float64Ptr(0), Temperature: -40, Level: -20, Timestamp: time.Unix(10, 0).UTC()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.payload, func(t *testing.T) {
+			decoded, err := DecodeReflect(&test.payload, &config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, test.expected) {
+				t.Fatalf("expected: %+v received: %+v", test.expected, decoded)
+			}
+
+			encoded, err := EncodeReflect(test.expected, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if encoded != test.payload {
+				t.Fatalf("expected: %s received: %s", test.payload, encoded)
+			}
+		})
+	}
+}
+
+func TestScaledTags(t *testing.T) {
+	type Status struct {
+		Battery     *float32
+		Temperature float64
+	}
+
+	config := PayloadConfig{
+		Tags: []TagConfig{
+			{Name: "Battery", Tag: 0x45, Optional: true, Scale: 0.001, Unit: "V"},
+			{Name: "Temperature", Tag: 0x50, Scale: 0.5, Offset: -40, Unit: "°C"},
+		},
+		TargetType: reflect.TypeOf(Status{}),
+	}
+
+	payload := "4c0000450200e450016e"
+	decoded, err := DecodeReflect(&payload, &config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := Status{Battery: Float32Ptr(0.228), Temperature: 15}
+	if !reflect.DeepEqual(decoded, expected) {
+		t.Errorf("expected: %+v received: %+v", expected, decoded)
+	}
+
+	explanations, err := Explain([]byte{0x4c, 0x00, 0x00, 0x45, 0x02, 0x00, 0xe4}, &config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(explanations) != 1 || explanations[0].Value != float32(0.228) || explanations[0].Unit != "V" {
+		t.Errorf("unexpected explanations %+v", explanations)
+	}
+}
+
+func TestScaledFieldErrors(t *testing.T) {
+	type Payload struct {
+		Name string
+	}
+
+	config := PayloadConfig{
+		Fields:     []FieldConfig{{Name: "Name", Start: 0, Length: 1, Scale: 0.1}},
+		TargetType: reflect.TypeOf(Payload{}),
+	}
+
+	payload := "01"
+	_, err := DecodeReflect(&payload, &config)
+	if err == nil {
+		t.Error("expected error for scaled string field")
+	}
+
+	_, err = EncodeReflect(Payload{Name: "a"}, config)
+	if err == nil {
+		t.Error("expected error for scaled string field")
+	}
+}
@@ -0,0 +1,100 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// validationPlan holds the validate rules of the fields of a payload type. It
+// is compiled once per type and checks the decoded payload in a single pass.
+type validationPlan struct {
+	fields map[string]validationRule
+}
+
+// validationRule is the validate tag of a field and, for repeated groups, the
+// rules of the fields of its records.
+type validationRule struct {
+	index   int
+	rules   string
+	members map[string]validationRule
+}
+
+// validationCheck is a field read by Decode. Only fields present in the payload
+// are validated, in the order they were read.
+type validationCheck struct {
+	field  string
+	item   int
+	member string
+}
+
+var validationPlans sync.Map
+
+// planValidation returns the cached validation plan of the payload type.
+func planValidation(t reflect.Type) *validationPlan {
+	if plan, ok := validationPlans.Load(t); ok {
+		return plan.(*validationPlan)
+	}
+
+	plan, _ := validationPlans.LoadOrStore(t, &validationPlan{fields: compileRules(t)})
+	return plan.(*validationPlan)
+}
+
+func compileRules(t reflect.Type) map[string]validationRule {
+	rules := map[string]validationRule{}
+	if t.Kind() != reflect.Struct {
+		return rules
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		rule := validationRule{index: i, rules: field.Tag.Get("validate")}
+		if field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Struct {
+			rule.members = compileRules(field.Type.Elem())
+		}
+		if rule.rules != "" || len(rule.members) != 0 {
+			rules[field.Name] = rule
+		}
+	}
+	return rules
+}
+
+// validate checks the fields of the decoded struct and returns an error for
+// every field which violates its rules.
+func (p *validationPlan) validate(value reflect.Value, checks []validationCheck) []error {
+	errs := []error{}
+	for _, check := range checks {
+		rule, ok := p.fields[check.field]
+		if !ok {
+			continue
+		}
+
+		name := check.field
+		fieldValue := value.Field(rule.index)
+		if check.member != "" {
+			rule, ok = rule.members[check.member]
+			if !ok || check.item >= fieldValue.Len() {
+				continue
+			}
+			name = GroupFieldName(check.field, check.item, check.member)
+			fieldValue = fieldValue.Index(check.item).Field(rule.index)
+		}
+
+		if err := validateRules(fieldValue, rule.rules); err != nil {
+			errs = append(errs, fmt.Errorf("%w for %s %v", ErrValidationFailed, name, DerefValue(fieldValue)))
+		}
+	}
+	return errs
+}
+
+// validateRules checks the value against the rules of a validate struct tag.
+func validateRules(value reflect.Value, rules string) error {
+	if rules == "" {
+		return nil
+	}
+	return fieldValidator.Var(value.Interface(), rules)
+}
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidationPlan(t *testing.T) {
+	type Settings struct {
+		Interval uint16  `validate:"gte=60,lte=86400"`
+		Retries  uint8   `validate:"gte=1,lte=5"`
+		Battery  *uint16 `validate:"gte=3000"`
+		Note     uint8
+	}
+
+	config := PayloadConfig{
+		Fields: []FieldConfig{
+			{Name: "Retries", Start: 0, Length: 1},
+			{Name: "Interval", Start: 1, Length: 2},
+			{Name: "Note", Start: 3, Length: 1},
+			{Name: "Battery", Start: 4, Length: 2, Optional: true},
+		},
+		TargetType: reflect.TypeOf(Settings{}),
+	}
+
+	tests := []struct {
+		payload  string
+		expected string
+	}{
+		{payload: "03012cff"},
+		{payload: "03012cff0bb8"},
+		{payload: "00012cff", expected: "validation failed for Retries 0"},
+		{payload: "06000aff", expected: "validation failed for Retries 6\nvalidation failed for Interval 10"},
+		{payload: "03012cff03e8", expected: "validation failed for Battery 1000"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.payload, func(t *testing.T) {
+			_, err := Decode(&test.payload, &config)
+			if test.expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrValidationFailed) {
+				t.Fatalf("expected validation error, got %v", err)
+			}
+			if err.Error() != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, err.Error())
+			}
+		})
+	}
+
+	if planValidation(config.TargetType) != planValidation(config.TargetType) {
+		t.Error("expected the validation plan to be cached")
+	}
+	if _, ok := planValidation(config.TargetType).fields["Note"]; ok {
+		t.Error("expected no rule for a field without validate tag")
+	}
+}
+
+// BenchmarkValidationPlan compares validating with the cached validation plan
+// to compiling the plan for every decode.
+func BenchmarkValidationPlan(b *testing.B) {
+	type Settings struct {
+		Interval uint16 `validate:"gte=60,lte=86400"`
+		Retries  uint8  `validate:"gte=1,lte=5"`
+		Note     uint8
+	}
+
+	value := reflect.ValueOf(Settings{Interval: 300, Retries: 3})
+	checks := []validationCheck{{field: "Retries"}, {field: "Interval"}, {field: "Note"}}
+
+	b.Run("Cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = planValidation(value.Type()).validate(value, checks)
+		}
+	})
+	b.Run("Uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			plan := &validationPlan{fields: compileRules(value.Type())}
+			_ = plan.validate(value, checks)
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package decoder
+
+import "reflect"
+
+// Catalog is implemented by decoders which can describe the payloads of the
+// ports they support without decoding one.
+type Catalog interface {
+	// Ports describes the payloads of the supported ports, ordered by port. A
+	// versioned port has an entry per version.
+	Ports() []PortInfo
+}
+
+// PortInfo describes the payload of a port, or of one version of it.
+type PortInfo struct {
+	Port uint8 `json:"port"`
+	// Version is the version byte of versioned ports, e.g. the first byte of the
+	// tag XL port 152 payloads.
+	Version *uint8 `json:"version,omitempty"`
+	// Payload is the name of the struct the payload is decoded into, e.g.
+	// tagsl.Port1Payload. It is empty for ports resolved by a solver.
+	Payload string `json:"payload,omitempty"`
+	// PayloadType is the type of the decoded payload, nil if unknown.
+	PayloadType reflect.Type `json:"-"`
+	// Solver is set for ports whose payload is passed to the solver.
+	Solver   bool        `json:"solver,omitempty"`
+	Features []Feature   `json:"features"`
+	Fields   []FieldInfo `json:"fields"`
+	Docs     string      `json:"docs,omitempty"`
+}
+
+// FieldInfo describes a field of a payload.
+type FieldInfo struct {
+	// Name is the name of the struct field. The fields of the records of repeated
+	// groups are named like AccessPoints.Mac.
+	Name string `json:"name"`
+	// Tag is the TLV tag of the field, e.g. "0x45", or empty for fixed position fields.
+	Tag string `json:"tag,omitempty"`
+	// Start and Length are the byte range of the field. The fields of repeated
+	// groups are positioned relative to the start of their record.
+	Start     int  `json:"start"`
+	Length    int  `json:"length"`
+	BitOffset int  `json:"bitOffset,omitempty"`
+	BitLength int  `json:"bitLength,omitempty"`
+	Optional  bool `json:"optional,omitempty"`
+	// Unit is the unit of the decoded value, e.g. "m". Scale and Offset turn the
+	// stored integer into the value, integer * Scale + Offset, if set.
+	Unit   string  `json:"unit,omitempty"`
+	Scale  float64 `json:"scale,omitempty"`
+	Offset float64 `json:"offset,omitempty"`
+}
@@ -1,15 +1,23 @@
 package decoder
 
+// DecoderContextKey is the type of the context keys the options of an uplink
+// were passed with before DecodeOptions.
 type DecoderContextKey string
 
 const (
 	// DEVEUI_CONTEXT_KEY is the context key used to store and retrieve the device EUI (Extended Unique Identifier)
 	// in the application context. This key is typically used when passing the devEUI value through context objects.
+	//
+	// Deprecated: pass DecodeOptions to an OptionsDecoder instead, see OptionsFromContext.
 	DEVEUI_CONTEXT_KEY DecoderContextKey = "devEui"
 
 	// FCNT_CONTEXT_KEY is the context key used to store and retrieve the frame count in the application context.
+	//
+	// Deprecated: pass DecodeOptions to an OptionsDecoder instead, see OptionsFromContext.
 	FCNT_CONTEXT_KEY DecoderContextKey = "fCount"
 
 	// PORT_CONTEXT_KEY is the context key used to store and retrieve the port number in the application context.
+	//
+	// Deprecated: pass DecodeOptions to an OptionsDecoder instead, see OptionsFromContext.
 	PORT_CONTEXT_KEY DecoderContextKey = "port"
 )
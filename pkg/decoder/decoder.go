@@ -6,7 +6,10 @@ import (
 )
 
 type Decoder interface {
+	// Decode decodes a hex encoded payload.
 	Decode(ctx context.Context, payload string, port uint8) (*DecodedUplink, error)
+	// DecodeBytes decodes a binary payload, see FromHex and FromBase64.
+	DecodeBytes(ctx context.Context, payload []byte, port uint8) (*DecodedUplink, error)
 }
 
 type Feature string
@@ -33,11 +36,15 @@ const (
 	FeatureRotationState   Feature = "rotationState"
 	FeatureSequenceNumber  Feature = "sequenceNumber"
 	FeatureDataRate        Feature = "dataRate"
+	FeatureIndoorPosition  Feature = "indoorPosition"
 )
 
 type DecodedUplink struct {
 	features []Feature
-	Data     any `json:"data"`
+	// featureData holds the data of features added with AddFeature.
+	featureData map[Feature]any
+	Data        any      `json:"data"`
+	Metadata    Metadata `json:"metadata"`
 }
 
 func NewDecodedUplink(features []Feature, data any) *DecodedUplink {
@@ -68,6 +75,19 @@ func (d DecodedUplink) GetFeatures() []Feature {
 	return d.features
 }
 
+// AddFeature adds the feature to the uplink with data implementing the
+// interface of the feature, e.g. a position a solver resolved from the decoded
+// data. As returns the data of the feature instead of the data of the uplink.
+func (d *DecodedUplink) AddFeature(feature Feature, data any) {
+	if !d.Is(feature) {
+		d.features = append(d.features, feature)
+	}
+	if d.featureData == nil {
+		d.featureData = map[Feature]any{}
+	}
+	d.featureData[feature] = data
+}
+
 type UplinkFeatureTimestamp interface {
 	GetTimestamp() *time.Time
 }
@@ -201,3 +221,18 @@ type UplinkFeatureRotationState interface {
 type UplinkFeatureSequenceNumber interface {
 	GetSequenceNumber() uint
 }
+
+// UplinkFeatureIndoorPosition is an indoor position resolved from BLE beacons.
+// Its latitude, longitude and accuracy are read like those of UplinkFeatureGNSS.
+type UplinkFeatureIndoorPosition interface {
+	// GetLatitude returns the latitude of the indoor position.
+	GetLatitude() float64
+	// GetLongitude returns the longitude of the indoor position.
+	GetLongitude() float64
+	// GetAccuracy returns the accuracy of the indoor position in meters.
+	GetAccuracy() *float64
+	// GetFloor returns the floor of the indoor position, nil if unknown.
+	GetFloor() *int
+	// GetZone returns the zone of the indoor position, empty if unknown.
+	GetZone() string
+}
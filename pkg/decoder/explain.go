@@ -0,0 +1,35 @@
+package decoder
+
+import "context"
+
+// Explainer is implemented by decoders that can show how a payload maps to its fields.
+type Explainer interface {
+	// Explain describes how each field of the payload is decoded on the port.
+	Explain(ctx context.Context, payload []byte, port uint8) ([]FieldExplanation, error)
+}
+
+// FieldExplanation describes how a single field is decoded from the payload.
+type FieldExplanation struct {
+	Name string `json:"name"`
+	// Tag is the TLV tag of the field, e.g. "0x45", or empty for fixed position fields.
+	Tag string `json:"tag,omitempty"`
+	// Start and Length are the byte range of the field in the payload.
+	Start     int `json:"start"`
+	Length    int `json:"length"`
+	BitOffset int `json:"bitOffset,omitempty"`
+	BitLength int `json:"bitLength,omitempty"`
+	// Hex holds the raw bytes of the field, Integer their unsigned value in the
+	// byte order of the field after applying the bit range. Integer is nil for
+	// fields longer than 8 bytes.
+	Hex     string  `json:"hex"`
+	Integer *uint64 `json:"integer,omitempty"`
+	// Value is the result of the transform or type conversion of the field, Unit
+	// its unit if the field declares one.
+	Value any    `json:"value"`
+	Unit  string `json:"unit,omitempty"`
+	// Missing is set for fields the payload is too short for.
+	Missing bool `json:"missing,omitempty"`
+	// Valid reports whether the value passed validation, Error holds the reason if not.
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
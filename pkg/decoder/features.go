@@ -0,0 +1,107 @@
+package decoder
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+var ErrFeatureMismatch = errors.New("features do not match the payload type")
+
+// featureInterfaces are the interfaces the data of an uplink implements for its
+// features. FeatureDataRate is part of UplinkFeatureConfig and has none.
+var featureInterfaces = map[Feature]reflect.Type{
+	FeatureTimestamp:       reflect.TypeFor[UplinkFeatureTimestamp](),
+	FeatureResetReason:     reflect.TypeFor[UplinkFeatureResetReason](),
+	FeatureGNSS:            reflect.TypeFor[UplinkFeatureGNSS](),
+	FeatureBuffered:        reflect.TypeFor[UplinkFeatureBuffered](),
+	FeatureBattery:         reflect.TypeFor[UplinkFeatureBattery](),
+	FeaturePhotovoltaic:    reflect.TypeFor[UplinkFeaturePhotovoltaic](),
+	FeatureTemperature:     reflect.TypeFor[UplinkFeatureTemperature](),
+	FeatureHumidity:        reflect.TypeFor[UplinkFeatureHumidity](),
+	FeaturePressure:        reflect.TypeFor[UplinkFeaturePressure](),
+	FeatureWiFi:            reflect.TypeFor[UplinkFeatureWiFi](),
+	FeatureBle:             reflect.TypeFor[UplinkFeatureBle](),
+	FeatureButton:          reflect.TypeFor[UplinkFeatureButton](),
+	FeatureConfig:          reflect.TypeFor[UplinkFeatureConfig](),
+	FeatureConfigChange:    reflect.TypeFor[UplinkFeatureConfigChange](),
+	FeatureMoving:          reflect.TypeFor[UplinkFeatureMoving](),
+	FeatureDutyCycle:       reflect.TypeFor[UplinkFeatureDutyCycle](),
+	FeatureFirmwareVersion: reflect.TypeFor[UplinkFeatureFirmwareVersion](),
+	FeatureHardwareVersion: reflect.TypeFor[UplinkFeatureHardwareVersion](),
+	FeatureRotationState:   reflect.TypeFor[UplinkFeatureRotationState](),
+	FeatureSequenceNumber:  reflect.TypeFor[UplinkFeatureSequenceNumber](),
+	FeatureIndoorPosition:  reflect.TypeFor[UplinkFeatureIndoorPosition](),
+}
+
+// FeatureInterface returns the interface the data of an uplink with the feature
+// implements, or nil if the feature has none.
+func FeatureInterface(feature Feature) reflect.Type {
+	return featureInterfaces[feature]
+}
+
+// As returns the data of the uplink as feature interface T, e.g.
+// UplinkFeatureGNSS, if the uplink has the feature of T and its data
+// implements T.
+//
+//	if gnss, ok := decoder.As[decoder.UplinkFeatureGNSS](uplink); ok {
+//		fmt.Println(gnss.GetLatitude(), gnss.GetLongitude())
+//	}
+func As[T any](d *DecodedUplink) (T, bool) {
+	var zero T
+	if d == nil {
+		return zero, false
+	}
+
+	t := reflect.TypeFor[T]()
+	for feature, featureInterface := range featureInterfaces {
+		if featureInterface == t {
+			return featureData[T](*d, feature)
+		}
+	}
+	return zero, false
+}
+
+// featureData returns the data of the uplink as T if the uplink has the feature,
+// or the data the feature was added with, see AddFeature.
+func featureData[T any](d DecodedUplink, feature Feature) (T, bool) {
+	if !d.Is(feature) {
+		var zero T
+		return zero, false
+	}
+	if data, ok := d.featureData[feature]; ok {
+		v, ok := data.(T)
+		return v, ok
+	}
+	v, ok := d.Data.(T)
+	return v, ok
+}
+
+// CheckFeatures reports the features which do not match the interfaces the
+// payload type implements: declared features whose interface is not implemented
+// and implemented interfaces whose feature is not declared.
+func CheckFeatures(features []Feature, payloadType reflect.Type) error {
+	errs := []error{}
+
+	for _, feature := range features {
+		featureInterface, ok := featureInterfaces[feature]
+		if ok && !payloadType.Implements(featureInterface) {
+			errs = append(errs, fmt.Errorf("%w: %v declares %s but does not implement %v", ErrFeatureMismatch, payloadType, feature, featureInterface))
+		}
+	}
+
+	// sorted for stable errors
+	implemented := []Feature{}
+	for feature, featureInterface := range featureInterfaces {
+		if payloadType.Implements(featureInterface) && !slices.Contains(features, feature) {
+			implemented = append(implemented, feature)
+		}
+	}
+	slices.Sort(implemented)
+	for _, feature := range implemented {
+		errs = append(errs, fmt.Errorf("%w: %v implements %v but does not declare %s", ErrFeatureMismatch, payloadType, featureInterfaces[feature], feature))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,119 @@
+package decoder
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAs(t *testing.T) {
+	uplink := NewDecodedUplink([]Feature{FeatureGNSS, FeaturePressure}, telemetryPosition{})
+
+	gnss, ok := As[UplinkFeatureGNSS](uplink)
+	if !ok {
+		t.Fatal("expected GNSS feature")
+	}
+	if gnss.GetLatitude() != 47.1 {
+		t.Errorf("expected %v, got %v", 47.1, gnss.GetLatitude())
+	}
+
+	// implemented but not declared
+	if _, ok := As[UplinkFeatureBattery](uplink); ok {
+		t.Error("expected no battery feature")
+	}
+
+	// declared but not implemented
+	if _, ok := As[UplinkFeaturePressure](uplink); ok {
+		t.Error("expected no pressure feature")
+	}
+
+	// no feature interface
+	if _, ok := As[error](uplink); ok {
+		t.Error("expected no feature for error")
+	}
+
+	if _, ok := As[UplinkFeatureGNSS](nil); ok {
+		t.Error("expected no feature for nil uplink")
+	}
+}
+
+func TestAddFeature(t *testing.T) {
+	uplink := NewDecodedUplink([]Feature{FeatureGNSS}, telemetryPosition{})
+	floor := 2
+	position := &IndoorPosition{Latitude: 47, Longitude: 8, Accuracy: 10, Floor: &floor, Zone: "lab", Beacons: 1}
+
+	if _, ok := As[UplinkFeatureIndoorPosition](uplink); ok {
+		t.Fatal("expected no indoor position before it is added")
+	}
+
+	uplink.AddFeature(FeatureIndoorPosition, position)
+	uplink.AddFeature(FeatureIndoorPosition, position)
+	if !reflect.DeepEqual(uplink.GetFeatures(), []Feature{FeatureGNSS, FeatureIndoorPosition}) {
+		t.Errorf("unexpected features %v", uplink.GetFeatures())
+	}
+
+	indoor, ok := As[UplinkFeatureIndoorPosition](uplink)
+	if !ok || indoor.GetZone() != "lab" || *indoor.GetFloor() != 2 {
+		t.Errorf("expected the added indoor position, got %v %v", indoor, ok)
+	}
+
+	// the data of the uplink is kept for its own features
+	if gnss, ok := As[UplinkFeatureGNSS](uplink); !ok || gnss.GetLatitude() != 47.1 {
+		t.Errorf("expected the GNSS position of the data, got %v %v", gnss, ok)
+	}
+
+	if uplink.Telemetry().Indoor == nil {
+		t.Error("expected the indoor position in the telemetry")
+	}
+}
+
+func TestCheckFeatures(t *testing.T) {
+	tests := []struct {
+		name     string
+		features []Feature
+		expected []string
+	}{
+		{
+			name:     "Match",
+			features: []Feature{FeatureTemperature, FeatureHumidity, FeaturePhotovoltaic, FeatureResetReason, FeatureDataRate},
+		},
+		{
+			name:     "NotImplemented",
+			features: []Feature{FeatureTemperature, FeatureHumidity, FeaturePhotovoltaic, FeatureResetReason, FeatureGNSS},
+			expected: []string{"features do not match the payload type: decoder.telemetryEnvironment declares gnss but does not implement decoder.UplinkFeatureGNSS"},
+		},
+		{
+			name:     "NotDeclared",
+			features: []Feature{FeatureTemperature},
+			expected: []string{
+				"features do not match the payload type: decoder.telemetryEnvironment implements decoder.UplinkFeatureHumidity but does not declare humidity",
+				"features do not match the payload type: decoder.telemetryEnvironment implements decoder.UplinkFeaturePhotovoltaic but does not declare photovoltaic",
+				"features do not match the payload type: decoder.telemetryEnvironment implements decoder.UplinkFeatureResetReason but does not declare resetReason",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckFeatures(test.features, reflect.TypeOf(telemetryEnvironment{}))
+			if len(test.expected) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, ErrFeatureMismatch) {
+				t.Fatalf("expected %v, got %v", ErrFeatureMismatch, err)
+			}
+			errs := err.(interface{ Unwrap() []error }).Unwrap()
+			messages := []string{}
+			for _, e := range errs {
+				messages = append(messages, e.Error())
+			}
+			if !reflect.DeepEqual(messages, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, messages)
+			}
+		})
+	}
+}
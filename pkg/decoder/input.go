@@ -0,0 +1,20 @@
+package decoder
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// FromHex converts a hex encoded payload, e.g. "8002cdcd13", into bytes for DecodeBytes.
+func FromHex(payload string) ([]byte, error) {
+	return hex.DecodeString(payload)
+}
+
+// FromBase64 converts a base64 encoded payload, as forwarded by most LoRaWAN network servers,
+// into bytes for DecodeBytes. The padding is optional.
+func FromBase64(payload string) ([]byte, error) {
+	if len(payload)%4 != 0 {
+		return base64.RawStdEncoding.DecodeString(payload)
+	}
+	return base64.StdEncoding.DecodeString(payload)
+}
@@ -0,0 +1,45 @@
+package decoder
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFromHex(t *testing.T) {
+	got, err := FromHex("8002cdCD13")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, []byte{0x80, 0x02, 0xcd, 0xcd, 0x13}) {
+		t.Errorf("unexpected bytes %x", got)
+	}
+
+	_, err = FromHex("xx")
+	if err == nil || err.Error() != "encoding/hex: invalid byte: U+0078 'x'" {
+		t.Errorf("expected invalid hex byte, got %v", err)
+	}
+}
+
+func TestFromBase64(t *testing.T) {
+	tests := []struct {
+		payload  string
+		expected []byte
+		err      bool
+	}{
+		{payload: "gALNzRM=", expected: []byte{0x80, 0x02, 0xcd, 0xcd, 0x13}},
+		{payload: "gALNzRM", expected: []byte{0x80, 0x02, 0xcd, 0xcd, 0x13}},
+		{payload: "AQ==", expected: []byte{0x01}},
+		{payload: "", expected: []byte{}},
+		{payload: "gALN*RM=", err: true},
+	}
+
+	for _, test := range tests {
+		got, err := FromBase64(test.payload)
+		if (err != nil) != test.err {
+			t.Errorf("FromBase64(%q) unexpected error %v", test.payload, err)
+		}
+		if !test.err && !bytes.Equal(got, test.expected) {
+			t.Errorf("FromBase64(%q) = %x, expected %x", test.payload, got, test.expected)
+		}
+	}
+}
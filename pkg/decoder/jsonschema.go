@@ -0,0 +1,159 @@
+package decoder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// JSONSchemaDialect is the JSON Schema version of the schemas of JSONSchema,
+// which is also the schema dialect of OpenAPI 3.1.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchemaUnit is the keyword the unit of a property is stated with, e.g.
+// "x-unit": "V". OpenAPI accepts it as specification extension.
+const JSONSchemaUnit = "x-unit"
+
+// JSONSchema returns the JSON Schema of the payloads of a device, see Lookup.
+// The payload of every port is a definition in $defs named like port1, or
+// port152v2 for versioned ports, as written by the machine output profile.
+// Properties of fields with a unit state it with JSONSchemaUnit.
+func (r *Registry) JSONSchema(device string) (map[string]any, error) {
+	found, err := r.Lookup(device)
+	if err != nil {
+		return nil, err
+	}
+	ports, err := r.Ports(device)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := map[string]any{}
+	for _, port := range ports {
+		schema := port.JSONSchema()
+		if schema == nil {
+			continue
+		}
+
+		name := fmt.Sprintf("port%d", port.Port)
+		if port.Version != nil {
+			name += fmt.Sprintf("v%d", *port.Version)
+		}
+		definitions[name] = schema
+	}
+
+	return map[string]any{
+		"$schema":     JSONSchemaDialect,
+		"title":       found.Path(),
+		"description": found.Description,
+		"$defs":       definitions,
+	}, nil
+}
+
+// JSONSchema returns the JSON Schema of the decoded payload as written by the
+// machine output profile, nil if the payload type is unknown.
+func (p PortInfo) JSONSchema() map[string]any {
+	if p.PayloadType == nil {
+		return nil
+	}
+
+	units := map[string]string{}
+	for _, field := range p.Fields {
+		if field.Unit != "" {
+			units[field.Name] = field.Unit
+		}
+	}
+
+	schema := typeSchema(p.PayloadType, units, "")
+	if p.Payload != "" {
+		schema["title"] = p.Payload
+	}
+	if p.Docs != "" {
+		schema["description"] = p.Docs
+	}
+	return schema
+}
+
+// typeSchema returns the schema of the type. The units are keyed by the names
+// of the struct fields like the FieldInfo of a PortInfo, prefixed with the
+// name of the group for the fields of repeated groups.
+func typeSchema(t reflect.Type, units map[string]string, prefix string) map[string]any {
+	switch t {
+	case durationType:
+		return map[string]any{"type": "number", JSONSchemaUnit: "s"}
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := typeSchema(t.Elem(), units, prefix)
+		if kind, ok := schema["type"].(string); ok {
+			schema["type"] = []string{kind, "null"}
+		}
+		return schema
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// byte slices are written in base64
+			return map[string]any{"type": "string", "contentEncoding": "base64"}
+		}
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem(), units, prefix)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(t.Elem(), units, prefix)}
+	case reflect.Struct:
+		properties := map[string]any{}
+		required := []string{}
+		structSchema(t, units, prefix, properties, &required)
+
+		schema := map[string]any{"type": "object", "properties": properties}
+		if len(required) != 0 {
+			schema["required"] = required
+		}
+		return schema
+	default:
+		// interfaces may hold any value
+		return map[string]any{}
+	}
+}
+
+// structSchema adds the fields of the struct to the properties with their JSON
+// names like marshalFields. The fields marshalFields always writes, those
+// without omitempty and structs, are required.
+func structSchema(t reflect.Type, units map[string]string, prefix string, properties map[string]any, required *[]string) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			structSchema(field.Type, units, prefix, properties, required)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema := typeSchema(field.Type, units, prefix+field.Name+".")
+		if unit, ok := units[prefix+field.Name]; ok {
+			schema[JSONSchemaUnit] = unit
+		}
+		properties[name] = schema
+		if !strings.Contains(options, "omitempty") || field.Type.Kind() == reflect.Struct {
+			*required = append(*required, name)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package decoder
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type schemaRecord struct {
+	Mac  string `json:"mac"`
+	Rssi *int8  `json:"rssi,omitempty"`
+}
+
+type schemaPayload struct {
+	Moving       bool           `json:"moving"`
+	Battery      float32        `json:"battery"`
+	Temperature  *float64       `json:"temperature"`
+	Interval     uint16         `json:"interval"`
+	TTF          time.Duration  `json:"ttf"`
+	Timestamp    time.Time      `json:"timestamp"`
+	DataRate     DataRate       `json:"dataRate"`
+	AccessPoints []schemaRecord `json:"accessPoints"`
+	internal     int
+}
+
+type testCatalogDecoder struct {
+	testDecoder
+}
+
+func (testCatalogDecoder) Ports() []PortInfo {
+	version := uint8(2)
+	return []PortInfo{
+		{Port: 1, PayloadType: reflect.TypeOf(schemaPayload{}), Payload: "decoder.schemaPayload", Fields: []FieldInfo{
+			{Name: "Battery", Unit: "V", Scale: 0.001},
+			{Name: "Temperature", Unit: "°C"},
+			{Name: "AccessPoints.Rssi", Unit: "dBm"},
+		}},
+		{Port: 152, Version: &version, PayloadType: reflect.TypeOf(schemaRecord{})},
+		{Port: 192, Solver: true},
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	registry := NewRegistry()
+	device := testDevice("schema", "v1")
+	device.Description = "schema device"
+	device.New = func(options DeviceOptions) (Decoder, error) {
+		return testCatalogDecoder{}, nil
+	}
+	if err := registry.Register(device); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	schema, err := registry.JSONSchema("schema")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var expected any
+	_ = json.Unmarshal([]byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "schema/v1",
+		"description": "schema device",
+		"$defs": {
+			"port1": {
+				"title": "decoder.schemaPayload",
+				"type": "object",
+				"properties": {
+					"moving": {"type": "boolean"},
+					"battery": {"type": "number", "x-unit": "V"},
+					"temperature": {"type": ["number", "null"], "x-unit": "°C"},
+					"interval": {"type": "integer", "minimum": 0},
+					"ttf": {"type": "number", "x-unit": "s"},
+					"timestamp": {"type": "string", "format": "date-time"},
+					"dataRate": {"type": "string"},
+					"accessPoints": {"type": "array", "items": {
+						"type": "object",
+						"properties": {
+							"mac": {"type": "string"},
+							"rssi": {"type": ["integer", "null"], "x-unit": "dBm"}
+						},
+						"required": ["mac"]
+					}}
+				},
+				"required": ["moving", "battery", "temperature", "interval", "ttf", "timestamp", "dataRate", "accessPoints"]
+			},
+			"port152v2": {
+				"type": "object",
+				"properties": {
+					"mac": {"type": "string"},
+					"rssi": {"type": ["integer", "null"]}
+				},
+				"required": ["mac"]
+			}
+		}
+	}`), &expected)
+
+	var received any
+	_ = json.Unmarshal(data, &received)
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("expected %v, got %s", expected, data)
+	}
+
+	_, err = registry.JSONSchema("unknown")
+	if err == nil {
+		t.Error("expected error for unknown device")
+	}
+}
@@ -0,0 +1,111 @@
+package decoder
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// SolverSource is the solver which resolved the position of an uplink.
+type SolverSource string
+
+const (
+	SolverPrimary  SolverSource = "primary"
+	SolverFallback SolverSource = "fallback"
+)
+
+// Metadata describes the uplink a DecodedUplink was decoded from.
+type Metadata struct {
+	Port uint8 `json:"port"`
+	// DeviceType and DecoderVersion are the name and version of the decoder,
+	// e.g. tagsl and v1.
+	DeviceType     string `json:"deviceType,omitempty"`
+	DecoderVersion string `json:"decoderVersion,omitempty"`
+	// Payload is the hex encoded raw payload.
+	Payload      string     `json:"payload"`
+	DevEUI       string     `json:"devEui,omitempty"`
+	FrameCounter *uint32    `json:"frameCounter,omitempty"`
+	ReceivedAt   *time.Time `json:"receivedAt,omitempty"`
+
+	Gateways []GatewayMetadata `json:"gateways,omitempty"`
+	// Solver is set for uplinks whose position was resolved by a solver.
+	Solver SolverSource `json:"solver,omitempty"`
+	// WifiPosition is the position a WiFi solver resolved from the access
+	// points of the uplink.
+	WifiPosition *WifiPosition `json:"wifiPosition,omitempty"`
+	// IndoorPosition is the position an indoor solver resolved from the BLE
+	// beacons of the uplink.
+	IndoorPosition *IndoorPosition `json:"indoorPosition,omitempty"`
+}
+
+// WifiPosition is a position resolved from the WiFi access points of an uplink.
+type WifiPosition struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Accuracy is the estimated radius of the position in meters.
+	Accuracy float64 `json:"accuracy"`
+	// AccessPoints is the number of access points the position was resolved from.
+	AccessPoints int `json:"accessPoints"`
+}
+
+// IndoorPosition is a position resolved from the BLE beacons of an uplink.
+type IndoorPosition struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Accuracy is the estimated radius of the position in meters.
+	Accuracy float64 `json:"accuracy"`
+	// Floor is the floor of the position, nil if unknown.
+	Floor *int `json:"floor,omitempty"`
+	// Zone is the zone of the position, e.g. a room, empty if unknown.
+	Zone string `json:"zone,omitempty"`
+	// Beacons is the number of beacons the position was resolved from.
+	Beacons int `json:"beacons"`
+}
+
+var _ UplinkFeatureIndoorPosition = &IndoorPosition{}
+
+func (p IndoorPosition) GetLatitude() float64 {
+	return p.Latitude
+}
+
+func (p IndoorPosition) GetLongitude() float64 {
+	return p.Longitude
+}
+
+func (p IndoorPosition) GetAccuracy() *float64 {
+	return &p.Accuracy
+}
+
+func (p IndoorPosition) GetFloor() *int {
+	return p.Floor
+}
+
+func (p IndoorPosition) GetZone() string {
+	return p.Zone
+}
+
+// SetMetadata fills the metadata of the uplink with the decoder path like
+// tagsl/v1, the raw payload and the options of the uplink. The solver and the
+// WiFi and indoor positions are kept.
+// SetMetadata does nothing for a nil uplink.
+func (d *DecodedUplink) SetMetadata(path string, payload []byte, options DecodeOptions) {
+	if d == nil {
+		return
+	}
+
+	deviceType, decoderVersion, _ := strings.Cut(path, "/")
+
+	d.Metadata = Metadata{
+		Port:           options.Port,
+		DeviceType:     deviceType,
+		DecoderVersion: decoderVersion,
+		Payload:        hex.EncodeToString(payload),
+		DevEUI:         options.DevEUI,
+		FrameCounter:   options.FCnt,
+		ReceivedAt:     options.ReceivedAt,
+		Gateways:       options.Gateways,
+		Solver:         d.Metadata.Solver,
+		WifiPosition:   d.Metadata.WifiPosition,
+		IndoorPosition: d.Metadata.IndoorPosition,
+	}
+}
@@ -0,0 +1,49 @@
+package decoder
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSetMetadata(t *testing.T) {
+	receivedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	frameCounter := uint32(42)
+	gateways := []GatewayMetadata{{GatewayID: "0016c001ff1a2b3c", RSSI: -110, SNR: 7.5}}
+
+	uplink := NewDecodedUplink(nil, nil)
+	uplink.Metadata.Solver = SolverFallback
+	uplink.SetMetadata("tagxl/v1", []byte{0xde, 0xad}, DecodeOptions{
+		DevEUI:     "0011223344556677",
+		FCnt:       &frameCounter,
+		Port:       192,
+		ReceivedAt: &receivedAt,
+		Gateways:   gateways,
+	})
+
+	expected := Metadata{
+		Port:           192,
+		DeviceType:     "tagxl",
+		DecoderVersion: "v1",
+		Payload:        "dead",
+		DevEUI:         "0011223344556677",
+		FrameCounter:   &frameCounter,
+		ReceivedAt:     &receivedAt,
+		Gateways:       gateways,
+		Solver:         SolverFallback,
+	}
+	if !reflect.DeepEqual(uplink.Metadata, expected) {
+		t.Errorf("expected %+v, got %+v", expected, uplink.Metadata)
+	}
+
+	uplink = NewDecodedUplink(nil, nil)
+	uplink.SetMetadata("tagsl/v1", []byte{0x01}, DecodeOptions{Port: 1})
+	expected = Metadata{Port: 1, DeviceType: "tagsl", DecoderVersion: "v1", Payload: "01"}
+	if !reflect.DeepEqual(uplink.Metadata, expected) {
+		t.Errorf("expected %+v, got %+v", expected, uplink.Metadata)
+	}
+
+	// does not panic
+	var empty *DecodedUplink
+	empty.SetMetadata("tagsl/v1", nil, DecodeOptions{})
+}
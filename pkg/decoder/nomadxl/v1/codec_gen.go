@@ -0,0 +1,195 @@
+// Code generated by decodergen. DO NOT EDIT.
+
+package nomadxl
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/truvami/decoder/pkg/common"
+)
+
+func init() {
+	common.RegisterGenerated(reflect.TypeOf(Port101Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "SystemTime", Start: 0, Length: 8},
+			{Name: "UTCDate", Start: 8, Length: 4},
+			{Name: "UTCTime", Start: 12, Length: 4},
+			{Name: "BufferLevelSTA", Start: 16, Length: 2},
+			{Name: "BufferLevelGPS", Start: 18, Length: 2},
+			{Name: "BufferLevelACC", Start: 20, Length: 2},
+			{Name: "BufferLevelLOG", Start: 22, Length: 2},
+			{Name: "Temperature", Start: 24, Length: 2, Scale: 0.1},
+			{Name: "Pressure", Start: 26, Length: 2, Scale: 0.1},
+			{Name: "AccelerometerXAxis", Start: 28, Length: 2},
+			{Name: "AccelerometerYAxis", Start: 30, Length: 2},
+			{Name: "AccelerometerZAxis", Start: 32, Length: 2},
+			{Name: "Battery", Start: 34, Length: 2, Scale: 0.001},
+			{Name: "BatteryLorawan", Start: 36, Length: 1},
+			{Name: "TimeToFix", Start: 37, Length: 1, Scale: 1},
+		},
+		Decode: decodePort101Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port103Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "UTCDate", Start: 0, Length: 4},
+			{Name: "UTCTime", Start: 4, Length: 4},
+			{Name: "Latitude", Start: 8, Length: 4, Signedness: common.Signed, Scale: 1e-05},
+			{Name: "Longitude", Start: 12, Length: 4, Signedness: common.Signed, Scale: 1e-05},
+			{Name: "Altitude", Start: 16, Length: 4, Scale: 0.01},
+		},
+		Decode: decodePort103Payload,
+		Encode: encodePort103Payload,
+	})
+}
+
+func decodePort101Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port101Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// SystemTime
+	if raw, ok := reader.Read("SystemTime", 0, 8, false); ok {
+		p.SystemTime = common.BytesToInt64(raw)
+	}
+
+	// UTCDate
+	if raw, ok := reader.Read("UTCDate", 8, 4, false); ok {
+		p.UTCDate = common.BytesToUint32(raw)
+	}
+
+	// UTCTime
+	if raw, ok := reader.Read("UTCTime", 12, 4, false); ok {
+		p.UTCTime = common.BytesToUint32(raw)
+	}
+
+	// BufferLevelSTA
+	reader.Read("BufferLevelSTA", 16, 2, false)
+
+	// BufferLevelGPS
+	reader.Read("BufferLevelGPS", 18, 2, false)
+
+	// BufferLevelACC
+	reader.Read("BufferLevelACC", 20, 2, false)
+
+	// BufferLevelLOG
+	reader.Read("BufferLevelLOG", 22, 2, false)
+
+	// Temperature
+	if raw, ok := reader.Read("Temperature", 24, 2, false); ok {
+		p.Temperature = float32(common.ScaledValue(raw, false, config.Fields[7].Scale, config.Fields[7].Offset))
+		if err := common.ValidateField("Temperature", p.Temperature, "gte=-20,lte=60"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Pressure
+	if raw, ok := reader.Read("Pressure", 26, 2, false); ok {
+		p.Pressure = float32(common.ScaledValue(raw, false, config.Fields[8].Scale, config.Fields[8].Offset))
+		if err := common.ValidateField("Pressure", p.Pressure, "gte=0,lte=1100"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// AccelerometerXAxis
+	if raw, ok := reader.Read("AccelerometerXAxis", 28, 2, false); ok {
+		p.AccelerometerXAxis = common.BytesToInt16(raw)
+	}
+
+	// AccelerometerYAxis
+	if raw, ok := reader.Read("AccelerometerYAxis", 30, 2, false); ok {
+		p.AccelerometerYAxis = common.BytesToInt16(raw)
+	}
+
+	// AccelerometerZAxis
+	if raw, ok := reader.Read("AccelerometerZAxis", 32, 2, false); ok {
+		p.AccelerometerZAxis = common.BytesToInt16(raw)
+	}
+
+	// Battery
+	if raw, ok := reader.Read("Battery", 34, 2, false); ok {
+		p.Battery = float64(common.ScaledValue(raw, false, config.Fields[12].Scale, config.Fields[12].Offset))
+		if err := common.ValidateField("Battery", p.Battery, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// BatteryLorawan
+	if raw, ok := reader.Read("BatteryLorawan", 36, 1, false); ok {
+		p.BatteryLorawan = common.BytesToUint8(raw)
+	}
+
+	// TimeToFix
+	if raw, ok := reader.Read("TimeToFix", 37, 1, false); ok {
+		p.TimeToFix = time.Duration(common.ScaledValue(raw, false, config.Fields[14].Scale, config.Fields[14].Offset) * float64(time.Second))
+	}
+
+	return reader.Result(p, errs)
+}
+
+func decodePort103Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port103Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// UTCDate
+	if raw, ok := reader.Read("UTCDate", 0, 4, false); ok {
+		p.UTCDate = common.BytesToUint32(raw)
+	}
+
+	// UTCTime
+	if raw, ok := reader.Read("UTCTime", 4, 4, false); ok {
+		p.UTCTime = common.BytesToUint32(raw)
+	}
+
+	// Latitude
+	if raw, ok := reader.Read("Latitude", 8, 4, false); ok {
+		p.Latitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[2].Scale, config.Fields[2].Offset))
+	}
+
+	// Longitude
+	if raw, ok := reader.Read("Longitude", 12, 4, false); ok {
+		p.Longitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[3].Scale, config.Fields[3].Offset))
+	}
+
+	// Altitude
+	if raw, ok := reader.Read("Altitude", 16, 4, false); ok {
+		p.Altitude = float64(common.ScaledValue(raw, false, config.Fields[4].Scale, config.Fields[4].Offset))
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort103Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port103Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(20)
+	var bytes []byte
+
+	// UTCDate
+	bytes = common.UintToBytes(uint64(p.UTCDate), 4)
+	writer.Write(0, 4, bytes)
+
+	// UTCTime
+	bytes = common.UintToBytes(uint64(p.UTCTime), 4)
+	writer.Write(4, 4, bytes)
+
+	// Latitude
+	bytes = common.UnscaledBytes(float64(p.Latitude), config.Fields[2].Scale, config.Fields[2].Offset, 4)
+	writer.Write(8, 4, bytes)
+
+	// Longitude
+	bytes = common.UnscaledBytes(float64(p.Longitude), config.Fields[3].Scale, config.Fields[3].Offset, 4)
+	writer.Write(12, 4, bytes)
+
+	// Altitude
+	bytes = common.UnscaledBytes(float64(p.Altitude), config.Fields[4].Scale, config.Fields[4].Offset, 4)
+	writer.Write(16, 4, bytes)
+
+	return writer.String(), nil
+}
@@ -4,16 +4,18 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"time"
 
 	"github.com/truvami/decoder/pkg/common"
 	"github.com/truvami/decoder/pkg/decoder"
 )
 
+//go:generate go run github.com/truvami/decoder/internal/decodergen
+
 type Option func(*NomadXLv1Decoder)
 
 type NomadXLv1Decoder struct {
 	skipValidation bool
+	lenient        bool
 }
 
 func NewNomadXLv1Decoder(options ...Option) decoder.Decoder {
@@ -32,6 +34,13 @@ func WithSkipValidation(skipValidation bool) Option {
 	}
 }
 
+// WithLenient decodes truncated payloads as far as possible, see common.PayloadConfig.Lenient.
+func WithLenient(lenient bool) Option {
+	return func(t *NomadXLv1Decoder) {
+		t.lenient = lenient
+	}
+}
+
 // https://docs.truvami.com/docs/payloads/nomad-XL
 func (t NomadXLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	switch port {
@@ -45,14 +54,14 @@ func (t NomadXLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 				{Name: "BufferLevelGPS", Start: 18, Length: 2},
 				{Name: "BufferLevelACC", Start: 20, Length: 2},
 				{Name: "BufferLevelLOG", Start: 22, Length: 2},
-				{Name: "Temperature", Start: 24, Length: 2, Transform: temperature},
-				{Name: "Pressure", Start: 26, Length: 2, Transform: pressure},
+				{Name: "Temperature", Start: 24, Length: 2, Scale: 0.1, Unit: "°C"},
+				{Name: "Pressure", Start: 26, Length: 2, Scale: 0.1, Unit: "hPa"},
 				{Name: "AccelerometerXAxis", Start: 28, Length: 2},
 				{Name: "AccelerometerYAxis", Start: 30, Length: 2},
 				{Name: "AccelerometerZAxis", Start: 32, Length: 2},
-				{Name: "Battery", Start: 34, Length: 2, Transform: battery},
+				{Name: "Battery", Start: 34, Length: 2, Scale: 0.001, Unit: "V"},
 				{Name: "BatteryLorawan", Start: 36, Length: 1},
-				{Name: "TimeToFix", Start: 37, Length: 1, Transform: ttf},
+				{Name: "TimeToFix", Start: 37, Length: 1, Scale: 1, Unit: "s"},
 			},
 			TargetType: reflect.TypeOf(Port101Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureBuffered, decoder.FeatureBattery, decoder.FeatureTemperature, decoder.FeaturePressure},
@@ -62,9 +71,9 @@ func (t NomadXLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 			Fields: []common.FieldConfig{
 				{Name: "UTCDate", Start: 0, Length: 4},
 				{Name: "UTCTime", Start: 4, Length: 4},
-				{Name: "Latitude", Start: 8, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 12, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 16, Length: 4, Transform: altitude},
+				{Name: "Latitude", Start: 8, Length: 4, Signedness: common.Signed, Scale: 0.00001, Unit: "°"},
+				{Name: "Longitude", Start: 12, Length: 4, Signedness: common.Signed, Scale: 0.00001, Unit: "°"},
+				{Name: "Altitude", Start: 16, Length: 4, Scale: 0.01, Unit: "m"},
 			},
 			TargetType: reflect.TypeOf(Port103Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureGNSS},
@@ -74,47 +83,72 @@ func (t NomadXLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	return common.PayloadConfig{}, fmt.Errorf("%w: port %v not supported", common.ErrPortNotSupported, port)
 }
 
-func (t NomadXLv1Decoder) Decode(ctx context.Context, data string, port uint8) (*decoder.DecodedUplink, error) {
-	config, err := t.getConfig(port)
-	if err != nil {
-		return nil, err
-	}
+var _ decoder.Catalog = &NomadXLv1Decoder{}
 
-	if !t.skipValidation {
-		err := common.ValidateLength(&data, &config)
+// Ports describes the payloads of the supported ports.
+func (t NomadXLv1Decoder) Ports() []decoder.PortInfo {
+	infos := []decoder.PortInfo{}
+	for _, port := range supportedPorts {
+		config, err := t.getConfig(port)
 		if err != nil {
-			return nil, err
+			continue
 		}
+		infos = append(infos, common.DescribePort(port, nil, config, docs))
 	}
-
-	decodedData, err := common.Decode(&data, &config)
-	return decoder.NewDecodedUplink(config.Features, decodedData), err
+	return infos
 }
 
-func temperature(v any) any {
-	return float32(common.BytesToUint16(v.([]byte))) / 10
-}
+var _ decoder.Explainer = &NomadXLv1Decoder{}
 
-func pressure(v any) any {
-	return float32(common.BytesToUint16(v.([]byte))) / 10
+// Explain describes how each field of the payload is decoded on the port.
+func (t NomadXLv1Decoder) Explain(ctx context.Context, payload []byte, port uint8) ([]decoder.FieldExplanation, error) {
+	config, err := t.getConfig(port)
+	if err != nil {
+		return nil, err
+	}
+	return common.Explain(payload, &config)
 }
 
-func battery(v any) any {
-	return float64(common.BytesToUint16(v.([]byte))) / 1000
+func (t NomadXLv1Decoder) Decode(ctx context.Context, data string, port uint8) (*decoder.DecodedUplink, error) {
+	payload, err := decoder.FromHex(data)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "nomadxl/v1", port, nil)
+	}
+	return t.DecodeBytes(ctx, payload, port)
 }
 
-func ttf(v any) any {
-	return time.Duration(int64(common.BytesToUint8(v.([]byte)))) * time.Second
+func (t NomadXLv1Decoder) DecodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	options, err := decoder.OptionsFromContext(ctx, port)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "nomadxl/v1", port, nil)
+	}
+	return t.DecodeWithOptions(ctx, payload, options)
 }
 
-func latitude(v any) any {
-	return float64(common.BytesToInt32(v.([]byte))) / 100000
-}
+var _ decoder.OptionsDecoder = &NomadXLv1Decoder{}
 
-func longitude(v any) any {
-	return float64(common.BytesToInt32(v.([]byte))) / 100000
+// DecodeWithOptions decodes the binary payload on options.Port with the options of the uplink.
+func (t NomadXLv1Decoder) DecodeWithOptions(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	uplink, err := t.decodeBytes(ctx, payload, options.Port)
+	uplink.SetMetadata("nomadxl/v1", payload, options)
+	return uplink, common.WrapDecodeError(err, "nomadxl/v1", options.Port, payload)
 }
 
-func altitude(v any) any {
-	return float64(common.BytesToUint16(v.([]byte))) / 100
+func (t NomadXLv1Decoder) decodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	config, err := t.getConfig(port)
+	if err != nil {
+		return nil, err
+	}
+
+	config.Lenient = t.lenient
+
+	if !t.skipValidation {
+		err := common.ValidateBytesLength(payload, &config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	decodedData, err := common.DecodeBytes(payload, &config)
+	return decoder.NewDecodedUplink(config.Features, decodedData), err
 }
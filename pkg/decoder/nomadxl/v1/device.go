@@ -0,0 +1,27 @@
+package nomadxl
+
+import "github.com/truvami/decoder/pkg/decoder"
+
+const docs = "https://docs.truvami.com/docs/payloads/nomad-XL"
+
+// supportedPorts are the uplink ports the decoder supports.
+var supportedPorts = []uint8{101, 103}
+
+func init() {
+	decoder.Register(decoder.Device{
+		Name:        "nomadxl",
+		Version:     "v1",
+		Description: "nomad XL",
+		Ports:       supportedPorts,
+		Features: []decoder.Feature{
+			decoder.FeatureGNSS,
+			decoder.FeatureBuffered,
+			decoder.FeatureBattery,
+			decoder.FeatureTemperature,
+			decoder.FeaturePressure,
+		},
+		New: func(options decoder.DeviceOptions) (decoder.Decoder, error) {
+			return NewNomadXLv1Decoder(WithSkipValidation(options.SkipValidation), WithLenient(options.Lenient)), nil
+		},
+	})
+}
@@ -0,0 +1,649 @@
+// Code generated by decodergen. DO NOT EDIT.
+
+package nomadxs
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/truvami/decoder/pkg/common"
+)
+
+func init() {
+	common.RegisterGenerated(reflect.TypeOf(Port1Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1},
+			{Name: "Year", Start: 11, Length: 1},
+			{Name: "Month", Start: 12, Length: 1},
+			{Name: "Day", Start: 13, Length: 1},
+			{Name: "Hour", Start: 14, Length: 1},
+			{Name: "Minute", Start: 15, Length: 1},
+			{Name: "Second", Start: 16, Length: 1},
+			{Name: "TimeToFix", Start: 17, Length: 1, Scale: 1},
+			{Name: "AmbientLight", Start: 18, Length: 2},
+			{Name: "AccelerometerXAxis", Start: 20, Length: 2},
+			{Name: "AccelerometerYAxis", Start: 22, Length: 2},
+			{Name: "AccelerometerZAxis", Start: 24, Length: 2},
+			{Name: "Temperature", Start: 26, Length: 2, Optional: true, Scale: 0.01},
+			{Name: "Pressure", Start: 28, Length: 2, Optional: true, Scale: 0.1},
+			{Name: "GyroscopeXAxis", Start: 30, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.1},
+			{Name: "GyroscopeYAxis", Start: 32, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.1},
+			{Name: "GyroscopeZAxis", Start: 34, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.1},
+			{Name: "MagnetometerXAxis", Start: 36, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.001},
+			{Name: "MagnetometerYAxis", Start: 38, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.001},
+			{Name: "MagnetometerZAxis", Start: 40, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.001},
+		},
+		Decode: decodePort1Payload,
+		Encode: encodePort1Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port4Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "LocalizationIntervalWhileMoving", Start: 0, Length: 4},
+			{Name: "LocalizationIntervalWhileSteady", Start: 4, Length: 4},
+			{Name: "HeartbeatInterval", Start: 8, Length: 4},
+			{Name: "GPSTimeoutWhileWaitingForFix", Start: 12, Length: 2},
+			{Name: "AccelerometerWakeupThreshold", Start: 14, Length: 2},
+			{Name: "AccelerometerDelay", Start: 16, Length: 2},
+			{Name: "FirmwareVersionMajor", Start: 18, Length: 1},
+			{Name: "FirmwareVersionMinor", Start: 19, Length: 1},
+			{Name: "FirmwareVersionPatch", Start: 20, Length: 1},
+			{Name: "HardwareVersionType", Start: 21, Length: 1},
+			{Name: "HardwareVersionRevision", Start: 22, Length: 1},
+			{Name: "BatteryKeepAliveMessageInterval", Start: 23, Length: 4},
+			{Name: "ReJoinInterval", Start: 27, Length: 4},
+			{Name: "AccuracyEnhancement", Start: 31, Length: 1},
+			{Name: "LightLowerThreshold", Start: 32, Length: 2},
+			{Name: "LightUpperThreshold", Start: 34, Length: 2},
+		},
+		Decode: decodePort4Payload,
+		Encode: encodePort4Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port15Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "LowBattery", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "Battery", Start: 1, Length: 2, Scale: 0.001},
+		},
+		Decode: decodePort15Payload,
+		Encode: encodePort15Payload,
+	})
+}
+
+func decodePort1Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port1Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 0, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 0, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 0, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		p.Moving = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// Latitude
+	if raw, ok := reader.Read("Latitude", 1, 4, false); ok {
+		p.Latitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[4].Scale, config.Fields[4].Offset))
+		if err := common.ValidateField("Latitude", p.Latitude, "gte=-90,lte=90"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Longitude
+	if raw, ok := reader.Read("Longitude", 5, 4, false); ok {
+		p.Longitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[5].Scale, config.Fields[5].Offset))
+		if err := common.ValidateField("Longitude", p.Longitude, "gte=-180,lte=180"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Altitude
+	if raw, ok := reader.Read("Altitude", 9, 2, false); ok {
+		p.Altitude = float64(common.ScaledValue(raw, false, config.Fields[6].Scale, config.Fields[6].Offset))
+	}
+
+	// Year
+	if raw, ok := reader.Read("Year", 11, 1, false); ok {
+		p.Year = common.BytesToUint8(raw)
+		if err := common.ValidateField("Year", p.Year, "gte=0,lte=255"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Month
+	if raw, ok := reader.Read("Month", 12, 1, false); ok {
+		p.Month = common.BytesToUint8(raw)
+		if err := common.ValidateField("Month", p.Month, "gte=1,lte=12"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Day
+	if raw, ok := reader.Read("Day", 13, 1, false); ok {
+		p.Day = common.BytesToUint8(raw)
+		if err := common.ValidateField("Day", p.Day, "gte=1,lte=31"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Hour
+	if raw, ok := reader.Read("Hour", 14, 1, false); ok {
+		p.Hour = common.BytesToUint8(raw)
+		if err := common.ValidateField("Hour", p.Hour, "gte=0,lte=23"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Minute
+	if raw, ok := reader.Read("Minute", 15, 1, false); ok {
+		p.Minute = common.BytesToUint8(raw)
+		if err := common.ValidateField("Minute", p.Minute, "gte=0,lte=59"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Second
+	if raw, ok := reader.Read("Second", 16, 1, false); ok {
+		p.Second = common.BytesToUint8(raw)
+		if err := common.ValidateField("Second", p.Second, "gte=0,lte=59"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// TimeToFix
+	if raw, ok := reader.Read("TimeToFix", 17, 1, false); ok {
+		p.TimeToFix = time.Duration(common.ScaledValue(raw, false, config.Fields[13].Scale, config.Fields[13].Offset) * float64(time.Second))
+	}
+
+	// AmbientLight
+	if raw, ok := reader.Read("AmbientLight", 18, 2, false); ok {
+		p.AmbientLight = common.BytesToUint16(raw)
+	}
+
+	// AccelerometerXAxis
+	if raw, ok := reader.Read("AccelerometerXAxis", 20, 2, false); ok {
+		p.AccelerometerXAxis = common.BytesToInt16(raw)
+	}
+
+	// AccelerometerYAxis
+	if raw, ok := reader.Read("AccelerometerYAxis", 22, 2, false); ok {
+		p.AccelerometerYAxis = common.BytesToInt16(raw)
+	}
+
+	// AccelerometerZAxis
+	if raw, ok := reader.Read("AccelerometerZAxis", 24, 2, false); ok {
+		p.AccelerometerZAxis = common.BytesToInt16(raw)
+	}
+
+	// Temperature
+	if raw, ok := reader.Read("Temperature", 26, 2, true); ok {
+		p.Temperature = float32(common.ScaledValue(raw, false, config.Fields[18].Scale, config.Fields[18].Offset))
+		if err := common.ValidateField("Temperature", p.Temperature, "gte=-20,lte=60"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Pressure
+	if raw, ok := reader.Read("Pressure", 28, 2, true); ok {
+		p.Pressure = float32(common.ScaledValue(raw, false, config.Fields[19].Scale, config.Fields[19].Offset))
+		if err := common.ValidateField("Pressure", p.Pressure, "gte=0,lte=1100"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// GyroscopeXAxis
+	if raw, ok := reader.Read("GyroscopeXAxis", 30, 2, true); ok {
+		{
+			value := float32(common.ScaledValue(common.ExtendBytes(raw, 16, true), true, config.Fields[20].Scale, config.Fields[20].Offset))
+			p.GyroscopeXAxis = &value
+		}
+	}
+
+	// GyroscopeYAxis
+	if raw, ok := reader.Read("GyroscopeYAxis", 32, 2, true); ok {
+		{
+			value := float32(common.ScaledValue(common.ExtendBytes(raw, 16, true), true, config.Fields[21].Scale, config.Fields[21].Offset))
+			p.GyroscopeYAxis = &value
+		}
+	}
+
+	// GyroscopeZAxis
+	if raw, ok := reader.Read("GyroscopeZAxis", 34, 2, true); ok {
+		{
+			value := float32(common.ScaledValue(common.ExtendBytes(raw, 16, true), true, config.Fields[22].Scale, config.Fields[22].Offset))
+			p.GyroscopeZAxis = &value
+		}
+	}
+
+	// MagnetometerXAxis
+	if raw, ok := reader.Read("MagnetometerXAxis", 36, 2, true); ok {
+		{
+			value := float32(common.ScaledValue(common.ExtendBytes(raw, 16, true), true, config.Fields[23].Scale, config.Fields[23].Offset))
+			p.MagnetometerXAxis = &value
+		}
+	}
+
+	// MagnetometerYAxis
+	if raw, ok := reader.Read("MagnetometerYAxis", 38, 2, true); ok {
+		{
+			value := float32(common.ScaledValue(common.ExtendBytes(raw, 16, true), true, config.Fields[24].Scale, config.Fields[24].Offset))
+			p.MagnetometerYAxis = &value
+		}
+	}
+
+	// MagnetometerZAxis
+	if raw, ok := reader.Read("MagnetometerZAxis", 40, 2, true); ok {
+		{
+			value := float32(common.ScaledValue(common.ExtendBytes(raw, 16, true), true, config.Fields[25].Scale, config.Fields[25].Offset))
+			p.MagnetometerZAxis = &value
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort1Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port1Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(42)
+	var bytes []byte
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(0, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(0, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(0, 1, 2, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	writer.WriteBits(0, 1, 0, 1, bytes)
+
+	// Latitude
+	bytes = common.UnscaledBytes(float64(p.Latitude), config.Fields[4].Scale, config.Fields[4].Offset, 4)
+	writer.Write(1, 4, bytes)
+
+	// Longitude
+	bytes = common.UnscaledBytes(float64(p.Longitude), config.Fields[5].Scale, config.Fields[5].Offset, 4)
+	writer.Write(5, 4, bytes)
+
+	// Altitude
+	bytes = common.UnscaledBytes(float64(p.Altitude), config.Fields[6].Scale, config.Fields[6].Offset, 2)
+	writer.Write(9, 2, bytes)
+
+	// Year
+	bytes = common.UintToBytes(uint64(p.Year), 1)
+	writer.Write(11, 1, bytes)
+
+	// Month
+	bytes = common.UintToBytes(uint64(p.Month), 1)
+	writer.Write(12, 1, bytes)
+
+	// Day
+	bytes = common.UintToBytes(uint64(p.Day), 1)
+	writer.Write(13, 1, bytes)
+
+	// Hour
+	bytes = common.UintToBytes(uint64(p.Hour), 1)
+	writer.Write(14, 1, bytes)
+
+	// Minute
+	bytes = common.UintToBytes(uint64(p.Minute), 1)
+	writer.Write(15, 1, bytes)
+
+	// Second
+	bytes = common.UintToBytes(uint64(p.Second), 1)
+	writer.Write(16, 1, bytes)
+
+	// TimeToFix
+	bytes = common.UnscaledBytes((p.TimeToFix).Seconds(), config.Fields[13].Scale, config.Fields[13].Offset, 1)
+	writer.Write(17, 1, bytes)
+
+	// AmbientLight
+	bytes = common.UintToBytes(uint64(p.AmbientLight), 2)
+	writer.Write(18, 2, bytes)
+
+	// AccelerometerXAxis
+	bytes = common.IntToBytes(int64(p.AccelerometerXAxis), 2)
+	writer.Write(20, 2, bytes)
+
+	// AccelerometerYAxis
+	bytes = common.IntToBytes(int64(p.AccelerometerYAxis), 2)
+	writer.Write(22, 2, bytes)
+
+	// AccelerometerZAxis
+	bytes = common.IntToBytes(int64(p.AccelerometerZAxis), 2)
+	writer.Write(24, 2, bytes)
+
+	// Temperature
+	bytes = common.UnscaledBytes(float64(p.Temperature), config.Fields[18].Scale, config.Fields[18].Offset, 2)
+	if p.Temperature != 0 {
+		writer.Write(26, 2, bytes)
+	}
+
+	// Pressure
+	bytes = common.UnscaledBytes(float64(p.Pressure), config.Fields[19].Scale, config.Fields[19].Offset, 2)
+	if p.Pressure != 0 {
+		writer.Write(28, 2, bytes)
+	}
+
+	// GyroscopeXAxis
+	if p.GyroscopeXAxis != nil {
+		bytes = common.UnscaledBytes(float64(*p.GyroscopeXAxis), config.Fields[20].Scale, config.Fields[20].Offset, 2)
+		if *p.GyroscopeXAxis != 0 {
+			writer.Write(30, 2, bytes)
+		}
+	}
+
+	// GyroscopeYAxis
+	if p.GyroscopeYAxis != nil {
+		bytes = common.UnscaledBytes(float64(*p.GyroscopeYAxis), config.Fields[21].Scale, config.Fields[21].Offset, 2)
+		if *p.GyroscopeYAxis != 0 {
+			writer.Write(32, 2, bytes)
+		}
+	}
+
+	// GyroscopeZAxis
+	if p.GyroscopeZAxis != nil {
+		bytes = common.UnscaledBytes(float64(*p.GyroscopeZAxis), config.Fields[22].Scale, config.Fields[22].Offset, 2)
+		if *p.GyroscopeZAxis != 0 {
+			writer.Write(34, 2, bytes)
+		}
+	}
+
+	// MagnetometerXAxis
+	if p.MagnetometerXAxis != nil {
+		bytes = common.UnscaledBytes(float64(*p.MagnetometerXAxis), config.Fields[23].Scale, config.Fields[23].Offset, 2)
+		if *p.MagnetometerXAxis != 0 {
+			writer.Write(36, 2, bytes)
+		}
+	}
+
+	// MagnetometerYAxis
+	if p.MagnetometerYAxis != nil {
+		bytes = common.UnscaledBytes(float64(*p.MagnetometerYAxis), config.Fields[24].Scale, config.Fields[24].Offset, 2)
+		if *p.MagnetometerYAxis != 0 {
+			writer.Write(38, 2, bytes)
+		}
+	}
+
+	// MagnetometerZAxis
+	if p.MagnetometerZAxis != nil {
+		bytes = common.UnscaledBytes(float64(*p.MagnetometerZAxis), config.Fields[25].Scale, config.Fields[25].Offset, 2)
+		if *p.MagnetometerZAxis != 0 {
+			writer.Write(40, 2, bytes)
+		}
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort4Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port4Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// LocalizationIntervalWhileMoving
+	if raw, ok := reader.Read("LocalizationIntervalWhileMoving", 0, 4, false); ok {
+		p.LocalizationIntervalWhileMoving = common.BytesToUint32(raw)
+	}
+
+	// LocalizationIntervalWhileSteady
+	if raw, ok := reader.Read("LocalizationIntervalWhileSteady", 4, 4, false); ok {
+		p.LocalizationIntervalWhileSteady = common.BytesToUint32(raw)
+	}
+
+	// HeartbeatInterval
+	if raw, ok := reader.Read("HeartbeatInterval", 8, 4, false); ok {
+		p.HeartbeatInterval = common.BytesToUint32(raw)
+	}
+
+	// GPSTimeoutWhileWaitingForFix
+	if raw, ok := reader.Read("GPSTimeoutWhileWaitingForFix", 12, 2, false); ok {
+		p.GPSTimeoutWhileWaitingForFix = common.BytesToUint16(raw)
+	}
+
+	// AccelerometerWakeupThreshold
+	if raw, ok := reader.Read("AccelerometerWakeupThreshold", 14, 2, false); ok {
+		p.AccelerometerWakeupThreshold = common.BytesToUint16(raw)
+	}
+
+	// AccelerometerDelay
+	if raw, ok := reader.Read("AccelerometerDelay", 16, 2, false); ok {
+		p.AccelerometerDelay = common.BytesToUint16(raw)
+	}
+
+	// FirmwareVersionMajor
+	if raw, ok := reader.Read("FirmwareVersionMajor", 18, 1, false); ok {
+		p.FirmwareVersionMajor = common.BytesToUint8(raw)
+	}
+
+	// FirmwareVersionMinor
+	if raw, ok := reader.Read("FirmwareVersionMinor", 19, 1, false); ok {
+		p.FirmwareVersionMinor = common.BytesToUint8(raw)
+	}
+
+	// FirmwareVersionPatch
+	if raw, ok := reader.Read("FirmwareVersionPatch", 20, 1, false); ok {
+		p.FirmwareVersionPatch = common.BytesToUint8(raw)
+	}
+
+	// HardwareVersionType
+	if raw, ok := reader.Read("HardwareVersionType", 21, 1, false); ok {
+		p.HardwareVersionType = common.BytesToUint8(raw)
+	}
+
+	// HardwareVersionRevision
+	if raw, ok := reader.Read("HardwareVersionRevision", 22, 1, false); ok {
+		p.HardwareVersionRevision = common.BytesToUint8(raw)
+	}
+
+	// BatteryKeepAliveMessageInterval
+	if raw, ok := reader.Read("BatteryKeepAliveMessageInterval", 23, 4, false); ok {
+		p.BatteryKeepAliveMessageInterval = common.BytesToUint32(raw)
+	}
+
+	// ReJoinInterval
+	if raw, ok := reader.Read("ReJoinInterval", 27, 4, false); ok {
+		p.ReJoinInterval = common.BytesToUint32(raw)
+	}
+
+	// AccuracyEnhancement
+	if raw, ok := reader.Read("AccuracyEnhancement", 31, 1, false); ok {
+		p.AccuracyEnhancement = common.BytesToUint8(raw)
+	}
+
+	// LightLowerThreshold
+	if raw, ok := reader.Read("LightLowerThreshold", 32, 2, false); ok {
+		p.LightLowerThreshold = common.BytesToUint16(raw)
+	}
+
+	// LightUpperThreshold
+	if raw, ok := reader.Read("LightUpperThreshold", 34, 2, false); ok {
+		p.LightUpperThreshold = common.BytesToUint16(raw)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort4Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port4Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(36)
+	var bytes []byte
+
+	// LocalizationIntervalWhileMoving
+	bytes = common.UintToBytes(uint64(p.LocalizationIntervalWhileMoving), 4)
+	writer.Write(0, 4, bytes)
+
+	// LocalizationIntervalWhileSteady
+	bytes = common.UintToBytes(uint64(p.LocalizationIntervalWhileSteady), 4)
+	writer.Write(4, 4, bytes)
+
+	// HeartbeatInterval
+	bytes = common.UintToBytes(uint64(p.HeartbeatInterval), 4)
+	writer.Write(8, 4, bytes)
+
+	// GPSTimeoutWhileWaitingForFix
+	bytes = common.UintToBytes(uint64(p.GPSTimeoutWhileWaitingForFix), 2)
+	writer.Write(12, 2, bytes)
+
+	// AccelerometerWakeupThreshold
+	bytes = common.UintToBytes(uint64(p.AccelerometerWakeupThreshold), 2)
+	writer.Write(14, 2, bytes)
+
+	// AccelerometerDelay
+	bytes = common.UintToBytes(uint64(p.AccelerometerDelay), 2)
+	writer.Write(16, 2, bytes)
+
+	// FirmwareVersionMajor
+	bytes = common.UintToBytes(uint64(p.FirmwareVersionMajor), 1)
+	writer.Write(18, 1, bytes)
+
+	// FirmwareVersionMinor
+	bytes = common.UintToBytes(uint64(p.FirmwareVersionMinor), 1)
+	writer.Write(19, 1, bytes)
+
+	// FirmwareVersionPatch
+	bytes = common.UintToBytes(uint64(p.FirmwareVersionPatch), 1)
+	writer.Write(20, 1, bytes)
+
+	// HardwareVersionType
+	bytes = common.UintToBytes(uint64(p.HardwareVersionType), 1)
+	writer.Write(21, 1, bytes)
+
+	// HardwareVersionRevision
+	bytes = common.UintToBytes(uint64(p.HardwareVersionRevision), 1)
+	writer.Write(22, 1, bytes)
+
+	// BatteryKeepAliveMessageInterval
+	bytes = common.UintToBytes(uint64(p.BatteryKeepAliveMessageInterval), 4)
+	writer.Write(23, 4, bytes)
+
+	// ReJoinInterval
+	bytes = common.UintToBytes(uint64(p.ReJoinInterval), 4)
+	writer.Write(27, 4, bytes)
+
+	// AccuracyEnhancement
+	bytes = common.UintToBytes(uint64(p.AccuracyEnhancement), 1)
+	writer.Write(31, 1, bytes)
+
+	// LightLowerThreshold
+	bytes = common.UintToBytes(uint64(p.LightLowerThreshold), 2)
+	writer.Write(32, 2, bytes)
+
+	// LightUpperThreshold
+	bytes = common.UintToBytes(uint64(p.LightUpperThreshold), 2)
+	writer.Write(34, 2, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort15Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port15Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 0, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 0, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 0, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// LowBattery
+	if raw, ok := reader.Read("LowBattery", 0, 1, false); ok {
+		p.LowBattery = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// Battery
+	if raw, ok := reader.Read("Battery", 1, 2, false); ok {
+		p.Battery = float64(common.ScaledValue(raw, false, config.Fields[4].Scale, config.Fields[4].Offset))
+		if err := common.ValidateField("Battery", p.Battery, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort15Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port15Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(3)
+	var bytes []byte
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(0, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(0, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(0, 1, 2, 1, bytes)
+
+	// LowBattery
+	bytes = common.BoolToBytes(p.LowBattery, 0)
+	writer.WriteBits(0, 1, 0, 1, bytes)
+
+	// Battery
+	bytes = common.UnscaledBytes(float64(p.Battery), config.Fields[4].Scale, config.Fields[4].Offset, 2)
+	writer.Write(1, 2, bytes)
+
+	return writer.String(), nil
+}
@@ -4,16 +4,18 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"time"
 
 	"github.com/truvami/decoder/pkg/common"
 	"github.com/truvami/decoder/pkg/decoder"
 )
 
+//go:generate go run github.com/truvami/decoder/internal/decodergen
+
 type Option func(*NomadXSv1Decoder)
 
 type NomadXSv1Decoder struct {
 	skipValidation bool
+	lenient        bool
 }
 
 func NewNomadXSv1Decoder(options ...Option) decoder.Decoder {
@@ -32,38 +34,45 @@ func WithSkipValidation(skipValidation bool) Option {
 	}
 }
 
+// WithLenient decodes truncated payloads as far as possible, see common.PayloadConfig.Lenient.
+func WithLenient(lenient bool) Option {
+	return func(t *NomadXSv1Decoder) {
+		t.lenient = lenient
+	}
+}
+
 // https://docs.truvami.com/docs/payloads/nomad-xs
 func (t NomadXSv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	switch port {
 	case 1:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DutyCycle", Start: 0, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 0, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 0, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Year", Start: 11, Length: 1},
 				{Name: "Month", Start: 12, Length: 1},
 				{Name: "Day", Start: 13, Length: 1},
 				{Name: "Hour", Start: 14, Length: 1},
 				{Name: "Minute", Start: 15, Length: 1},
 				{Name: "Second", Start: 16, Length: 1},
-				{Name: "TimeToFix", Start: 17, Length: 1, Transform: ttf},
+				{Name: "TimeToFix", Start: 17, Length: 1, Scale: 1, Unit: "s"},
 				{Name: "AmbientLight", Start: 18, Length: 2},
 				{Name: "AccelerometerXAxis", Start: 20, Length: 2},
 				{Name: "AccelerometerYAxis", Start: 22, Length: 2},
 				{Name: "AccelerometerZAxis", Start: 24, Length: 2},
-				{Name: "Temperature", Start: 26, Length: 2, Optional: true, Transform: temperature},
-				{Name: "Pressure", Start: 28, Length: 2, Optional: true, Transform: pressure},
-				{Name: "GyroscopeXAxis", Start: 30, Length: 2, Optional: true, Transform: gyroscope},
-				{Name: "GyroscopeYAxis", Start: 32, Length: 2, Optional: true, Transform: gyroscope},
-				{Name: "GyroscopeZAxis", Start: 34, Length: 2, Optional: true, Transform: gyroscope},
-				{Name: "MagnetometerXAxis", Start: 36, Length: 2, Optional: true, Transform: magnetometer},
-				{Name: "MagnetometerYAxis", Start: 38, Length: 2, Optional: true, Transform: magnetometer},
-				{Name: "MagnetometerZAxis", Start: 40, Length: 2, Optional: true, Transform: magnetometer},
+				{Name: "Temperature", Start: 26, Length: 2, Optional: true, Scale: 0.01, Unit: "°C"},
+				{Name: "Pressure", Start: 28, Length: 2, Optional: true, Scale: 0.1, Unit: "hPa"},
+				{Name: "GyroscopeXAxis", Start: 30, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.1, Unit: "dps"},
+				{Name: "GyroscopeYAxis", Start: 32, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.1, Unit: "dps"},
+				{Name: "GyroscopeZAxis", Start: 34, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.1, Unit: "dps"},
+				{Name: "MagnetometerXAxis", Start: 36, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.001, Unit: "G"},
+				{Name: "MagnetometerYAxis", Start: 38, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.001, Unit: "G"},
+				{Name: "MagnetometerZAxis", Start: 40, Length: 2, Optional: true, Signedness: common.Signed, Scale: 0.001, Unit: "G"},
 			},
 			TargetType: reflect.TypeOf(Port1Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureMoving, decoder.FeatureGNSS, decoder.FeatureTimestamp, decoder.FeatureTemperature, decoder.FeaturePressure},
@@ -94,11 +103,11 @@ func (t NomadXSv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 15:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DutyCycle", Start: 0, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 0, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 0, Length: 1, Transform: configSuccess},
-				{Name: "LowBattery", Start: 0, Length: 1, Transform: lowBattery},
-				{Name: "Battery", Start: 1, Length: 2, Transform: battery},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "LowBattery", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Battery", Start: 1, Length: 2, Scale: 0.001, Unit: "V"},
 			},
 			TargetType: reflect.TypeOf(Port15Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureBattery},
@@ -108,75 +117,72 @@ func (t NomadXSv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	return common.PayloadConfig{}, fmt.Errorf("%w: port %v not supported", common.ErrPortNotSupported, port)
 }
 
-func (t NomadXSv1Decoder) Decode(ctx context.Context, data string, port uint8) (*decoder.DecodedUplink, error) {
-	config, err := t.getConfig(port)
-	if err != nil {
-		return nil, err
-	}
+var _ decoder.Catalog = &NomadXSv1Decoder{}
 
-	if !t.skipValidation {
-		err := common.ValidateLength(&data, &config)
+// Ports describes the payloads of the supported ports.
+func (t NomadXSv1Decoder) Ports() []decoder.PortInfo {
+	infos := []decoder.PortInfo{}
+	for _, port := range supportedPorts {
+		config, err := t.getConfig(port)
 		if err != nil {
-			return nil, err
+			continue
 		}
+		infos = append(infos, common.DescribePort(port, nil, config, docs))
 	}
-
-	decodedData, err := common.Decode(&data, &config)
-	return decoder.NewDecodedUplink(config.Features, decodedData), err
-}
-
-func dutyCycle(v any) any {
-	return ((v.([]byte))[0]>>7)&0x01 == 1
-}
-
-func configId(v any) any {
-	return ((v.([]byte))[0] >> 3) & 0x0f
+	return infos
 }
 
-func configSuccess(v any) any {
-	return ((v.([]byte))[0]>>2)&0x01 == 1
-}
-
-func moving(v any) any {
-	return (v.([]byte))[0]&0x01 == 1
-}
-
-func lowBattery(v any) any {
-	return (v.([]byte))[0]&0x01 == 1
-}
+var _ decoder.Explainer = &NomadXSv1Decoder{}
 
-func latitude(v any) any {
-	return float64(common.BytesToInt32(v.([]byte))) / 1000000
+// Explain describes how each field of the payload is decoded on the port.
+func (t NomadXSv1Decoder) Explain(ctx context.Context, payload []byte, port uint8) ([]decoder.FieldExplanation, error) {
+	config, err := t.getConfig(port)
+	if err != nil {
+		return nil, err
+	}
+	return common.Explain(payload, &config)
 }
 
-func longitude(v any) any {
-	return float64(common.BytesToInt32(v.([]byte))) / 1000000
+func (t NomadXSv1Decoder) Decode(ctx context.Context, data string, port uint8) (*decoder.DecodedUplink, error) {
+	payload, err := decoder.FromHex(data)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "nomadxs/v1", port, nil)
+	}
+	return t.DecodeBytes(ctx, payload, port)
 }
 
-func altitude(v any) any {
-	return float64(common.BytesToUint16(v.([]byte))) / 10
+func (t NomadXSv1Decoder) DecodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	options, err := decoder.OptionsFromContext(ctx, port)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "nomadxs/v1", port, nil)
+	}
+	return t.DecodeWithOptions(ctx, payload, options)
 }
 
-func ttf(v any) any {
-	return time.Duration(int64(common.BytesToUint8(v.([]byte)))) * time.Second
-}
+var _ decoder.OptionsDecoder = &NomadXSv1Decoder{}
 
-func temperature(v any) any {
-	return float32(common.BytesToUint16(v.([]byte))) / 100
+// DecodeWithOptions decodes the binary payload on options.Port with the options of the uplink.
+func (t NomadXSv1Decoder) DecodeWithOptions(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	uplink, err := t.decodeBytes(ctx, payload, options.Port)
+	uplink.SetMetadata("nomadxs/v1", payload, options)
+	return uplink, common.WrapDecodeError(err, "nomadxs/v1", options.Port, payload)
 }
 
-func pressure(v any) any {
-	return float32(common.BytesToUint16(v.([]byte))) / 10
-}
+func (t NomadXSv1Decoder) decodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	config, err := t.getConfig(port)
+	if err != nil {
+		return nil, err
+	}
 
-func gyroscope(v any) any {
-	return float32(common.BytesToInt16(v.([]byte))) / 10
-}
+	config.Lenient = t.lenient
 
-func magnetometer(v any) any {
-	return float32(common.BytesToInt16(v.([]byte))) / 1000
-}
+	if !t.skipValidation {
+		err := common.ValidateBytesLength(payload, &config)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-func battery(v any) any {
-	return float64(common.BytesToUint16(v.([]byte))) / 1000
+	decodedData, err := common.DecodeBytes(payload, &config)
+	return decoder.NewDecodedUplink(config.Features, decodedData), err
 }
@@ -0,0 +1,33 @@
+package nomadxs
+
+import "github.com/truvami/decoder/pkg/decoder"
+
+const docs = "https://docs.truvami.com/docs/payloads/nomad-xs"
+
+// supportedPorts are the uplink ports the decoder supports.
+var supportedPorts = []uint8{1, 4, 15}
+
+func init() {
+	decoder.Register(decoder.Device{
+		Name:        "nomadxs",
+		Version:     "v1",
+		Description: "nomad XS",
+		Ports:       supportedPorts,
+		Features: []decoder.Feature{
+			decoder.FeatureTimestamp,
+			decoder.FeatureGNSS,
+			decoder.FeatureBattery,
+			decoder.FeatureTemperature,
+			decoder.FeaturePressure,
+			decoder.FeatureConfig,
+			decoder.FeatureConfigChange,
+			decoder.FeatureMoving,
+			decoder.FeatureDutyCycle,
+			decoder.FeatureFirmwareVersion,
+			decoder.FeatureHardwareVersion,
+		},
+		New: func(options decoder.DeviceOptions) (decoder.Decoder, error) {
+			return NewNomadXSv1Decoder(WithSkipValidation(options.SkipValidation), WithLenient(options.Lenient)), nil
+		},
+	})
+}
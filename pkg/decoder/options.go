@@ -0,0 +1,131 @@
+package decoder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var ErrInvalidFrameCounter = errors.New("invalid frame counter")
+
+// DecodeOptions describe the uplink a payload was received with. They are
+// passed to an OptionsDecoder explicitly instead of the deprecated context keys.
+type DecodeOptions struct {
+	DevEUI string
+
+	// FCnt is the full 32-bit uplink frame counter, nil if unknown.
+	FCnt *uint32
+
+	Port uint8
+
+	// ReceivedAt is the time the network server received the uplink, when available.
+	ReceivedAt *time.Time
+
+	// Gateways are the gateways which received the uplink.
+	Gateways []GatewayMetadata
+}
+
+// GatewayMetadata describes the reception of an uplink by a gateway.
+type GatewayMetadata struct {
+	GatewayID  string     `json:"gatewayId"`
+	RSSI       int16      `json:"rssi"`
+	SNR        float64    `json:"snr"`
+	ReceivedAt *time.Time `json:"receivedAt,omitempty"`
+	Latitude   *float64   `json:"latitude,omitempty"`
+	Longitude  *float64   `json:"longitude,omitempty"`
+	Altitude   *float64   `json:"altitude,omitempty"`
+}
+
+// OptionsDecoder is implemented by decoders which accept the DecodeOptions of
+// the uplink explicitly. The payload is decoded on options.Port.
+type OptionsDecoder interface {
+	DecodeWithOptions(ctx context.Context, payload []byte, options DecodeOptions) (*DecodedUplink, error)
+}
+
+// DecodeWithOptions decodes the binary payload with the options. Decoders which
+// are no OptionsDecoder get the options with the deprecated context keys, which
+// hold neither the receive time nor the gateways.
+func DecodeWithOptions(ctx context.Context, d Decoder, payload []byte, options DecodeOptions) (*DecodedUplink, error) {
+	if optionsDecoder, ok := d.(OptionsDecoder); ok {
+		return optionsDecoder.DecodeWithOptions(ctx, payload, options)
+	}
+	return d.DecodeBytes(ContextWithOptions(ctx, options), payload, options.Port)
+}
+
+// OptionsFromContext returns the options stored with the deprecated context
+// keys. The frame counter may be stored as any integer type or as a whole
+// float64 like numbers decoded from JSON, other types and values outside of
+// the range of a 32-bit frame counter return ErrInvalidFrameCounter. The port
+// is the port the decoder was called with. The receive time and the gateways
+// have no context keys and are only passed with DecodeOptions.
+//
+// Deprecated: pass DecodeOptions to an OptionsDecoder instead.
+func OptionsFromContext(ctx context.Context, port uint8) (DecodeOptions, error) {
+	options := DecodeOptions{Port: port}
+
+	if devEui, ok := ctx.Value(DEVEUI_CONTEXT_KEY).(string); ok {
+		options.DevEUI = devEui
+	}
+	if value := ctx.Value(FCNT_CONTEXT_KEY); value != nil {
+		fcnt, err := frameCounter(value)
+		if err != nil {
+			return DecodeOptions{}, err
+		}
+		options.FCnt = &fcnt
+	}
+	return options, nil
+}
+
+// ContextWithOptions stores the options with the deprecated context keys for
+// solvers and decoders which still read them. The frame counter is stored as int.
+//
+// Deprecated: pass DecodeOptions to an OptionsDecoder instead.
+func ContextWithOptions(ctx context.Context, options DecodeOptions) context.Context {
+	ctx = context.WithValue(ctx, DEVEUI_CONTEXT_KEY, options.DevEUI)
+	ctx = context.WithValue(ctx, PORT_CONTEXT_KEY, options.Port)
+	if options.FCnt != nil {
+		ctx = context.WithValue(ctx, FCNT_CONTEXT_KEY, int(*options.FCnt))
+	}
+	return ctx
+}
+
+// frameCounter converts the value of the frame counter context key.
+func frameCounter(v any) (uint32, error) {
+	var fcnt float64
+	switch value := v.(type) {
+	case int:
+		fcnt = float64(value)
+	case int8:
+		fcnt = float64(value)
+	case int16:
+		fcnt = float64(value)
+	case int32:
+		fcnt = float64(value)
+	case int64:
+		fcnt = float64(value)
+	case uint:
+		fcnt = float64(value)
+	case uint8:
+		fcnt = float64(value)
+	case uint16:
+		fcnt = float64(value)
+	case uint32:
+		return value, nil
+	case uint64:
+		fcnt = float64(value)
+	case float64:
+		if value != math.Trunc(value) {
+			return 0, fmt.Errorf("%w: %v is no whole number", ErrInvalidFrameCounter, value)
+		}
+		fcnt = value
+	default:
+		return 0, fmt.Errorf("%w: unsupported type %T", ErrInvalidFrameCounter, v)
+	}
+
+	if fcnt < 0 || fcnt > math.MaxUint32 {
+		return 0, fmt.Errorf("%w: %v is out of range", ErrInvalidFrameCounter, v)
+	}
+	return uint32(fcnt), nil
+}
@@ -0,0 +1,122 @@
+package decoder
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testOptionsDecoder struct {
+	testDecoder
+}
+
+func (d testOptionsDecoder) DecodeWithOptions(ctx context.Context, payload []byte, options DecodeOptions) (*DecodedUplink, error) {
+	return NewDecodedUplink(nil, options), nil
+}
+
+type testContextDecoder struct{}
+
+func (testContextDecoder) Decode(ctx context.Context, payload string, port uint8) (*DecodedUplink, error) {
+	return nil, nil
+}
+
+func (testContextDecoder) DecodeBytes(ctx context.Context, payload []byte, port uint8) (*DecodedUplink, error) {
+	options, err := OptionsFromContext(ctx, port)
+	if err != nil {
+		return nil, err
+	}
+	return NewDecodedUplink(nil, options), nil
+}
+
+func TestOptionsFromContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		fcnt     any
+		expected *uint32
+		err      bool
+	}{
+		{name: "Int", fcnt: 42, expected: ptr(uint32(42))},
+		{name: "Int8", fcnt: int8(3), expected: ptr(uint32(3))},
+		{name: "Uint32", fcnt: uint32(70000), expected: ptr(uint32(70000))},
+		{name: "Uint16", fcnt: uint16(7), expected: ptr(uint32(7))},
+		{name: "Int64", fcnt: int64(8), expected: ptr(uint32(8))},
+		{name: "MaxUint32", fcnt: uint64(math.MaxUint32), expected: ptr(uint32(math.MaxUint32))},
+		{name: "Float64", fcnt: float64(70000), expected: ptr(uint32(70000))},
+		{name: "Missing"},
+		{name: "Negative", fcnt: -1, err: true},
+		{name: "NegativeFloat64", fcnt: float64(-1), err: true},
+		{name: "Uint64AboveMaxUint32", fcnt: uint64(math.MaxUint32 + 1), err: true},
+		{name: "IntAboveMaxUint32", fcnt: math.MaxUint32 + 1, err: true},
+		{name: "Float64AboveMaxUint32", fcnt: float64(math.MaxUint32 + 1), err: true},
+		{name: "Fraction", fcnt: 42.5, err: true},
+		{name: "Float32", fcnt: float32(42), err: true},
+		{name: "String", fcnt: "42", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), DEVEUI_CONTEXT_KEY, "0011223344556677")
+			ctx = context.WithValue(ctx, PORT_CONTEXT_KEY, uint8(5))
+			if test.fcnt != nil {
+				ctx = context.WithValue(ctx, FCNT_CONTEXT_KEY, test.fcnt)
+			}
+
+			options, err := OptionsFromContext(ctx, 1)
+			if test.err {
+				if !errors.Is(err, ErrInvalidFrameCounter) {
+					t.Fatalf("expected %v, got %v", ErrInvalidFrameCounter, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected := DecodeOptions{DevEUI: "0011223344556677", FCnt: test.expected, Port: 1}
+			if !reflect.DeepEqual(options, expected) {
+				t.Errorf("expected %+v, got %+v", expected, options)
+			}
+		})
+	}
+}
+
+func TestDecodeWithOptions(t *testing.T) {
+	receivedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	options := DecodeOptions{DevEUI: "0011223344556677", FCnt: ptr(uint32(70000)), Port: 3, ReceivedAt: &receivedAt}
+
+	// the context keys hold no receive time
+	withoutReceivedAt := options
+	withoutReceivedAt.ReceivedAt = nil
+
+	tests := []struct {
+		decoder  Decoder
+		expected DecodeOptions
+	}{
+		{decoder: testOptionsDecoder{}, expected: options},
+		{decoder: testContextDecoder{}, expected: withoutReceivedAt},
+	}
+
+	for _, test := range tests {
+		t.Run(reflect.TypeOf(test.decoder).Name(), func(t *testing.T) {
+			uplink, err := DecodeWithOptions(context.Background(), test.decoder, []byte{0x01}, options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(uplink.Data, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, uplink.Data)
+			}
+		})
+	}
+
+	// the deprecated context keys keep their types
+	ctx := ContextWithOptions(context.Background(), options)
+	if fcnt, ok := ctx.Value(FCNT_CONTEXT_KEY).(int); !ok || fcnt != 70000 {
+		t.Errorf("expected frame counter 70000 as int, got %v", ctx.Value(FCNT_CONTEXT_KEY))
+	}
+	if port, ok := ctx.Value(PORT_CONTEXT_KEY).(uint8); !ok || port != 3 {
+		t.Errorf("expected port 3 as uint8, got %v", ctx.Value(PORT_CONTEXT_KEY))
+	}
+}
@@ -0,0 +1,264 @@
+package decoder
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidOutputProfile = errors.New("invalid output profile")
+
+// OutputProfile selects how decoded payloads are written as JSON.
+type OutputProfile string
+
+const (
+	// ProfileDisplay writes payloads with their MarshalJSON methods, which add
+	// units to values like "572.8m", "3.612v" or "10s".
+	ProfileDisplay OutputProfile = "display"
+	// ProfileMachine writes the fields of payloads as plain numbers. Durations
+	// are written in seconds and timestamps in RFC 3339.
+	ProfileMachine OutputProfile = "machine"
+)
+
+// OutputProfiles are the supported output profiles.
+var OutputProfiles = []OutputProfile{ProfileDisplay, ProfileMachine}
+
+// ParseOutputProfile returns the output profile of the name, the display
+// profile for an empty name.
+func ParseOutputProfile(name string) (OutputProfile, error) {
+	switch OutputProfile(strings.ToLower(name)) {
+	case "", ProfileDisplay:
+		return ProfileDisplay, nil
+	case ProfileMachine:
+		return ProfileMachine, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidOutputProfile, name)
+	}
+}
+
+// MarshalProfile marshals the payload as JSON in the output profile.
+func MarshalProfile(payload any, profile OutputProfile) ([]byte, error) {
+	switch profile {
+	case "", ProfileDisplay:
+		return json.Marshal(payload)
+	case ProfileMachine:
+		var out bytes.Buffer
+		err := marshalMachine(&out, reflect.ValueOf(payload))
+		if err != nil {
+			return nil, err
+		}
+		return out.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOutputProfile, profile)
+	}
+}
+
+// MarshalProfile marshals the uplink like json.Marshal with its data in the
+// output profile.
+func (d DecodedUplink) MarshalProfile(profile OutputProfile) ([]byte, error) {
+	data, err := MarshalProfile(d.Data, profile)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		Data     json.RawMessage `json:"data"`
+		Metadata Metadata        `json:"metadata"`
+	}{
+		Data:     data,
+		Metadata: d.Metadata,
+	})
+}
+
+var (
+	durationType = reflect.TypeFor[time.Duration]()
+	timeType     = reflect.TypeFor[time.Time]()
+)
+
+// marshalMachine writes the value like json.Marshal but ignores the MarshalJSON
+// methods of structs.
+func marshalMachine(out *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() {
+		out.WriteString("null")
+		return nil
+	}
+
+	switch v.Type() {
+	case durationType:
+		return writeJSON(out, time.Duration(v.Int()).Seconds())
+	case timeType:
+		return writeJSON(out, v.Interface().(time.Time).Format(time.RFC3339))
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			out.WriteString("null")
+			return nil
+		}
+		return marshalMachine(out, v.Elem())
+	case reflect.Struct:
+		out.WriteByte('{')
+		err := marshalFields(out, v)
+		out.WriteByte('}')
+		return err
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && (v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8) {
+			return writeJSON(out, v.Interface())
+		}
+		out.WriteByte('[')
+		for i := range v.Len() {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			if err := marshalMachine(out, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		out.WriteByte(']')
+		return nil
+	case reflect.Map:
+		return marshalMap(out, v)
+	default:
+		return writeJSON(out, v.Interface())
+	}
+}
+
+// marshalFields writes the fields of the struct with their JSON names. The
+// fields of embedded structs are written inline.
+func marshalFields(out *bytes.Buffer, v reflect.Value) error {
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		tag := field.Tag.Get("json")
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			continue
+		}
+
+		value := v.Field(i)
+		if field.Anonymous && name == "" && value.Kind() == reflect.Struct {
+			if err := marshalFields(out, value); err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if strings.Contains(options, "omitempty") && isEmptyValue(value) {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		// a comma unless this is the first field of the object
+		if out.Bytes()[out.Len()-1] != '{' {
+			out.WriteByte(',')
+		}
+		if err := writeJSON(out, name); err != nil {
+			return err
+		}
+		out.WriteByte(':')
+		if err := marshalMachine(out, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// marshalMap writes the entries of the map sorted by their keys, which are
+// written like json.Marshal writes them.
+func marshalMap(out *bytes.Buffer, v reflect.Value) error {
+	if v.IsNil() {
+		out.WriteString("null")
+		return nil
+	}
+
+	type entry struct {
+		key   string
+		value reflect.Value
+	}
+	entries := []entry{}
+	for iter := v.MapRange(); iter.Next(); {
+		key, err := mapKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{key: key, value: iter.Value()})
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	out.WriteByte('{')
+	for i, entry := range entries {
+		if i > 0 {
+			out.WriteByte(',')
+		}
+		if err := writeJSON(out, entry.key); err != nil {
+			return err
+		}
+		out.WriteByte(':')
+		if err := marshalMachine(out, entry.value); err != nil {
+			return err
+		}
+	}
+	out.WriteByte('}')
+	return nil
+}
+
+// mapKey returns the object key of the map key like json.Marshal.
+func mapKey(key reflect.Value) (string, error) {
+	if key.Kind() == reflect.String {
+		return key.String(), nil
+	}
+	if marshaler, ok := key.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+
+	switch key.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(key.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported map key type %v", key.Type())
+	}
+}
+
+// isEmptyValue reports whether json.Marshal omits the value of an omitempty
+// field. Unlike reflect.Value.IsZero structs such as time.Time are never empty.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+func writeJSON(out *bytes.Buffer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	out.Write(data)
+	return nil
+}
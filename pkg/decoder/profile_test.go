@@ -0,0 +1,187 @@
+package decoder
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+type profileRecord struct {
+	Mac  string `json:"mac"`
+	Rssi *int8  `json:"rssi"`
+}
+
+type profileHeader struct {
+	Version uint8 `json:"version"`
+}
+
+type profilePayload struct {
+	profileHeader
+	Altitude     float64         `json:"altitude"`
+	Timestamp    time.Time       `json:"timestamp"`
+	TTF          time.Duration   `json:"ttf"`
+	PDOP         *float64        `json:"pdop"`
+	Hidden       string          `json:"-"`
+	Optional     *uint8          `json:"optional,omitempty"`
+	DataRate     DataRate        `json:"dataRate"`
+	AccessPoints []profileRecord `json:"accessPoints"`
+}
+
+func (p profilePayload) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"altitude": "572.8m"})
+}
+
+func TestMarshalProfile(t *testing.T) {
+	rssi := int8(-81)
+	payload := profilePayload{
+		profileHeader: profileHeader{Version: 2},
+		Altitude:      572.8,
+		Timestamp:     time.Date(2024, 8, 20, 14, 18, 53, 0, time.UTC),
+		TTF:           42 * time.Second,
+		Hidden:        "hidden",
+		DataRate:      DataRateFast,
+		AccessPoints:  []profileRecord{{Mac: "f052fab920fe", Rssi: &rssi}},
+	}
+
+	tests := []struct {
+		profile  OutputProfile
+		expected string
+	}{
+		{
+			profile:  ProfileDisplay,
+			expected: `{"altitude":"572.8m"}`,
+		},
+		{
+			profile:  ProfileMachine,
+			expected: `{"version":2,"altitude":572.8,"timestamp":"2024-08-20T14:18:53Z","ttf":42,"pdop":null,"dataRate":"fast","accessPoints":[{"mac":"f052fab920fe","rssi":-81}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.profile), func(t *testing.T) {
+			data, err := MarshalProfile(payload, test.profile)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, data)
+			}
+		})
+	}
+
+	_, err := MarshalProfile(payload, "fancy")
+	if !errors.Is(err, ErrInvalidOutputProfile) {
+		t.Errorf("expected %v, got %v", ErrInvalidOutputProfile, err)
+	}
+}
+
+type profilePosition struct {
+	Latitude float64 `json:"latitude"`
+}
+
+func (p profilePosition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"latitude": "47.1°"})
+}
+
+type profileOmitEmpty struct {
+	Timestamp time.Time                  `json:"timestamp,omitempty"`
+	Position  profilePosition            `json:"position,omitempty"`
+	Count     uint8                      `json:"count,omitempty"`
+	Moving    bool                       `json:"moving,omitempty"`
+	Name      string                     `json:"name,omitempty"`
+	PDOP      *float64                   `json:"pdop,omitempty"`
+	Records   []profileRecord            `json:"records,omitempty"`
+	Zones     map[string]profilePosition `json:"zones,omitempty"`
+	Floors    map[int8]profilePosition   `json:"floors"`
+}
+
+func TestMarshalProfileOmitEmpty(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  profileOmitEmpty
+		expected string
+	}{
+		{
+			name:     "Zero",
+			payload:  profileOmitEmpty{},
+			expected: `{"timestamp":"0001-01-01T00:00:00Z","position":{"latitude":0},"floors":null}`,
+		},
+		{
+			name: "Nested",
+			payload: profileOmitEmpty{
+				Position: profilePosition{Latitude: 47.1},
+				Count:    1,
+				Zones:    map[string]profilePosition{"lab": {Latitude: 47.2}, "garage": {}},
+				Floors:   map[int8]profilePosition{-1: {Latitude: 47.3}},
+			},
+			expected: `{"timestamp":"0001-01-01T00:00:00Z","position":{"latitude":47.1},"count":1,"zones":{"garage":{"latitude":0},"lab":{"latitude":47.2}},"floors":{"-1":{"latitude":47.3}}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := MarshalProfile(test.payload, ProfileMachine)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, data)
+			}
+		})
+	}
+
+	// the JSON Schema requires the fields which are written for the zero value
+	data, err := MarshalProfile(profileOmitEmpty{}, ProfileMachine)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var written map[string]any
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keys := []string{}
+	for key := range written {
+		keys = append(keys, key)
+	}
+
+	required := typeSchema(reflect.TypeOf(profileOmitEmpty{}), nil, "")["required"].([]string)
+	slices.Sort(keys)
+	slices.Sort(required)
+	if !slices.Equal(required, keys) {
+		t.Errorf("expected required %v, got %v", keys, required)
+	}
+}
+
+func TestParseOutputProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected OutputProfile
+		err      bool
+	}{
+		{name: "", expected: ProfileDisplay},
+		{name: "display", expected: ProfileDisplay},
+		{name: "Machine", expected: ProfileMachine},
+		{name: "fancy", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			profile, err := ParseOutputProfile(test.name)
+			if test.err {
+				if !errors.Is(err, ErrInvalidOutputProfile) {
+					t.Errorf("expected %v, got %v", ErrInvalidOutputProfile, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if profile != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, profile)
+			}
+		})
+	}
+}
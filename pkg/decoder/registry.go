@@ -0,0 +1,251 @@
+package decoder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/truvami/decoder/pkg/encoder"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrDeviceNotFound   = errors.New("device not found")
+	ErrDeviceRegistered = errors.New("device already registered")
+)
+
+// Solver resolves the position of localization payloads, see solver.SolverV1.
+type Solver interface {
+	Solve(ctx context.Context, payload string) (*DecodedUplink, error)
+}
+
+// DeviceOptions are the settings a registered decoder is built with.
+type DeviceOptions struct {
+	Context context.Context
+	// Solver is used by devices which resolve positions, see Device.Solver.
+	// Defaults to a solver which returns no position.
+	Solver Solver
+	// Logger defaults to a no-op logger.
+	Logger         *zap.Logger
+	SkipValidation bool
+	Lenient        bool
+}
+
+// Device describes a device and the version of its payload format.
+type Device struct {
+	// Name is the name of the device, e.g. tagsl.
+	Name string `json:"name"`
+	// Version is the version of the payload format, e.g. v1.
+	Version     string `json:"version"`
+	Description string `json:"description"`
+	// Ports are the uplink ports the decoder supports.
+	Ports []uint8 `json:"ports"`
+	// Features are the features of the uplinks of the device on any port.
+	Features []Feature `json:"features"`
+	// Solver reports whether the decoder resolves positions with DeviceOptions.Solver.
+	Solver bool `json:"solver"`
+
+	// New builds the decoder of the device.
+	New func(options DeviceOptions) (Decoder, error) `json:"-"`
+	// NewEncoder builds the encoder of the device, nil if it has none. Encoder
+	// packages set it with RegisterEncoder.
+	NewEncoder func() encoder.Encoder `json:"-"`
+}
+
+// Path returns the name and version of the device like tagsl/v1.
+func (d Device) Path() string {
+	return d.Name + "/" + d.Version
+}
+
+// Registry holds the devices decoders can be built for.
+type Registry struct {
+	mutex   sync.RWMutex
+	devices map[string]Device
+}
+
+func NewRegistry() *Registry {
+	return &Registry{devices: map[string]Device{}}
+}
+
+// DefaultRegistry holds the devices of this module. Device packages register
+// themselves when they are imported, see the devices package.
+var DefaultRegistry = NewRegistry()
+
+// Register adds a device to the registry. A device can only be registered once
+// per version.
+func (r *Registry) Register(device Device) error {
+	if device.Name == "" || device.Version == "" || device.New == nil {
+		return fmt.Errorf("device %s requires a name, version and constructor", device.Path())
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.devices[device.Path()]; ok {
+		return fmt.Errorf("%w: %s", ErrDeviceRegistered, device.Path())
+	}
+	r.devices[device.Path()] = device
+	return nil
+}
+
+// RegisterEncoder sets the encoder of a registered device.
+func (r *Registry) RegisterEncoder(path string, newEncoder func() encoder.Encoder) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	device, ok := r.devices[path]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrDeviceNotFound, path)
+	}
+	device.NewEncoder = newEncoder
+	r.devices[path] = device
+	return nil
+}
+
+// Devices returns the registered devices ordered by path.
+func (r *Registry) Devices() []Device {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	devices := make([]Device, 0, len(r.devices))
+	for _, device := range r.devices {
+		devices = append(devices, device)
+	}
+	slices.SortFunc(devices, func(a, b Device) int {
+		return strings.Compare(a.Path(), b.Path())
+	})
+	return devices
+}
+
+// Lookup returns a device by its path like tagsl/v1, or by its name if only
+// one version of the device is registered.
+func (r *Registry) Lookup(device string) (Device, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if d, ok := r.devices[device]; ok {
+		return d, nil
+	}
+
+	var found []Device
+	for _, d := range r.devices {
+		if d.Name == device {
+			found = append(found, d)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return Device{}, fmt.Errorf("%w: %s", ErrDeviceNotFound, device)
+	case 1:
+		return found[0], nil
+	default:
+		return Device{}, fmt.Errorf("device %s has multiple versions, use <device>/<version>", device)
+	}
+}
+
+// New builds the decoder of a device, see Lookup.
+func (r *Registry) New(device string, options DeviceOptions) (Decoder, error) {
+	d, err := r.Lookup(device)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Context == nil {
+		options.Context = context.Background()
+	}
+	if options.Solver == nil {
+		options.Solver = noopSolver{}
+	}
+	if options.Logger == nil {
+		options.Logger = zap.NewNop()
+	}
+	return d.New(options)
+}
+
+// Ports describes the payloads of the ports of a device, see Lookup. Devices
+// whose decoder is no Catalog are described by their port numbers only.
+func (r *Registry) Ports(device string) ([]PortInfo, error) {
+	d, err := r.New(device, DeviceOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if catalog, ok := d.(Catalog); ok {
+		return catalog.Ports(), nil
+	}
+
+	found, err := r.Lookup(device)
+	if err != nil {
+		return nil, err
+	}
+	infos := []PortInfo{}
+	for _, port := range found.Ports {
+		infos = append(infos, PortInfo{Port: port, Features: []Feature{}, Fields: []FieldInfo{}})
+	}
+	return infos, nil
+}
+
+// Clone returns a copy of the registry, e.g. to add devices without changing
+// the DefaultRegistry.
+func (r *Registry) Clone() *Registry {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	clone := NewRegistry()
+	for path, device := range r.devices {
+		clone.devices[path] = device
+	}
+	return clone
+}
+
+// Register adds a device to the DefaultRegistry. It panics if the device is
+// already registered.
+func Register(device Device) {
+	if err := DefaultRegistry.Register(device); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterEncoder sets the encoder of a device of the DefaultRegistry. It panics
+// if the device is not registered.
+func RegisterEncoder(path string, newEncoder func() encoder.Encoder) {
+	if err := DefaultRegistry.RegisterEncoder(path, newEncoder); err != nil {
+		panic(err)
+	}
+}
+
+// Devices returns the devices of the DefaultRegistry.
+func Devices() []Device {
+	return DefaultRegistry.Devices()
+}
+
+// Lookup returns a device of the DefaultRegistry.
+func Lookup(device string) (Device, error) {
+	return DefaultRegistry.Lookup(device)
+}
+
+// New builds the decoder of a device of the DefaultRegistry.
+func New(device string, options DeviceOptions) (Decoder, error) {
+	return DefaultRegistry.New(device, options)
+}
+
+// Ports describes the payloads of the ports of a device of the DefaultRegistry.
+func Ports(device string) ([]PortInfo, error) {
+	return DefaultRegistry.Ports(device)
+}
+
+// JSONSchema returns the JSON Schema of the payloads of a device of the DefaultRegistry.
+func JSONSchema(device string) (map[string]any, error) {
+	return DefaultRegistry.JSONSchema(device)
+}
+
+// noopSolver returns no position, like solver.NoopSolver.
+type noopSolver struct{}
+
+func (noopSolver) Solve(ctx context.Context, payload string) (*DecodedUplink, error) {
+	return NewDecodedUplink([]Feature{}, []any{}), nil
+}
@@ -0,0 +1,154 @@
+package decoder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/encoder"
+)
+
+type testDecoder struct {
+	options DeviceOptions
+}
+
+func (d testDecoder) Decode(ctx context.Context, payload string, port uint8) (*DecodedUplink, error) {
+	return NewDecodedUplink(nil, payload), nil
+}
+
+func (d testDecoder) DecodeBytes(ctx context.Context, payload []byte, port uint8) (*DecodedUplink, error) {
+	return NewDecodedUplink(nil, payload), nil
+}
+
+type testEncoder struct{}
+
+func (testEncoder) Encode(data any, port uint8) (any, error) {
+	return "", nil
+}
+
+func testDevice(name string, version string) Device {
+	return Device{
+		Name:    name,
+		Version: version,
+		Ports:   []uint8{1},
+		New: func(options DeviceOptions) (Decoder, error) {
+			return testDecoder{options: options}, nil
+		},
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+
+	for _, device := range []Device{testDevice("beta", "v1"), testDevice("alpha", "v2"), testDevice("alpha", "v1")} {
+		if err := registry.Register(device); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	err := registry.Register(testDevice("beta", "v1"))
+	if !errors.Is(err, ErrDeviceRegistered) {
+		t.Errorf("expected %v, got %v", ErrDeviceRegistered, err)
+	}
+
+	err = registry.Register(Device{Name: "gamma", Version: "v1"})
+	if err == nil {
+		t.Error("expected error for device without constructor")
+	}
+
+	paths := []string{}
+	for _, device := range registry.Devices() {
+		paths = append(paths, device.Path())
+	}
+	if len(paths) != 3 || paths[0] != "alpha/v1" || paths[1] != "alpha/v2" || paths[2] != "beta/v1" {
+		t.Errorf("expected devices ordered by path, got %v", paths)
+	}
+
+	tests := []struct {
+		device   string
+		expected string
+		err      bool
+	}{
+		{device: "beta", expected: "beta/v1"},
+		{device: "alpha/v2", expected: "alpha/v2"},
+		{device: "alpha", err: true},
+		{device: "gamma", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.device, func(t *testing.T) {
+			device, err := registry.Lookup(test.device)
+			if test.err {
+				if err == nil {
+					t.Errorf("expected error, got %v", device.Path())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if device.Path() != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, device.Path())
+			}
+		})
+	}
+
+	_, err = registry.Lookup("gamma")
+	if !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("expected %v, got %v", ErrDeviceNotFound, err)
+	}
+}
+
+func TestRegistryNew(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register(testDevice("alpha", "v1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d, err := registry.New("alpha", DeviceOptions{Lenient: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	options := d.(testDecoder).options
+	if options.Context == nil || options.Solver == nil || options.Logger == nil {
+		t.Errorf("expected default context, solver and logger, got %+v", options)
+	}
+	if !options.Lenient {
+		t.Error("expected options to be passed to the constructor")
+	}
+
+	_, err = registry.New("beta", DeviceOptions{})
+	if !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("expected %v, got %v", ErrDeviceNotFound, err)
+	}
+}
+
+func TestRegistryEncoder(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register(testDevice("alpha", "v1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	newEncoder := func() encoder.Encoder { return testEncoder{} }
+
+	err := registry.RegisterEncoder("beta/v1", newEncoder)
+	if !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("expected %v, got %v", ErrDeviceNotFound, err)
+	}
+
+	clone := registry.Clone()
+	if err := clone.RegisterEncoder("alpha/v1", newEncoder); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	device, _ := clone.Lookup("alpha/v1")
+	if device.NewEncoder == nil {
+		t.Error("expected encoder to be registered")
+	}
+
+	device, _ = registry.Lookup("alpha/v1")
+	if device.NewEncoder != nil {
+		t.Error("expected clone to leave the registry unchanged")
+	}
+}
@@ -0,0 +1,762 @@
+// Code generated by decodergen. DO NOT EDIT.
+
+package smartlabel
+
+import (
+	"reflect"
+
+	"github.com/truvami/decoder/pkg/common"
+)
+
+func init() {
+	common.RegisterGenerated(reflect.TypeOf(Port1Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "BatteryVoltage", Start: 0, Length: 2, Scale: 0.001},
+			{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Scale: 0.001},
+		},
+		Decode: decodePort1Payload,
+		Encode: encodePort1Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port2Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Temperature", Start: 0, Length: 2, Scale: 0.01},
+			{Name: "Humidity", Start: 2, Length: 1, Scale: 0.5},
+		},
+		Decode: decodePort2Payload,
+		Encode: encodePort2Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port4Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DataRate", Start: 0, Length: 1, BitOffset: 0, BitLength: 3},
+			{Name: "Acceleration", Start: 0, Length: 1, BitOffset: 3, BitLength: 1},
+			{Name: "Wifi", Start: 0, Length: 1, BitOffset: 4, BitLength: 1},
+			{Name: "Gnss", Start: 0, Length: 1, BitOffset: 5, BitLength: 1},
+			{Name: "SteadyInterval", Start: 1, Length: 2},
+			{Name: "MovingInterval", Start: 3, Length: 2},
+			{Name: "HeartbeatInterval", Start: 5, Length: 1},
+			{Name: "AccelerationThreshold", Start: 6, Length: 2},
+			{Name: "AccelerationDelay", Start: 8, Length: 2},
+			{Name: "TemperaturePollingInterval", Start: 10, Length: 2},
+			{Name: "TemperatureUplinkInterval", Start: 12, Length: 2},
+			{Name: "TemperatureUpperThreshold", Start: 14, Length: 1},
+			{Name: "TemperatureLowerThreshold", Start: 15, Length: 1},
+			{Name: "AccessPointsThreshold", Start: 16, Length: 1},
+			{Name: "FirmwareVersionMajor", Start: 17, Length: 1, Optional: true},
+			{Name: "FirmwareVersionMinor", Start: 18, Length: 1, Optional: true},
+			{Name: "FirmwareVersionPatch", Start: 19, Length: 1, Optional: true},
+		},
+		Decode: decodePort4Payload,
+		Encode: encodePort4Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port11Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "BatteryVoltage", Start: 0, Length: 2, Scale: 0.001},
+			{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Scale: 0.001},
+			{Name: "Temperature", Start: 4, Length: 2, Scale: 0.01},
+			{Name: "Humidity", Start: 6, Length: 1, Scale: 0.5},
+		},
+		Decode: decodePort11Payload,
+		Encode: encodePort11Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port150Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Battery100Voltage", Start: 0, Length: 2, Scale: 0.001},
+			{Name: "Battery80Voltage", Start: 2, Length: 2, Scale: 0.001},
+			{Name: "Battery60Voltage", Start: 4, Length: 2, Scale: 0.001},
+			{Name: "Battery40Voltage", Start: 6, Length: 2, Scale: 0.001},
+			{Name: "Battery20Voltage", Start: 8, Length: 2, Scale: 0.001},
+		},
+		Decode: decodePort150Payload,
+		Encode: encodePort150Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port197Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Tag", Start: 0, Length: 1},
+			{Name: "Rssi1", Start: 1, Length: 1},
+			{Name: "Mac1", Start: 2, Length: 6, Hex: true},
+			{Name: "Rssi2", Start: 8, Length: 1, Optional: true},
+			{Name: "Mac2", Start: 9, Length: 6, Optional: true, Hex: true},
+			{Name: "Rssi3", Start: 15, Length: 1, Optional: true},
+			{Name: "Mac3", Start: 16, Length: 6, Optional: true, Hex: true},
+			{Name: "Rssi4", Start: 22, Length: 1, Optional: true},
+			{Name: "Mac4", Start: 23, Length: 6, Optional: true, Hex: true},
+			{Name: "Rssi5", Start: 29, Length: 1, Optional: true},
+			{Name: "Mac5", Start: 30, Length: 6, Optional: true, Hex: true},
+			{Name: "Rssi6", Start: 36, Length: 1, Optional: true},
+			{Name: "Mac6", Start: 37, Length: 6, Optional: true, Hex: true},
+		},
+		Decode: decodePort197Payload,
+		Encode: encodePort197Payload,
+	})
+}
+
+func decodePort1Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port1Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// BatteryVoltage
+	if raw, ok := reader.Read("BatteryVoltage", 0, 2, false); ok {
+		p.BatteryVoltage = float32(common.ScaledValue(raw, false, config.Fields[0].Scale, config.Fields[0].Offset))
+		if err := common.ValidateField("BatteryVoltage", p.BatteryVoltage, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// PhotovoltaicVoltage
+	if raw, ok := reader.Read("PhotovoltaicVoltage", 2, 2, false); ok {
+		p.PhotovoltaicVoltage = float32(common.ScaledValue(raw, false, config.Fields[1].Scale, config.Fields[1].Offset))
+		if err := common.ValidateField("PhotovoltaicVoltage", p.PhotovoltaicVoltage, "gte=0,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort1Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port1Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(4)
+	var bytes []byte
+
+	// BatteryVoltage
+	bytes = common.UnscaledBytes(float64(p.BatteryVoltage), config.Fields[0].Scale, config.Fields[0].Offset, 2)
+	writer.Write(0, 2, bytes)
+
+	// PhotovoltaicVoltage
+	bytes = common.UnscaledBytes(float64(p.PhotovoltaicVoltage), config.Fields[1].Scale, config.Fields[1].Offset, 2)
+	writer.Write(2, 2, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort2Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port2Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Temperature
+	if raw, ok := reader.Read("Temperature", 0, 2, false); ok {
+		p.Temperature = float32(common.ScaledValue(raw, false, config.Fields[0].Scale, config.Fields[0].Offset))
+		if err := common.ValidateField("Temperature", p.Temperature, "gte=-20,lte=60"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Humidity
+	if raw, ok := reader.Read("Humidity", 2, 1, false); ok {
+		p.Humidity = float32(common.ScaledValue(raw, false, config.Fields[1].Scale, config.Fields[1].Offset))
+		if err := common.ValidateField("Humidity", p.Humidity, "gte=5,lte=95"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort2Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port2Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(3)
+	var bytes []byte
+
+	// Temperature
+	bytes = common.UnscaledBytes(float64(p.Temperature), config.Fields[0].Scale, config.Fields[0].Offset, 2)
+	writer.Write(0, 2, bytes)
+
+	// Humidity
+	bytes = common.UnscaledBytes(float64(p.Humidity), config.Fields[1].Scale, config.Fields[1].Offset, 1)
+	writer.Write(2, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort4Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port4Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DataRate
+	if raw, ok := reader.Read("DataRate", 0, 1, false); ok {
+		p.DataRate = common.BytesToUint8(common.ExtractBits(raw, 0, 3))
+		if err := common.ValidateField("DataRate", p.DataRate, "gte=0,lte=7"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Acceleration
+	if raw, ok := reader.Read("Acceleration", 0, 1, false); ok {
+		p.Acceleration = common.ExtractBits(raw, 3, 1)[0]&0x01 == 1
+	}
+
+	// Wifi
+	if raw, ok := reader.Read("Wifi", 0, 1, false); ok {
+		p.Wifi = common.ExtractBits(raw, 4, 1)[0]&0x01 == 1
+	}
+
+	// Gnss
+	if raw, ok := reader.Read("Gnss", 0, 1, false); ok {
+		p.Gnss = common.ExtractBits(raw, 5, 1)[0]&0x01 == 1
+	}
+
+	// SteadyInterval
+	if raw, ok := reader.Read("SteadyInterval", 1, 2, false); ok {
+		p.SteadyInterval = common.BytesToUint16(raw)
+	}
+
+	// MovingInterval
+	if raw, ok := reader.Read("MovingInterval", 3, 2, false); ok {
+		p.MovingInterval = common.BytesToUint16(raw)
+	}
+
+	// HeartbeatInterval
+	if raw, ok := reader.Read("HeartbeatInterval", 5, 1, false); ok {
+		p.HeartbeatInterval = common.BytesToUint8(raw)
+	}
+
+	// AccelerationThreshold
+	if raw, ok := reader.Read("AccelerationThreshold", 6, 2, false); ok {
+		p.AccelerationThreshold = common.BytesToUint16(raw)
+	}
+
+	// AccelerationDelay
+	if raw, ok := reader.Read("AccelerationDelay", 8, 2, false); ok {
+		p.AccelerationDelay = common.BytesToUint16(raw)
+	}
+
+	// TemperaturePollingInterval
+	if raw, ok := reader.Read("TemperaturePollingInterval", 10, 2, false); ok {
+		p.TemperaturePollingInterval = common.BytesToUint16(raw)
+	}
+
+	// TemperatureUplinkInterval
+	if raw, ok := reader.Read("TemperatureUplinkInterval", 12, 2, false); ok {
+		p.TemperatureUplinkInterval = common.BytesToUint16(raw)
+	}
+
+	// TemperatureUpperThreshold
+	if raw, ok := reader.Read("TemperatureUpperThreshold", 14, 1, false); ok {
+		p.TemperatureUpperThreshold = common.BytesToInt8(raw)
+	}
+
+	// TemperatureLowerThreshold
+	if raw, ok := reader.Read("TemperatureLowerThreshold", 15, 1, false); ok {
+		p.TemperatureLowerThreshold = common.BytesToInt8(raw)
+	}
+
+	// AccessPointsThreshold
+	if raw, ok := reader.Read("AccessPointsThreshold", 16, 1, false); ok {
+		p.AccessPointsThreshold = common.BytesToUint8(raw)
+		if err := common.ValidateField("AccessPointsThreshold", p.AccessPointsThreshold, "gte=1,lte=6"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// FirmwareVersionMajor
+	if raw, ok := reader.Read("FirmwareVersionMajor", 17, 1, true); ok {
+		p.FirmwareVersionMajor = common.BytesToUint8(raw)
+	}
+
+	// FirmwareVersionMinor
+	if raw, ok := reader.Read("FirmwareVersionMinor", 18, 1, true); ok {
+		p.FirmwareVersionMinor = common.BytesToUint8(raw)
+	}
+
+	// FirmwareVersionPatch
+	if raw, ok := reader.Read("FirmwareVersionPatch", 19, 1, true); ok {
+		p.FirmwareVersionPatch = common.BytesToUint8(raw)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort4Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port4Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(20)
+	var bytes []byte
+
+	// DataRate
+	bytes = common.UintToBytes(uint64(p.DataRate), 1)
+	writer.WriteBits(0, 1, 0, 3, bytes)
+
+	// Acceleration
+	bytes = common.BoolToBytes(p.Acceleration, 0)
+	writer.WriteBits(0, 1, 3, 1, bytes)
+
+	// Wifi
+	bytes = common.BoolToBytes(p.Wifi, 0)
+	writer.WriteBits(0, 1, 4, 1, bytes)
+
+	// Gnss
+	bytes = common.BoolToBytes(p.Gnss, 0)
+	writer.WriteBits(0, 1, 5, 1, bytes)
+
+	// SteadyInterval
+	bytes = common.UintToBytes(uint64(p.SteadyInterval), 2)
+	writer.Write(1, 2, bytes)
+
+	// MovingInterval
+	bytes = common.UintToBytes(uint64(p.MovingInterval), 2)
+	writer.Write(3, 2, bytes)
+
+	// HeartbeatInterval
+	bytes = common.UintToBytes(uint64(p.HeartbeatInterval), 1)
+	writer.Write(5, 1, bytes)
+
+	// AccelerationThreshold
+	bytes = common.UintToBytes(uint64(p.AccelerationThreshold), 2)
+	writer.Write(6, 2, bytes)
+
+	// AccelerationDelay
+	bytes = common.UintToBytes(uint64(p.AccelerationDelay), 2)
+	writer.Write(8, 2, bytes)
+
+	// TemperaturePollingInterval
+	bytes = common.UintToBytes(uint64(p.TemperaturePollingInterval), 2)
+	writer.Write(10, 2, bytes)
+
+	// TemperatureUplinkInterval
+	bytes = common.UintToBytes(uint64(p.TemperatureUplinkInterval), 2)
+	writer.Write(12, 2, bytes)
+
+	// TemperatureUpperThreshold
+	bytes = common.IntToBytes(int64(p.TemperatureUpperThreshold), 1)
+	writer.Write(14, 1, bytes)
+
+	// TemperatureLowerThreshold
+	bytes = common.IntToBytes(int64(p.TemperatureLowerThreshold), 1)
+	writer.Write(15, 1, bytes)
+
+	// AccessPointsThreshold
+	bytes = common.UintToBytes(uint64(p.AccessPointsThreshold), 1)
+	writer.Write(16, 1, bytes)
+
+	// FirmwareVersionMajor
+	bytes = common.UintToBytes(uint64(p.FirmwareVersionMajor), 1)
+	if p.FirmwareVersionMajor != 0 {
+		writer.Write(17, 1, bytes)
+	}
+
+	// FirmwareVersionMinor
+	bytes = common.UintToBytes(uint64(p.FirmwareVersionMinor), 1)
+	if p.FirmwareVersionMinor != 0 {
+		writer.Write(18, 1, bytes)
+	}
+
+	// FirmwareVersionPatch
+	bytes = common.UintToBytes(uint64(p.FirmwareVersionPatch), 1)
+	if p.FirmwareVersionPatch != 0 {
+		writer.Write(19, 1, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort11Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port11Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// BatteryVoltage
+	if raw, ok := reader.Read("BatteryVoltage", 0, 2, false); ok {
+		p.BatteryVoltage = float32(common.ScaledValue(raw, false, config.Fields[0].Scale, config.Fields[0].Offset))
+		if err := common.ValidateField("BatteryVoltage", p.BatteryVoltage, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// PhotovoltaicVoltage
+	if raw, ok := reader.Read("PhotovoltaicVoltage", 2, 2, false); ok {
+		p.PhotovoltaicVoltage = float32(common.ScaledValue(raw, false, config.Fields[1].Scale, config.Fields[1].Offset))
+		if err := common.ValidateField("PhotovoltaicVoltage", p.PhotovoltaicVoltage, "gte=0,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Temperature
+	if raw, ok := reader.Read("Temperature", 4, 2, false); ok {
+		p.Temperature = float32(common.ScaledValue(raw, false, config.Fields[2].Scale, config.Fields[2].Offset))
+		if err := common.ValidateField("Temperature", p.Temperature, "gte=-20,lte=60"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Humidity
+	if raw, ok := reader.Read("Humidity", 6, 1, false); ok {
+		p.Humidity = float32(common.ScaledValue(raw, false, config.Fields[3].Scale, config.Fields[3].Offset))
+		if err := common.ValidateField("Humidity", p.Humidity, "gte=5,lte=95"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort11Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port11Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(7)
+	var bytes []byte
+
+	// BatteryVoltage
+	bytes = common.UnscaledBytes(float64(p.BatteryVoltage), config.Fields[0].Scale, config.Fields[0].Offset, 2)
+	writer.Write(0, 2, bytes)
+
+	// PhotovoltaicVoltage
+	bytes = common.UnscaledBytes(float64(p.PhotovoltaicVoltage), config.Fields[1].Scale, config.Fields[1].Offset, 2)
+	writer.Write(2, 2, bytes)
+
+	// Temperature
+	bytes = common.UnscaledBytes(float64(p.Temperature), config.Fields[2].Scale, config.Fields[2].Offset, 2)
+	writer.Write(4, 2, bytes)
+
+	// Humidity
+	bytes = common.UnscaledBytes(float64(p.Humidity), config.Fields[3].Scale, config.Fields[3].Offset, 1)
+	writer.Write(6, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort150Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port150Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Battery100Voltage
+	if raw, ok := reader.Read("Battery100Voltage", 0, 2, false); ok {
+		p.Battery100Voltage = float32(common.ScaledValue(raw, false, config.Fields[0].Scale, config.Fields[0].Offset))
+		if err := common.ValidateField("Battery100Voltage", p.Battery100Voltage, "gte=3.6,lte=4.0"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Battery80Voltage
+	if raw, ok := reader.Read("Battery80Voltage", 2, 2, false); ok {
+		p.Battery80Voltage = float32(common.ScaledValue(raw, false, config.Fields[1].Scale, config.Fields[1].Offset))
+		if err := common.ValidateField("Battery80Voltage", p.Battery80Voltage, "gte=3.5,lte=3.7"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Battery60Voltage
+	if raw, ok := reader.Read("Battery60Voltage", 4, 2, false); ok {
+		p.Battery60Voltage = float32(common.ScaledValue(raw, false, config.Fields[2].Scale, config.Fields[2].Offset))
+		if err := common.ValidateField("Battery60Voltage", p.Battery60Voltage, "gte=3.4,lte=3.6"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Battery40Voltage
+	if raw, ok := reader.Read("Battery40Voltage", 6, 2, false); ok {
+		p.Battery40Voltage = float32(common.ScaledValue(raw, false, config.Fields[3].Scale, config.Fields[3].Offset))
+		if err := common.ValidateField("Battery40Voltage", p.Battery40Voltage, "gte=3.1,lte=3.4"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Battery20Voltage
+	if raw, ok := reader.Read("Battery20Voltage", 8, 2, false); ok {
+		p.Battery20Voltage = float32(common.ScaledValue(raw, false, config.Fields[4].Scale, config.Fields[4].Offset))
+		if err := common.ValidateField("Battery20Voltage", p.Battery20Voltage, "gte=2.7,lte=3.0"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort150Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port150Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(10)
+	var bytes []byte
+
+	// Battery100Voltage
+	bytes = common.UnscaledBytes(float64(p.Battery100Voltage), config.Fields[0].Scale, config.Fields[0].Offset, 2)
+	writer.Write(0, 2, bytes)
+
+	// Battery80Voltage
+	bytes = common.UnscaledBytes(float64(p.Battery80Voltage), config.Fields[1].Scale, config.Fields[1].Offset, 2)
+	writer.Write(2, 2, bytes)
+
+	// Battery60Voltage
+	bytes = common.UnscaledBytes(float64(p.Battery60Voltage), config.Fields[2].Scale, config.Fields[2].Offset, 2)
+	writer.Write(4, 2, bytes)
+
+	// Battery40Voltage
+	bytes = common.UnscaledBytes(float64(p.Battery40Voltage), config.Fields[3].Scale, config.Fields[3].Offset, 2)
+	writer.Write(6, 2, bytes)
+
+	// Battery20Voltage
+	bytes = common.UnscaledBytes(float64(p.Battery20Voltage), config.Fields[4].Scale, config.Fields[4].Offset, 2)
+	writer.Write(8, 2, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort197Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port197Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Tag
+	if raw, ok := reader.Read("Tag", 0, 1, false); ok {
+		p.Tag = common.BytesToUint8(raw)
+	}
+
+	// Rssi1
+	if raw, ok := reader.Read("Rssi1", 1, 1, false); ok {
+		p.Rssi1 = common.BytesToInt8(raw)
+		if err := common.ValidateField("Rssi1", p.Rssi1, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Mac1
+	if raw, ok := reader.Read("Mac1", 2, 6, false); ok {
+		p.Mac1 = common.HexValue(raw)
+	}
+
+	// Rssi2
+	if raw, ok := reader.Read("Rssi2", 8, 1, true); ok {
+		{
+			value := common.BytesToInt8(raw)
+			p.Rssi2 = &value
+		}
+		if err := common.ValidateField("Rssi2", p.Rssi2, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Mac2
+	if raw, ok := reader.Read("Mac2", 9, 6, true); ok {
+		{
+			value := common.HexValue(raw)
+			p.Mac2 = &value
+		}
+	}
+
+	// Rssi3
+	if raw, ok := reader.Read("Rssi3", 15, 1, true); ok {
+		{
+			value := common.BytesToInt8(raw)
+			p.Rssi3 = &value
+		}
+		if err := common.ValidateField("Rssi3", p.Rssi3, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Mac3
+	if raw, ok := reader.Read("Mac3", 16, 6, true); ok {
+		{
+			value := common.HexValue(raw)
+			p.Mac3 = &value
+		}
+	}
+
+	// Rssi4
+	if raw, ok := reader.Read("Rssi4", 22, 1, true); ok {
+		{
+			value := common.BytesToInt8(raw)
+			p.Rssi4 = &value
+		}
+		if err := common.ValidateField("Rssi4", p.Rssi4, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Mac4
+	if raw, ok := reader.Read("Mac4", 23, 6, true); ok {
+		{
+			value := common.HexValue(raw)
+			p.Mac4 = &value
+		}
+	}
+
+	// Rssi5
+	if raw, ok := reader.Read("Rssi5", 29, 1, true); ok {
+		{
+			value := common.BytesToInt8(raw)
+			p.Rssi5 = &value
+		}
+		if err := common.ValidateField("Rssi5", p.Rssi5, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Mac5
+	if raw, ok := reader.Read("Mac5", 30, 6, true); ok {
+		{
+			value := common.HexValue(raw)
+			p.Mac5 = &value
+		}
+	}
+
+	// Rssi6
+	if raw, ok := reader.Read("Rssi6", 36, 1, true); ok {
+		{
+			value := common.BytesToInt8(raw)
+			p.Rssi6 = &value
+		}
+		if err := common.ValidateField("Rssi6", p.Rssi6, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Mac6
+	if raw, ok := reader.Read("Mac6", 37, 6, true); ok {
+		{
+			value := common.HexValue(raw)
+			p.Mac6 = &value
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort197Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port197Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(43)
+	var bytes []byte
+	var err error
+
+	// Tag
+	bytes = common.UintToBytes(uint64(p.Tag), 1)
+	writer.Write(0, 1, bytes)
+
+	// Rssi1
+	bytes = common.IntToBytes(int64(p.Rssi1), 1)
+	writer.Write(1, 1, bytes)
+
+	// Mac1
+	bytes, err = common.HexStringToBytes(p.Mac1)
+	if err != nil {
+		return "", err
+	}
+	writer.Write(2, 6, bytes)
+
+	// Rssi2
+	if p.Rssi2 != nil {
+		bytes = common.IntToBytes(int64(*p.Rssi2), 1)
+		if *p.Rssi2 != 0 {
+			writer.Write(8, 1, bytes)
+		}
+	}
+
+	// Mac2
+	if p.Mac2 != nil {
+		bytes, err = common.HexStringToBytes(*p.Mac2)
+		if err != nil {
+			return "", err
+		}
+		if len(*p.Mac2) != 0 {
+			writer.Write(9, 6, bytes)
+		}
+	}
+
+	// Rssi3
+	if p.Rssi3 != nil {
+		bytes = common.IntToBytes(int64(*p.Rssi3), 1)
+		if *p.Rssi3 != 0 {
+			writer.Write(15, 1, bytes)
+		}
+	}
+
+	// Mac3
+	if p.Mac3 != nil {
+		bytes, err = common.HexStringToBytes(*p.Mac3)
+		if err != nil {
+			return "", err
+		}
+		if len(*p.Mac3) != 0 {
+			writer.Write(16, 6, bytes)
+		}
+	}
+
+	// Rssi4
+	if p.Rssi4 != nil {
+		bytes = common.IntToBytes(int64(*p.Rssi4), 1)
+		if *p.Rssi4 != 0 {
+			writer.Write(22, 1, bytes)
+		}
+	}
+
+	// Mac4
+	if p.Mac4 != nil {
+		bytes, err = common.HexStringToBytes(*p.Mac4)
+		if err != nil {
+			return "", err
+		}
+		if len(*p.Mac4) != 0 {
+			writer.Write(23, 6, bytes)
+		}
+	}
+
+	// Rssi5
+	if p.Rssi5 != nil {
+		bytes = common.IntToBytes(int64(*p.Rssi5), 1)
+		if *p.Rssi5 != 0 {
+			writer.Write(29, 1, bytes)
+		}
+	}
+
+	// Mac5
+	if p.Mac5 != nil {
+		bytes, err = common.HexStringToBytes(*p.Mac5)
+		if err != nil {
+			return "", err
+		}
+		if len(*p.Mac5) != 0 {
+			writer.Write(30, 6, bytes)
+		}
+	}
+
+	// Rssi6
+	if p.Rssi6 != nil {
+		bytes = common.IntToBytes(int64(*p.Rssi6), 1)
+		if *p.Rssi6 != 0 {
+			writer.Write(36, 1, bytes)
+		}
+	}
+
+	// Mac6
+	if p.Mac6 != nil {
+		bytes, err = common.HexStringToBytes(*p.Mac6)
+		if err != nil {
+			return "", err
+		}
+		if len(*p.Mac6) != 0 {
+			writer.Write(37, 6, bytes)
+		}
+	}
+
+	return writer.String(), nil
+}
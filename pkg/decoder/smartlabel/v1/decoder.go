@@ -2,6 +2,7 @@ package smartlabel
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"reflect"
 
@@ -11,10 +12,13 @@ import (
 	"go.uber.org/zap"
 )
 
+//go:generate go run github.com/truvami/decoder/internal/decodergen
+
 type Option func(*SmartLabelv1Decoder)
 
 type SmartLabelv1Decoder struct {
 	skipValidation bool
+	lenient        bool
 	logger         *zap.Logger
 
 	solver         solver.SolverV1
@@ -45,6 +49,13 @@ func WithSkipValidation(skipValidation bool) Option {
 	}
 }
 
+// WithLenient decodes truncated payloads as far as possible, see common.PayloadConfig.Lenient.
+func WithLenient(lenient bool) Option {
+	return func(t *SmartLabelv1Decoder) {
+		t.lenient = lenient
+	}
+}
+
 func WithFallbackSolver(fallbackSolver solver.SolverV1) Option {
 	return func(t *SmartLabelv1Decoder) {
 		t.fallbackSolver = fallbackSolver
@@ -52,13 +63,13 @@ func WithFallbackSolver(fallbackSolver solver.SolverV1) Option {
 }
 
 // https://docs.truvami.com/docs/payloads/smartlabel
-func (t SmartLabelv1Decoder) getConfig(port uint8, data string) (common.PayloadConfig, error) {
+func (t SmartLabelv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	switch port {
 	case 1:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "BatteryVoltage", Start: 0, Length: 2, Transform: battery},
-				{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Transform: photovoltaic},
+				{Name: "BatteryVoltage", Start: 0, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Scale: 0.001, Unit: "V"},
 			},
 			TargetType: reflect.TypeOf(Port1Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureBattery, decoder.FeaturePhotovoltaic},
@@ -66,8 +77,8 @@ func (t SmartLabelv1Decoder) getConfig(port uint8, data string) (common.PayloadC
 	case 2:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "Temperature", Start: 0, Length: 2, Transform: temperature},
-				{Name: "Humidity", Start: 2, Length: 1, Transform: humidity},
+				{Name: "Temperature", Start: 0, Length: 2, Scale: 0.01, Unit: "°C"},
+				{Name: "Humidity", Start: 2, Length: 1, Scale: 0.5, Unit: "%"},
 			},
 			TargetType: reflect.TypeOf(Port2Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureTemperature, decoder.FeatureHumidity},
@@ -75,18 +86,10 @@ func (t SmartLabelv1Decoder) getConfig(port uint8, data string) (common.PayloadC
 	case 4:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DataRate", Start: 0, Length: 1, Transform: func(v any) any {
-					return v.([]byte)[0] & 0x7
-				}},
-				{Name: "Acceleration", Start: 0, Length: 1, Transform: func(v any) any {
-					return ((v.([]byte)[0] >> 3) & 0x1) != 0
-				}},
-				{Name: "Wifi", Start: 0, Length: 1, Transform: func(v any) any {
-					return ((v.([]byte)[0] >> 4) & 0x1) != 0
-				}},
-				{Name: "Gnss", Start: 0, Length: 1, Transform: func(v any) any {
-					return ((v.([]byte)[0] >> 5) & 0x1) != 0
-				}},
+				{Name: "DataRate", Start: 0, Length: 1, BitOffset: 0, BitLength: 3},
+				{Name: "Acceleration", Start: 0, Length: 1, BitOffset: 3, BitLength: 1},
+				{Name: "Wifi", Start: 0, Length: 1, BitOffset: 4, BitLength: 1},
+				{Name: "Gnss", Start: 0, Length: 1, BitOffset: 5, BitLength: 1},
 				{Name: "SteadyInterval", Start: 1, Length: 2},
 				{Name: "MovingInterval", Start: 3, Length: 2},
 				{Name: "HeartbeatInterval", Start: 5, Length: 1},
@@ -107,10 +110,10 @@ func (t SmartLabelv1Decoder) getConfig(port uint8, data string) (common.PayloadC
 	case 11:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "BatteryVoltage", Start: 0, Length: 2, Transform: battery},
-				{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Transform: photovoltaic},
-				{Name: "Temperature", Start: 4, Length: 2, Transform: temperature},
-				{Name: "Humidity", Start: 6, Length: 1, Transform: humidity},
+				{Name: "BatteryVoltage", Start: 0, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "Temperature", Start: 4, Length: 2, Scale: 0.01, Unit: "°C"},
+				{Name: "Humidity", Start: 6, Length: 1, Scale: 0.5, Unit: "%"},
 			},
 			TargetType: reflect.TypeOf(Port11Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureBattery, decoder.FeaturePhotovoltaic, decoder.FeatureTemperature, decoder.FeatureHumidity},
@@ -118,21 +121,11 @@ func (t SmartLabelv1Decoder) getConfig(port uint8, data string) (common.PayloadC
 	case 150:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "Battery100Voltage", Start: 0, Length: 2, Transform: func(v any) any {
-					return float32(common.BytesToUint16(v.([]byte))) / 1000
-				}},
-				{Name: "Battery80Voltage", Start: 2, Length: 2, Transform: func(v any) any {
-					return float32(common.BytesToUint16(v.([]byte))) / 1000
-				}},
-				{Name: "Battery60Voltage", Start: 4, Length: 2, Transform: func(v any) any {
-					return float32(common.BytesToUint16(v.([]byte))) / 1000
-				}},
-				{Name: "Battery40Voltage", Start: 6, Length: 2, Transform: func(v any) any {
-					return float32(common.BytesToUint16(v.([]byte))) / 1000
-				}},
-				{Name: "Battery20Voltage", Start: 8, Length: 2, Transform: func(v any) any {
-					return float32(common.BytesToUint16(v.([]byte))) / 1000
-				}},
+				{Name: "Battery100Voltage", Start: 0, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "Battery80Voltage", Start: 2, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "Battery60Voltage", Start: 4, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "Battery40Voltage", Start: 6, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "Battery20Voltage", Start: 8, Length: 2, Scale: 0.001, Unit: "V"},
 			},
 			TargetType: reflect.TypeOf(Port150Payload{}),
 			Features:   []decoder.Feature{},
@@ -162,55 +155,106 @@ func (t SmartLabelv1Decoder) getConfig(port uint8, data string) (common.PayloadC
 	}
 }
 
+var _ decoder.Catalog = &SmartLabelv1Decoder{}
+
+// Ports describes the payloads of the supported ports.
+func (t SmartLabelv1Decoder) Ports() []decoder.PortInfo {
+	infos := []decoder.PortInfo{}
+	for _, port := range supportedPorts {
+		if port == 192 {
+			infos = append(infos, decoder.PortInfo{Port: port, Solver: true, Features: []decoder.Feature{decoder.FeatureGNSS}, Fields: []decoder.FieldInfo{}, Docs: docs})
+			continue
+		}
+
+		config, err := t.getConfig(port)
+		if err != nil {
+			continue
+		}
+		infos = append(infos, common.DescribePort(port, nil, config, docs))
+	}
+	return infos
+}
+
+var _ decoder.Explainer = &SmartLabelv1Decoder{}
+
+// Explain describes how each field of the payload is decoded on the port.
+func (t SmartLabelv1Decoder) Explain(ctx context.Context, payload []byte, port uint8) ([]decoder.FieldExplanation, error) {
+	config, err := t.getConfig(port)
+	if err != nil {
+		return nil, err
+	}
+	return common.Explain(payload, &config)
+}
+
 func (t SmartLabelv1Decoder) Decode(ctx context.Context, data string, port uint8) (*decoder.DecodedUplink, error) {
+	payload, err := decoder.FromHex(data)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "smartlabel/v1", port, nil)
+	}
+	return t.DecodeBytes(ctx, payload, port)
+}
+
+func (t SmartLabelv1Decoder) DecodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	options, err := decoder.OptionsFromContext(ctx, port)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "smartlabel/v1", port, nil)
+	}
+	return t.DecodeWithOptions(ctx, payload, options)
+}
+
+var _ decoder.OptionsDecoder = &SmartLabelv1Decoder{}
+
+// DecodeWithOptions decodes the binary payload on options.Port with the options of the uplink.
+func (t SmartLabelv1Decoder) DecodeWithOptions(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	uplink, err := t.decodeBytes(ctx, payload, options)
+	uplink.SetMetadata("smartlabel/v1", payload, options)
+	return uplink, common.WrapDecodeError(err, "smartlabel/v1", options.Port, payload)
+}
+
+func (t SmartLabelv1Decoder) decodeBytes(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	port := options.Port
 	switch port {
 	case 192:
-		uplink, err := t.solver.Solve(ctx, data)
+		// solvers expect the hex encoded payload
+		data := hex.EncodeToString(payload)
+
+		source := decoder.SolverPrimary
+		uplink, err := solver.SolveV1(ctx, t.solver, data, options)
 		if err != nil {
 			if t.fallbackSolver == nil {
 				smartLabelDecoderSolverFailedCounter.Inc()
 				return nil, common.WrapError(err, common.ErrSolverFailed)
 			}
 
-			uplink, err = t.fallbackSolver.Solve(ctx, data)
+			uplink, err = solver.SolveV1(ctx, t.fallbackSolver, data, options)
 			if err != nil {
 				smartLabelDecoderSolverFailedCounter.Inc()
 				return nil, common.WrapError(err, common.ErrSolverFailed)
 			}
 			smartLabelDecoderSuccessfullyUsedFallbackSolverCounter.Inc()
+			source = decoder.SolverFallback
 		}
 
+		if uplink != nil {
+			uplink.Metadata.Solver = source
+		}
 		return uplink, nil
 	default:
-		config, err := t.getConfig(port, data)
+		config, err := t.getConfig(port)
 		if err != nil {
 			return nil, err
 		}
 
+		config.Lenient = t.lenient
+
 		if !t.skipValidation {
-			err := common.ValidateLength(&data, &config)
+			err := common.ValidateBytesLength(payload, &config)
 			if err != nil {
 				return nil, err
 			}
 		}
 
-		decodedData, err := common.Decode(&data, &config)
+		decodedData, err := common.DecodeBytes(payload, &config)
 		return decoder.NewDecodedUplink(config.Features, decodedData), err
 	}
 }
-
-func battery(v any) any {
-	return float32(common.BytesToUint16(v.([]byte))) / 1000
-}
-
-func photovoltaic(v any) any {
-	return float32(common.BytesToUint16(v.([]byte))) / 1000
-}
-
-func temperature(v any) any {
-	return float32(common.BytesToUint16(v.([]byte))) / 100
-}
-
-func humidity(v any) any {
-	return float32(common.BytesToUint8(v.([]byte))) / 2
-}
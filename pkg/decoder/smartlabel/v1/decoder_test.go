@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -846,3 +847,31 @@ func TestSmartLabelv1Solver(t *testing.T) {
 		}
 	}
 }
+
+func TestDevice(t *testing.T) {
+	device, err := decoder.Lookup("smartlabel/v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d, err := decoder.New("smartlabel/v1", decoder.DeviceOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for port := range 256 {
+		_, err := d.DecodeBytes(context.TODO(), []byte{}, uint8(port))
+		supported := !errors.Is(err, helpers.ErrPortNotSupported)
+		if supported != slices.Contains(device.Ports, uint8(port)) {
+			t.Errorf("port %d: expected supported %v, got %v", port, !supported, supported)
+		}
+	}
+
+	ports := []uint8{}
+	for _, info := range d.(decoder.Catalog).Ports() {
+		ports = append(ports, info.Port)
+	}
+	if ports = slices.Compact(ports); !slices.Equal(ports, device.Ports) {
+		t.Errorf("expected catalog of ports %v, got %v", device.Ports, ports)
+	}
+}
@@ -0,0 +1,31 @@
+package smartlabel
+
+import "github.com/truvami/decoder/pkg/decoder"
+
+const docs = "https://docs.truvami.com/docs/payloads/smartlabel"
+
+// supportedPorts are the uplink ports the decoder supports.
+var supportedPorts = []uint8{1, 2, 4, 11, 150, 192, 197}
+
+func init() {
+	decoder.Register(decoder.Device{
+		Name:        "smartlabel",
+		Version:     "v1",
+		Description: "smart label",
+		Ports:       supportedPorts,
+		Features: []decoder.Feature{
+			decoder.FeatureGNSS,
+			decoder.FeatureBattery,
+			decoder.FeaturePhotovoltaic,
+			decoder.FeatureTemperature,
+			decoder.FeatureHumidity,
+			decoder.FeatureWiFi,
+			decoder.FeatureConfig,
+			decoder.FeatureFirmwareVersion,
+		},
+		Solver: true,
+		New: func(options decoder.DeviceOptions) (decoder.Decoder, error) {
+			return NewSmartLabelv1Decoder(options.Context, options.Solver, options.Logger, WithSkipValidation(options.SkipValidation), WithLenient(options.Lenient)), nil
+		},
+	})
+}
@@ -0,0 +1,249 @@
+package tagsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+var ErrInvalidBleMessage = errors.New("invalid BLE scan message")
+
+// bleScanPrefix prefixes the store keys of the BLE scans, followed by the DevEUI
+// and the scan pointer.
+const bleScanPrefix = "tagsl/v1/ble/"
+
+// BleScan is a BLE scan which the device split across several port 3 uplinks,
+// combined from its messages.
+type BleScan struct {
+	DevEUI        string `json:"devEui"`
+	ScanPointer   uint16 `json:"scanPointer"`
+	TotalMessages uint8  `json:"totalMessages"`
+	// Missing are the messages which did not arrive before the timeout.
+	Missing []uint8 `json:"missing"`
+	// ReceivedAt is the time the first message of the scan was received.
+	ReceivedAt time.Time `json:"receivedAt"`
+	Beacons    []Beacon  `json:"beacons"`
+}
+
+var _ decoder.UplinkFeatureBle = &BleScan{}
+
+func (s BleScan) GetBeacons() []decoder.Beacon {
+	return beacons(s.Beacons)
+}
+
+// Complete reports whether all messages of the scan arrived.
+func (s BleScan) Complete() bool {
+	return len(s.Missing) == 0
+}
+
+type AssemblerOption func(*BleScanAssembler)
+
+// BleScanAssembler collects the port 3 messages of BLE scans by DevEUI and scan
+// pointer. Messages may arrive in any order. Assemblers sharing a store through
+// WithStore rely on FragmentStore.Update to combine the messages atomically.
+type BleScanAssembler struct {
+	store   common.FragmentStore
+	timeout time.Duration
+}
+
+func NewBleScanAssembler(options ...AssemblerOption) *BleScanAssembler {
+	assembler := &BleScanAssembler{
+		store:   common.NewMemoryFragmentStore(),
+		timeout: 5 * time.Minute,
+	}
+
+	for _, option := range options {
+		option(assembler)
+	}
+
+	return assembler
+}
+
+// WithStore keeps the messages in the store instead of in memory, e.g. to
+// share them between several assemblers.
+func WithStore(store common.FragmentStore) AssemblerOption {
+	return func(a *BleScanAssembler) {
+		a.store = store
+	}
+}
+
+// WithTimeout sets how long the assembler waits for the missing messages of a
+// scan after its first message, 5 minutes by default.
+func WithTimeout(timeout time.Duration) AssemblerOption {
+	return func(a *BleScanAssembler) {
+		a.timeout = timeout
+	}
+}
+
+// Add collects the port 3 payload received with the options, which need the
+// DevEUI of the device. It returns the scans the message finished: the combined
+// scan once all its messages arrived and, before it, the incomplete scan with
+// the same scan pointer if that is older than the timeout or had another number
+// of messages. Call Expire to receive incomplete scans without waiting for the
+// next message.
+func (a *BleScanAssembler) Add(ctx context.Context, payload []byte, options decoder.DecodeOptions) ([]BleScan, error) {
+	if options.DevEUI == "" {
+		return nil, fmt.Errorf("%w: BLE scans are assembled by DevEUI", common.ErrMissingDevEUI)
+	}
+
+	message, err := decodeBleMessage(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	if message.TotalMessages == 0 || message.CurrentMessage == 0 || message.CurrentMessage > message.TotalMessages {
+		return nil, fmt.Errorf("%w: message %d of %d", ErrInvalidBleMessage, message.CurrentMessage, message.TotalMessages)
+	}
+
+	receivedAt := time.Now()
+	if options.ReceivedAt != nil {
+		receivedAt = *options.ReceivedAt
+	}
+
+	scans := []BleScan{}
+	key := bleScanKey(options.DevEUI, message.ScanPointer)
+	err = a.store.Update(ctx, key, func(group common.FragmentGroup, ok bool) (*common.FragmentGroup, error) {
+		scans = scans[:0]
+
+		if ok && (group.Total != int(message.TotalMessages) || receivedAt.Sub(group.ReceivedAt) >= a.timeout) {
+			// the scan pointer is reused by a new scan
+			stale, err := combineBleScan(ctx, options.DevEUI, message.ScanPointer, group)
+			if err != nil {
+				return nil, err
+			}
+			scans = append(scans, *stale)
+			ok = false
+		}
+		if !ok {
+			group = common.FragmentGroup{
+				Total:      int(message.TotalMessages),
+				Fragments:  map[int][]byte{},
+				ReceivedAt: receivedAt,
+			}
+		}
+		group.Fragments[int(message.CurrentMessage)] = payload
+
+		if len(group.Fragments) < group.Total {
+			return &group, nil
+		}
+
+		scan, err := combineBleScan(ctx, options.DevEUI, message.ScanPointer, group)
+		if err != nil {
+			return nil, err
+		}
+		scans = append(scans, *scan)
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	countIncomplete(scans)
+	return scans, nil
+}
+
+// Expire removes the scans whose first message arrived at least the timeout
+// before now and returns them with their missing messages.
+func (a *BleScanAssembler) Expire(ctx context.Context, now time.Time) ([]BleScan, error) {
+	keys, err := a.store.Keys(ctx, bleScanPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	scans := []BleScan{}
+	for _, key := range keys {
+		devEui, scanPointer, err := parseBleScanKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		var scan *BleScan
+		err = a.store.Update(ctx, key, func(group common.FragmentGroup, ok bool) (*common.FragmentGroup, error) {
+			scan = nil
+			if !ok {
+				return nil, nil
+			}
+			if now.Sub(group.ReceivedAt) < a.timeout {
+				return &group, nil
+			}
+
+			var err error
+			scan, err = combineBleScan(ctx, devEui, scanPointer, group)
+			return nil, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if scan != nil {
+			scans = append(scans, *scan)
+		}
+	}
+
+	countIncomplete(scans)
+	return scans, nil
+}
+
+// countIncomplete counts the scans with missing messages.
+func countIncomplete(scans []BleScan) {
+	for _, scan := range scans {
+		if !scan.Complete() {
+			tagSlBleScanIncompleteCounter.Inc()
+		}
+	}
+}
+
+func decodeBleMessage(ctx context.Context, payload []byte) (Port3Payload, error) {
+	uplink, err := TagSLv1Decoder{}.decodeBytes(ctx, payload, 3)
+	if err != nil {
+		return Port3Payload{}, common.WrapDecodeError(err, "tagsl/v1", 3, payload)
+	}
+	return uplink.Data.(Port3Payload), nil
+}
+
+// combineBleScan combines the beacons of the messages in the group in order.
+func combineBleScan(ctx context.Context, devEui string, scanPointer uint16, group common.FragmentGroup) (*BleScan, error) {
+	scan := &BleScan{
+		DevEUI:        devEui,
+		ScanPointer:   scanPointer,
+		TotalMessages: uint8(group.Total),
+		Missing:       []uint8{},
+		ReceivedAt:    group.ReceivedAt,
+		Beacons:       []Beacon{},
+	}
+
+	for i := 1; i <= group.Total; i++ {
+		payload, ok := group.Fragments[i]
+		if !ok {
+			scan.Missing = append(scan.Missing, uint8(i))
+			continue
+		}
+
+		message, err := decodeBleMessage(ctx, payload)
+		if err != nil {
+			return nil, err
+		}
+		scan.Beacons = append(scan.Beacons, message.Beacons...)
+	}
+	return scan, nil
+}
+
+func bleScanKey(devEui string, scanPointer uint16) string {
+	return fmt.Sprintf("%s%s/%d", bleScanPrefix, devEui, scanPointer)
+}
+
+func parseBleScanKey(key string) (string, uint16, error) {
+	devEui, scanPointer, ok := strings.Cut(strings.TrimPrefix(key, bleScanPrefix), "/")
+	if !ok {
+		return "", 0, fmt.Errorf("%w: key %s", ErrInvalidBleMessage, key)
+	}
+	pointer, err := strconv.ParseUint(scanPointer, 10, 16)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: key %s", ErrInvalidBleMessage, key)
+	}
+	return devEui, uint16(pointer), nil
+}
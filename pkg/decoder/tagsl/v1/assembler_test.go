@@ -0,0 +1,220 @@
+package tagsl
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+func TestBleScanAssembler(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type message struct {
+		payload string
+		offset  time.Duration
+	}
+
+	tests := []struct {
+		name     string
+		messages []message
+		expected []Beacon
+		stale    []BleScan
+	}{
+		{
+			name:     "single message",
+			messages: []message{{payload: "01eb0101f052fab920feac"}},
+			expected: []Beacon{{Mac: "f052fab920fe", Rssi: -84}},
+		},
+		{
+			name: "in order",
+			messages: []message{
+				{payload: "01eb0201f052fab920feac"},
+				{payload: "01eb0202d0e4158b38b9afe05994cb2f5cb2", offset: time.Second},
+			},
+			expected: []Beacon{{Mac: "f052fab920fe", Rssi: -84}, {Mac: "d0e4158b38b9", Rssi: -81}, {Mac: "e05994cb2f5c", Rssi: -78}},
+		},
+		{
+			name: "out of order",
+			messages: []message{
+				{payload: "01eb0303e05994cb2f5cb2"},
+				{payload: "01eb0301f052fab920feac", offset: time.Second},
+				{payload: "01eb0302d0e4158b38b9af", offset: 2 * time.Second},
+			},
+			expected: []Beacon{{Mac: "f052fab920fe", Rssi: -84}, {Mac: "d0e4158b38b9", Rssi: -81}, {Mac: "e05994cb2f5c", Rssi: -78}},
+		},
+		{
+			name: "restarted after timeout",
+			messages: []message{
+				{payload: "01eb0201f052fab920feac"},
+				{payload: "01eb0201f052fab920feae", offset: 10 * time.Minute},
+				{payload: "01eb0202d0e4158b38b9af", offset: 11 * time.Minute},
+			},
+			expected: []Beacon{{Mac: "f052fab920fe", Rssi: -82}, {Mac: "d0e4158b38b9", Rssi: -81}},
+			stale: []BleScan{{
+				DevEUI:        "0123456789abcdef",
+				ScanPointer:   491,
+				TotalMessages: 2,
+				Missing:       []uint8{2},
+				ReceivedAt:    start,
+				Beacons:       []Beacon{{Mac: "f052fab920fe", Rssi: -84}},
+			}},
+		},
+		{
+			name: "restarted with other total",
+			messages: []message{
+				{payload: "01eb0302d0e4158b38b9af"},
+				{payload: "01eb0201f052fab920feae", offset: time.Second},
+				{payload: "01eb0202d0e4158b38b9af", offset: 2 * time.Second},
+			},
+			expected: []Beacon{{Mac: "f052fab920fe", Rssi: -82}, {Mac: "d0e4158b38b9", Rssi: -81}},
+			stale: []BleScan{{
+				DevEUI:        "0123456789abcdef",
+				ScanPointer:   491,
+				TotalMessages: 3,
+				Missing:       []uint8{1, 3},
+				ReceivedAt:    start,
+				Beacons:       []Beacon{{Mac: "d0e4158b38b9", Rssi: -81}},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assembler := NewBleScanAssembler()
+
+			scans := []BleScan{}
+			for _, message := range test.messages {
+				receivedAt := start.Add(message.offset)
+				payload, _ := decoder.FromHex(message.payload)
+
+				added, err := assembler.Add(context.Background(), payload, decoder.DecodeOptions{DevEUI: "0123456789abcdef", ReceivedAt: &receivedAt})
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				scans = append(scans, added...)
+			}
+
+			if len(scans) != len(test.stale)+1 {
+				t.Fatalf("expected %d scans, got %+v", len(test.stale)+1, scans)
+			}
+			if len(test.stale) != 0 && !reflect.DeepEqual(scans[:len(test.stale)], test.stale) {
+				t.Errorf("expected stale scans %+v, got %+v", test.stale, scans[:len(test.stale)])
+			}
+
+			scan := scans[len(scans)-1]
+			if !scan.Complete() || scan.DevEUI != "0123456789abcdef" || scan.ScanPointer != 491 {
+				t.Errorf("unexpected scan %+v", scan)
+			}
+			if !reflect.DeepEqual(scan.Beacons, test.expected) {
+				t.Errorf("expected beacons %+v, got %+v", test.expected, scan.Beacons)
+			}
+			if len(scan.GetBeacons()) != len(test.expected) {
+				t.Errorf("expected %d beacons, got %d", len(test.expected), len(scan.GetBeacons()))
+			}
+		})
+	}
+}
+
+func TestBleScanAssemblerExpire(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := common.NewMemoryFragmentStore()
+	assembler := NewBleScanAssembler(WithStore(store), WithTimeout(time.Minute))
+
+	messages := []struct {
+		devEui  string
+		payload string
+	}{
+		{devEui: "0123456789abcdef", payload: "01eb0302d0e4158b38b9af"},
+		{devEui: "fedcba9876543210", payload: "822f0201f052fab920feac"},
+	}
+	for i, message := range messages {
+		receivedAt := start.Add(time.Duration(i) * 30 * time.Second)
+		payload, _ := decoder.FromHex(message.payload)
+		scans, err := assembler.Add(context.Background(), payload, decoder.DecodeOptions{DevEUI: message.devEui, ReceivedAt: &receivedAt})
+		if err != nil || len(scans) != 0 {
+			t.Fatalf("expected no scan and no error, got %+v %v", scans, err)
+		}
+	}
+
+	scans, err := assembler.Expire(context.Background(), start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []BleScan{{
+		DevEUI:        "0123456789abcdef",
+		ScanPointer:   491,
+		TotalMessages: 3,
+		Missing:       []uint8{1, 3},
+		ReceivedAt:    start,
+		Beacons:       []Beacon{{Mac: "d0e4158b38b9", Rssi: -81}},
+	}}
+	if !reflect.DeepEqual(scans, expected) {
+		t.Errorf("expected %+v, got %+v", expected, scans)
+	}
+
+	keys, _ := store.Keys(context.Background(), "")
+	if !reflect.DeepEqual(keys, []string{"tagsl/v1/ble/fedcba9876543210/33327"}) {
+		t.Errorf("unexpected keys %v", keys)
+	}
+}
+
+func TestBleScanAssemblerErrors(t *testing.T) {
+	tests := []struct {
+		devEui   string
+		payload  string
+		expected error
+	}{
+		{devEui: "0123456789abcdef", payload: "01eb0203f052fab920feac", expected: ErrInvalidBleMessage},
+		{devEui: "0123456789abcdef", payload: "01eb0000f052fab920feac", expected: ErrInvalidBleMessage},
+		{devEui: "0123456789abcdef", payload: "01eb02", expected: common.ErrPayloadTooShort},
+		{devEui: "", payload: "01eb0101f052fab920feac", expected: common.ErrMissingDevEUI},
+	}
+
+	for _, test := range tests {
+		t.Run(test.payload, func(t *testing.T) {
+			payload, _ := decoder.FromHex(test.payload)
+			_, err := NewBleScanAssembler().Add(context.Background(), payload, decoder.DecodeOptions{DevEUI: test.devEui})
+			if !errors.Is(err, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestBleScanAssemblerSharedStore(t *testing.T) {
+	store := common.NewMemoryFragmentStore()
+	payloads := []string{"01eb0301f052fab920feac", "01eb0302d0e4158b38b9af", "01eb0303e05994cb2f5cb2"}
+
+	var wg sync.WaitGroup
+	results := make(chan []BleScan, len(payloads))
+	for _, hex := range payloads {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			payload, _ := decoder.FromHex(hex)
+			// every message is collected by another assembler sharing the store
+			scans, err := NewBleScanAssembler(WithStore(store)).Add(context.Background(), payload, decoder.DecodeOptions{DevEUI: "0123456789abcdef"})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			results <- scans
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	scans := []BleScan{}
+	for result := range results {
+		scans = append(scans, result...)
+	}
+	if len(scans) != 1 || !scans[0].Complete() || len(scans[0].Beacons) != 3 {
+		t.Errorf("expected one complete scan, got %+v", scans)
+	}
+}
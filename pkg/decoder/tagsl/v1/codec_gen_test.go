@@ -0,0 +1,169 @@
+package tagsl
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+var generatedTests = []struct {
+	port    uint8
+	payload string
+}{
+	{port: 1, payload: "8002cdcd1300744f5e166018040b14341a"},
+	{port: 1, payload: "adfe019c3cfbc9ac0c14500a0c0e160a3b"},
+	{port: 2, payload: "b9"},
+	{port: 3, payload: "822f0101f052fab920feafd0e4158b38b9afe05994cb2f5cb2"},
+	{port: 4, payload: "0000003c0000012c000151800078012c05dc02020100010200005460"},
+	{port: 4, payload: "0000003c0000012c000151800078012c05dc02020100010200005460000a1000"},
+	{port: 5, payload: "00e0286d8aabfca8e0286d8a9478c2726c9a74b58dab726cdac8b89dacf0b0140c96bbc8"},
+	{port: 6, payload: "01"},
+	{port: 7, payload: "66ec04bb80e0286d8aabfcbbec6c9a74b58fb2726c9a74b58db1e0286d8a9478cbf0b0140c96bbd2260122180d42ad"},
+	{port: 8, payload: "012c141e9c455738304543434343460078012c01a8c0"},
+	{port: 10, payload: "0002d30b070082491f11256718d9fe0ede190505"},
+	{port: 15, payload: "000ee5"},
+	{port: 50, payload: "0002d30c9300824c87117966c45dcd0f8118e0286d8aabfca9f0b0140c96bbc8726c9a74b58da8e0286d8a9478bf"},
+	{port: 105, payload: "00020002d309ae008247c5113966c45d640f7e"},
+	{port: 150, payload: "00000102d30a98008248b611ac66c45ed80f6b1be0286d0a6f42a3000000000044a4e0286d8a9478bff0b0140c96bbc9"},
+	// rssi out of range
+	{port: 5, payload: "00e0286d8aabfc00"},
+}
+
+func TestGeneratedCodec(t *testing.T) {
+	for _, test := range generatedTests {
+		t.Run(fmt.Sprintf("TestPort%vWith%v", test.port, test.payload), func(t *testing.T) {
+			config, err := TagSLv1Decoder{}.getConfig(test.port)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !common.HasGenerated(&config) {
+				t.Fatalf("expected generated codec for port %v", test.port)
+			}
+
+			reflectConfig := config
+			reflectConfig.Features = append([]decoder.Feature{}, config.Features...)
+
+			got, gotErr := common.Decode(&test.payload, &config)
+			expected, expectedErr := common.DecodeReflect(&test.payload, &reflectConfig)
+
+			if fmt.Sprint(gotErr) != fmt.Sprint(expectedErr) {
+				t.Errorf("expected error %v, got %v", expectedErr, gotErr)
+			}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected: %v\ngot: %v", expected, got)
+			}
+			if !reflect.DeepEqual(config.Features, reflectConfig.Features) {
+				t.Errorf("expected features %v, got %v", reflectConfig.Features, config.Features)
+			}
+		})
+	}
+}
+
+func TestGeneratedCodecLenient(t *testing.T) {
+	for _, test := range generatedTests {
+		t.Run(fmt.Sprintf("TestPort%vWith%v", test.port, test.payload), func(t *testing.T) {
+			config, err := TagSLv1Decoder{}.getConfig(test.port)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			config.Lenient = true
+
+			// drop the second half of the payload
+			payload := test.payload[:len(test.payload)/4*2]
+
+			reflectConfig := config
+			reflectConfig.Features = append([]decoder.Feature{}, config.Features...)
+
+			got, gotErr := common.Decode(&payload, &config)
+			expected, expectedErr := common.DecodeReflect(&payload, &reflectConfig)
+
+			if fmt.Sprint(gotErr) != fmt.Sprint(expectedErr) {
+				t.Errorf("expected error %v, got %v", expectedErr, gotErr)
+			}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected: %v\ngot: %v", expected, got)
+			}
+			if !reflect.DeepEqual(config.Features, reflectConfig.Features) {
+				t.Errorf("expected features %v, got %v", reflectConfig.Features, config.Features)
+			}
+		})
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	for _, test := range generatedTests[:len(generatedTests)-1] {
+		config, err := TagSLv1Decoder{}.getConfig(test.port)
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+
+		b.Run(fmt.Sprintf("Port%v/Generated", test.port), func(b *testing.B) {
+			for b.Loop() {
+				_, _ = common.Decode(&test.payload, &config)
+			}
+		})
+		b.Run(fmt.Sprintf("Port%v/Reflect", test.port), func(b *testing.B) {
+			for b.Loop() {
+				_, _ = common.DecodeReflect(&test.payload, &config)
+			}
+		})
+	}
+}
+
+// TestDecodePort150Allocs bounds the allocations of BenchmarkDecodePort150, so
+// validating without the cached validation plan, which took 2704 allocations
+// per decode, fails the test.
+func TestDecodePort150Allocs(t *testing.T) {
+	payload := "00000102d30a98008248b611ac66c45ed80f6b1be0286d0a6f42a3000000000044a4e0286d8a9478bff0b0140c96bbc9"
+	config, err := TagSLv1Decoder{}.getConfig(150)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		decode func(*string, *common.PayloadConfig) (any, error)
+		max    float64
+	}{
+		{name: "Generated", decode: common.Decode, max: 60},
+		{name: "Reflect", decode: common.DecodeReflect, max: 150},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				_, _ = test.decode(&payload, &config)
+			})
+			if allocs > test.max {
+				t.Errorf("expected at most %v allocations, got %v", test.max, allocs)
+			}
+		})
+	}
+}
+
+// BenchmarkDecodePort150 reports the allocations of a Wi-Fi payload, which has
+// the most validated fields of all ports.
+func BenchmarkDecodePort150(b *testing.B) {
+	payload := "00000102d30a98008248b611ac66c45ed80f6b1be0286d0a6f42a3000000000044a4e0286d8a9478bff0b0140c96bbc9"
+	config, err := TagSLv1Decoder{}.getConfig(150)
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+
+	b.Run("Generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_, _ = common.Decode(&payload, &config)
+		}
+	})
+	b.Run("Reflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_, _ = common.DecodeReflect(&payload, &config)
+		}
+	})
+}
@@ -11,10 +11,13 @@ import (
 	"github.com/truvami/decoder/pkg/decoder"
 )
 
+//go:generate go run github.com/truvami/decoder/internal/decodergen
+
 type Option func(*TagSLv1Decoder)
 
 type TagSLv1Decoder struct {
 	skipValidation bool
+	lenient        bool
 }
 
 func NewTagSLv1Decoder(options ...Option) decoder.Decoder {
@@ -33,6 +36,13 @@ func WithSkipValidation(skipValidation bool) Option {
 	}
 }
 
+// WithLenient decodes truncated payloads as far as possible, see common.PayloadConfig.Lenient.
+func WithLenient(lenient bool) Option {
+	return func(t *TagSLv1Decoder) {
+		t.lenient = lenient
+	}
+}
+
 // https://docs.truvami.com/docs/payloads/tag-S
 // https://docs.truvami.com/docs/payloads/tag-L
 func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
@@ -40,13 +50,13 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 1:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DutyCycle", Start: 0, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 0, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 0, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Year", Start: 11, Length: 1},
 				{Name: "Month", Start: 12, Length: 1},
 				{Name: "Day", Start: 13, Length: 1},
@@ -60,10 +70,10 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 2:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DutyCycle", Start: 0, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 0, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 0, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
 			},
 			TargetType: reflect.TypeOf(Port2Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureMoving},
@@ -74,18 +84,10 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 				{Name: "ScanPointer", Start: 0, Length: 2},
 				{Name: "TotalMessages", Start: 2, Length: 1},
 				{Name: "CurrentMessage", Start: 3, Length: 1},
-				{Name: "Mac1", Start: 4, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 10, Length: 1},
-				{Name: "Mac2", Start: 11, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi2", Start: 17, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 18, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi3", Start: 24, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 25, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi4", Start: 31, Length: 1, Optional: true},
-				{Name: "Mac5", Start: 32, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi5", Start: 38, Length: 1, Optional: true},
-				{Name: "Mac6", Start: 39, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi6", Start: 45, Length: 1, Optional: true},
+				{Name: "Beacons", Start: 4, Length: 7, MaxCount: 6, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(Port3Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureBle},
@@ -115,24 +117,14 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 5:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DutyCycle", Start: 0, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 0, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 0, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Mac1", Start: 1, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 7, Length: 1},
-				{Name: "Mac2", Start: 8, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi2", Start: 14, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 15, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi3", Start: 21, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 22, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi4", Start: 28, Length: 1, Optional: true},
-				{Name: "Mac5", Start: 29, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi5", Start: 35, Length: 1, Optional: true},
-				{Name: "Mac6", Start: 36, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi6", Start: 42, Length: 1, Optional: true},
-				{Name: "Mac7", Start: 43, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi7", Start: 49, Length: 1, Optional: true},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "AccessPoints", Start: 1, Length: 7, MaxCount: 7, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(Port5Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureMoving, decoder.FeatureWiFi},
@@ -149,22 +141,14 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
 				{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
-				{Name: "DutyCycle", Start: 4, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 4, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 4, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 4, Length: 1, Transform: moving},
-				{Name: "Mac1", Start: 5, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 11, Length: 1},
-				{Name: "Mac2", Start: 12, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi2", Start: 18, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 19, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi3", Start: 25, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 26, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi4", Start: 32, Length: 1, Optional: true},
-				{Name: "Mac5", Start: 33, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi5", Start: 39, Length: 1, Optional: true},
-				{Name: "Mac6", Start: 40, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi6", Start: 46, Length: 1, Optional: true},
+				{Name: "DutyCycle", Start: 4, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 4, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 4, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 4, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 6, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(Port7Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureTimestamp, decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureMoving, decoder.FeatureWiFi},
@@ -188,17 +172,17 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 10:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DutyCycle", Start: 0, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 0, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 0, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 11, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 15, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 17, Length: 1, Optional: true, Transform: ttf},
-				{Name: "PDOP", Start: 18, Length: 1, Optional: true, Transform: pdop},
+				{Name: "Battery", Start: 15, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 17, Length: 1, Optional: true, Scale: 1, Unit: "s"},
+				{Name: "PDOP", Start: 18, Length: 1, Optional: true, Scale: 0.5},
 				{Name: "Satellites", Start: 19, Length: 1, Optional: true},
 			},
 			TargetType: reflect.TypeOf(Port10Payload{}),
@@ -207,11 +191,11 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 15:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DutyCycle", Start: 0, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 0, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 0, Length: 1, Transform: configSuccess},
-				{Name: "LowBattery", Start: 0, Length: 1, Transform: lowBattery},
-				{Name: "Battery", Start: 1, Length: 2, Transform: battery},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "LowBattery", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Battery", Start: 1, Length: 2, Scale: 0.001, Unit: "V"},
 			},
 			TargetType: reflect.TypeOf(Port15Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureBattery},
@@ -219,24 +203,20 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 50:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DutyCycle", Start: 0, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 0, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 0, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 11, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 15, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 17, Length: 1, Transform: ttf},
-				{Name: "Mac1", Start: 18, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 24, Length: 1},
-				{Name: "Mac2", Start: 25, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi2", Start: 31, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 32, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi3", Start: 38, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 39, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi4", Start: 45, Length: 1, Optional: true},
+				{Name: "Battery", Start: 15, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 17, Length: 1, Scale: 1, Unit: "s"},
+				{Name: "AccessPoints", Start: 18, Length: 7, MaxCount: 4, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(Port50Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureMoving, decoder.FeatureGNSS, decoder.FeatureTimestamp, decoder.FeatureBattery, decoder.FeatureWiFi},
@@ -244,26 +224,22 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 51:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DutyCycle", Start: 0, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 0, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 0, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 11, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 15, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 17, Length: 1, Transform: ttf},
-				{Name: "PDOP", Start: 18, Length: 1, Transform: pdop},
+				{Name: "Battery", Start: 15, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 17, Length: 1, Scale: 1, Unit: "s"},
+				{Name: "PDOP", Start: 18, Length: 1, Scale: 0.5},
 				{Name: "Satellites", Start: 19, Length: 1},
-				{Name: "Mac1", Start: 20, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 26, Length: 1},
-				{Name: "Mac2", Start: 27, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi2", Start: 33, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 34, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi3", Start: 40, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 41, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi4", Start: 47, Length: 1, Optional: true},
+				{Name: "AccessPoints", Start: 20, Length: 7, MaxCount: 4, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(Port51Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureMoving, decoder.FeatureGNSS, decoder.FeatureTimestamp, decoder.FeatureBattery, decoder.FeatureWiFi},
@@ -273,22 +249,14 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 			Fields: []common.FieldConfig{
 				{Name: "BufferLevel", Start: 0, Length: 2},
 				{Name: "Timestamp", Start: 2, Length: 4, Transform: timestamp},
-				{Name: "DutyCycle", Start: 6, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 6, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 6, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 6, Length: 1, Transform: moving},
-				{Name: "Mac1", Start: 7, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 13, Length: 1},
-				{Name: "Mac2", Start: 14, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi2", Start: 20, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 21, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi3", Start: 27, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 28, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi4", Start: 34, Length: 1, Optional: true},
-				{Name: "Mac5", Start: 35, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi5", Start: 41, Length: 1, Optional: true},
-				{Name: "Mac6", Start: 42, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi6", Start: 48, Length: 1, Optional: true},
+				{Name: "DutyCycle", Start: 6, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 6, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 6, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 6, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "AccessPoints", Start: 7, Length: 7, MaxCount: 6, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(Port105Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureBuffered, decoder.FeatureTimestamp, decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureMoving, decoder.FeatureWiFi},
@@ -297,17 +265,17 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
 				{Name: "BufferLevel", Start: 0, Length: 2},
-				{Name: "DutyCycle", Start: 2, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 2, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 2, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 2, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 3, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 7, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 11, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 2, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 2, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 2, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 2, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 3, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 7, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 11, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 13, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 17, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 19, Length: 1, Optional: true, Transform: ttf},
-				{Name: "PDOP", Start: 20, Length: 1, Optional: true, Transform: pdop},
+				{Name: "Battery", Start: 17, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 19, Length: 1, Optional: true, Scale: 1, Unit: "s"},
+				{Name: "PDOP", Start: 20, Length: 1, Optional: true, Scale: 0.5},
 				{Name: "Satellites", Start: 21, Length: 1, Optional: true},
 			},
 			TargetType: reflect.TypeOf(Port110Payload{}),
@@ -317,28 +285,20 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
 				{Name: "BufferLevel", Start: 0, Length: 2},
-				{Name: "DutyCycle", Start: 2, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 2, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 2, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 2, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 3, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 7, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 11, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 2, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 2, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 2, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 2, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 3, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 7, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 11, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 13, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 17, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 19, Length: 1, Transform: ttf},
-				{Name: "Mac1", Start: 20, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 26, Length: 1},
-				{Name: "Mac2", Start: 27, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi2", Start: 33, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 34, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi3", Start: 40, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 41, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi4", Start: 47, Length: 1, Optional: true},
-				{Name: "Mac5", Start: 48, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi5", Start: 54, Length: 1, Optional: true},
-				{Name: "Mac6", Start: 55, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi6", Start: 61, Length: 1, Optional: true},
+				{Name: "Battery", Start: 17, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 19, Length: 1, Scale: 1, Unit: "s"},
+				{Name: "AccessPoints", Start: 20, Length: 7, MaxCount: 6, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(Port150Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureBuffered, decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureMoving, decoder.FeatureGNSS, decoder.FeatureTimestamp, decoder.FeatureBattery, decoder.FeatureWiFi},
@@ -347,26 +307,22 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
 				{Name: "BufferLevel", Start: 0, Length: 2},
-				{Name: "DutyCycle", Start: 2, Length: 1, Transform: dutyCycle},
-				{Name: "ConfigId", Start: 2, Length: 1, Transform: configId},
-				{Name: "ConfigChange", Start: 2, Length: 1, Transform: configSuccess},
-				{Name: "Moving", Start: 2, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 3, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 7, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 11, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 2, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 2, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 2, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 2, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 3, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 7, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 11, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 13, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 17, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 19, Length: 1, Transform: ttf},
-				{Name: "PDOP", Start: 20, Length: 1, Transform: pdop},
+				{Name: "Battery", Start: 17, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 19, Length: 1, Scale: 1, Unit: "s"},
+				{Name: "PDOP", Start: 20, Length: 1, Scale: 0.5},
 				{Name: "Satellites", Start: 21, Length: 1},
-				{Name: "Mac1", Start: 22, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 28, Length: 1},
-				{Name: "Mac2", Start: 29, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi2", Start: 35, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 36, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi3", Start: 42, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 43, Length: 6, Optional: true, Hex: true},
-				{Name: "Rssi4", Start: 49, Length: 1, Optional: true},
+				{Name: "AccessPoints", Start: 22, Length: 7, MaxCount: 4, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(Port151Payload{}),
 			Features:   []decoder.Feature{decoder.FeatureBuffered, decoder.FeatureDutyCycle, decoder.FeatureConfigChange, decoder.FeatureMoving, decoder.FeatureGNSS, decoder.FeatureTimestamp, decoder.FeatureBattery, decoder.FeatureWiFi},
@@ -404,69 +360,78 @@ func (t TagSLv1Decoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	return common.PayloadConfig{}, fmt.Errorf("%w: port %v not supported", common.ErrPortNotSupported, port)
 }
 
-func (t TagSLv1Decoder) Decode(ctx context.Context, data string, port uint8) (*decoder.DecodedUplink, error) {
-	config, err := t.getConfig(port)
-	if err != nil {
-		return nil, err
-	}
+var _ decoder.Catalog = &TagSLv1Decoder{}
 
-	if !t.skipValidation {
-		err := common.ValidateLength(&data, &config)
+// Ports describes the payloads of the supported ports.
+func (t TagSLv1Decoder) Ports() []decoder.PortInfo {
+	infos := []decoder.PortInfo{}
+	for _, port := range supportedPorts {
+		config, err := t.getConfig(port)
 		if err != nil {
-			return nil, err
+			continue
 		}
+		infos = append(infos, common.DescribePort(port, nil, config, docs))
 	}
-
-	decodedData, err := common.Decode(&data, &config)
-	return decoder.NewDecodedUplink(config.Features, decodedData), err
-}
-
-func dutyCycle(v any) any {
-	return ((v.([]byte))[0]>>7)&0x01 == 1
+	return infos
 }
 
-func configId(v any) any {
-	return ((v.([]byte))[0] >> 3) & 0x0f
-}
+var _ decoder.Explainer = &TagSLv1Decoder{}
 
-func configSuccess(v any) any {
-	return ((v.([]byte))[0]>>2)&0x01 == 1
+// Explain describes how each field of the payload is decoded on the port.
+func (t TagSLv1Decoder) Explain(ctx context.Context, payload []byte, port uint8) ([]decoder.FieldExplanation, error) {
+	config, err := t.getConfig(port)
+	if err != nil {
+		return nil, err
+	}
+	return common.Explain(payload, &config)
 }
 
-func moving(v any) any {
-	return (v.([]byte))[0]&0x01 == 1
+func (t TagSLv1Decoder) Decode(ctx context.Context, data string, port uint8) (*decoder.DecodedUplink, error) {
+	payload, err := decoder.FromHex(data)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "tagsl/v1", port, nil)
+	}
+	return t.DecodeBytes(ctx, payload, port)
 }
 
-func lowBattery(v any) any {
-	return (v.([]byte))[0]&0x01 == 1
+func (t TagSLv1Decoder) DecodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	options, err := decoder.OptionsFromContext(ctx, port)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "tagsl/v1", port, nil)
+	}
+	return t.DecodeWithOptions(ctx, payload, options)
 }
 
-func latitude(v any) any {
-	return float64(common.BytesToInt32(v.([]byte))) / 1000000
-}
+var _ decoder.OptionsDecoder = &TagSLv1Decoder{}
 
-func longitude(v any) any {
-	return float64(common.BytesToInt32(v.([]byte))) / 1000000
+// DecodeWithOptions decodes the binary payload on options.Port with the options of the uplink.
+func (t TagSLv1Decoder) DecodeWithOptions(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	uplink, err := t.decodeBytes(ctx, payload, options.Port)
+	uplink.SetMetadata("tagsl/v1", payload, options)
+	return uplink, common.WrapDecodeError(err, "tagsl/v1", options.Port, payload)
 }
 
-func altitude(v any) any {
-	return float64(common.BytesToUint16(v.([]byte))) / 10
-}
+func (t TagSLv1Decoder) decodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	config, err := t.getConfig(port)
+	if err != nil {
+		return nil, err
+	}
 
-func timestamp(v any) any {
-	return time.Unix(int64(common.BytesToUint32(v.([]byte))), 0).UTC()
-}
+	config.Lenient = t.lenient
 
-func battery(v any) any {
-	return float64(common.BytesToUint16(v.([]byte))) / 1000
-}
+	if !t.skipValidation {
+		err := common.ValidateBytesLength(payload, &config)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-func ttf(v any) any {
-	return time.Duration(int64(common.BytesToUint8(v.([]byte)))) * time.Second
+	decodedData, err := common.DecodeBytes(payload, &config)
+	return decoder.NewDecodedUplink(config.Features, decodedData), err
 }
 
-func pdop(v any) any {
-	return float64(common.BytesToUint8(v.([]byte))) / 2
+func timestamp(v any) any {
+	return time.Unix(int64(common.BytesToUint32(v.([]byte))), 0).UTC()
 }
 
 func stacktrace(v []byte, i int) any {
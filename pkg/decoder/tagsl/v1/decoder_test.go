@@ -2,10 +2,12 @@ package tagsl
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -183,8 +185,9 @@ func TestDecode(t *testing.T) {
 				ScanPointer:    491,
 				TotalMessages:  1,
 				CurrentMessage: 1,
-				Mac1:           "f052fab920fe",
-				Rssi1:          -84,
+				Beacons: []Beacon{
+					{Mac: "f052fab920fe", Rssi: -84},
+				},
 			},
 		},
 		{
@@ -194,8 +197,9 @@ func TestDecode(t *testing.T) {
 				ScanPointer:    491,
 				TotalMessages:  1,
 				CurrentMessage: 1,
-				Mac1:           "f052fab920fe",
-				Rssi1:          -82,
+				Beacons: []Beacon{
+					{Mac: "f052fab920fe", Rssi: -82},
+				},
 			},
 		},
 		{
@@ -205,12 +209,11 @@ func TestDecode(t *testing.T) {
 				ScanPointer:    33327,
 				TotalMessages:  1,
 				CurrentMessage: 1,
-				Mac1:           "f052fab920fe",
-				Rssi1:          -81,
-				Mac2:           helpers.StringPtr("d0e4158b38b9"),
-				Rssi2:          helpers.Int8Ptr(-81),
-				Mac3:           helpers.StringPtr("e05994cb2f5c"),
-				Rssi3:          helpers.Int8Ptr(-78),
+				Beacons: []Beacon{
+					{Mac: "f052fab920fe", Rssi: -81},
+					{Mac: "d0e4158b38b9", Rssi: -81},
+					{Mac: "e05994cb2f5c", Rssi: -78},
+				},
 			},
 		},
 		{
@@ -220,12 +223,11 @@ func TestDecode(t *testing.T) {
 				ScanPointer:    491,
 				TotalMessages:  1,
 				CurrentMessage: 1,
-				Mac1:           "f052fab920fe",
-				Rssi1:          -83,
-				Mac2:           helpers.StringPtr("d0e4158b38b9"),
-				Rssi2:          helpers.Int8Ptr(-81),
-				Mac3:           helpers.StringPtr("e05994cb2f5c"),
-				Rssi3:          helpers.Int8Ptr(-83),
+				Beacons: []Beacon{
+					{Mac: "f052fab920fe", Rssi: -83},
+					{Mac: "d0e4158b38b9", Rssi: -81},
+					{Mac: "e05994cb2f5c", Rssi: -83},
+				},
 			},
 		},
 		{
@@ -313,8 +315,9 @@ func TestDecode(t *testing.T) {
 			expected: Port5Payload{
 				Moving:    false,
 				DutyCycle: true,
-				Mac1:      "8c59c3c99fc0",
-				Rssi1:     -83,
+				AccessPoints: []AccessPoint{
+					{Mac: "8c59c3c99fc0", Rssi: -83},
+				},
 			},
 		},
 		{
@@ -323,8 +326,9 @@ func TestDecode(t *testing.T) {
 			expected: Port5Payload{
 				Moving:    false,
 				DutyCycle: true,
-				Mac1:      "e0286d8a2742",
-				Rssi1:     -95,
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8a2742", Rssi: -95},
+				},
 			},
 		},
 		{
@@ -333,12 +337,11 @@ func TestDecode(t *testing.T) {
 			expected: Port5Payload{
 				Moving:    false,
 				DutyCycle: false,
-				Mac1:      "1f3fd57cecb4",
-				Rssi1:     -55,
-				Mac2:      helpers.StringPtr("b0140c96bbb2"),
-				Rssi2:     helpers.Int8Ptr(-67),
-				Mac3:      helpers.StringPtr("286d8a9478b8"),
-				Rssi3:     helpers.Int8Ptr(-83),
+				AccessPoints: []AccessPoint{
+					{Mac: "1f3fd57cecb4", Rssi: -55},
+					{Mac: "b0140c96bbb2", Rssi: -67},
+					{Mac: "286d8a9478b8", Rssi: -83},
+				},
 			},
 		},
 		{
@@ -347,16 +350,13 @@ func TestDecode(t *testing.T) {
 			expected: Port5Payload{
 				Moving:    false,
 				DutyCycle: false,
-				Mac1:      "e0286d8aabfc",
-				Rssi1:     -88,
-				Mac2:      helpers.StringPtr("e0286d8a9478"),
-				Rssi2:     helpers.Int8Ptr(-62),
-				Mac3:      helpers.StringPtr("726c9a74b58d"),
-				Rssi3:     helpers.Int8Ptr(-85),
-				Mac4:      helpers.StringPtr("726cdac8b89d"),
-				Rssi4:     helpers.Int8Ptr(-84),
-				Mac5:      helpers.StringPtr("f0b0140c96bb"),
-				Rssi5:     helpers.Int8Ptr(-56),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -88},
+					{Mac: "e0286d8a9478", Rssi: -62},
+					{Mac: "726c9a74b58d", Rssi: -85},
+					{Mac: "726cdac8b89d", Rssi: -84},
+					{Mac: "f0b0140c96bb", Rssi: -56},
+				},
 			},
 		},
 		{
@@ -366,20 +366,15 @@ func TestDecode(t *testing.T) {
 			expected: Port5Payload{
 				Moving:    false,
 				DutyCycle: false,
-				Mac1:      "e0286d8aabfc",
-				Rssi1:     -88,
-				Mac2:      helpers.StringPtr("e0286d8a9478"),
-				Rssi2:     helpers.Int8Ptr(-62),
-				Mac3:      helpers.StringPtr("726c9a74b58d"),
-				Rssi3:     helpers.Int8Ptr(-85),
-				Mac4:      helpers.StringPtr("726cdac8b89d"),
-				Rssi4:     helpers.Int8Ptr(-84),
-				Mac5:      helpers.StringPtr("f0b0140c96bb"),
-				Rssi5:     helpers.Int8Ptr(-56),
-				Mac6:      helpers.StringPtr("deadbeef4242"),
-				Rssi6:     helpers.Int8Ptr(-42),
-				Mac7:      helpers.StringPtr("deadbeef4242"),
-				Rssi7:     helpers.Int8Ptr(-42),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -88},
+					{Mac: "e0286d8a9478", Rssi: -62},
+					{Mac: "726c9a74b58d", Rssi: -85},
+					{Mac: "726cdac8b89d", Rssi: -84},
+					{Mac: "f0b0140c96bb", Rssi: -56},
+					{Mac: "deadbeef4242", Rssi: -42},
+					{Mac: "deadbeef4242", Rssi: -42},
+				},
 			},
 		},
 		{
@@ -389,20 +384,15 @@ func TestDecode(t *testing.T) {
 			expected: Port5Payload{
 				Moving:    false,
 				DutyCycle: false,
-				Mac1:      "e0286d8aabfc",
-				Rssi1:     -88,
-				Mac2:      helpers.StringPtr("e0286d8a9478"),
-				Rssi2:     helpers.Int8Ptr(-62),
-				Mac3:      helpers.StringPtr("726c9a74b58d"),
-				Rssi3:     helpers.Int8Ptr(-85),
-				Mac4:      helpers.StringPtr("726cdac8b89d"),
-				Rssi4:     helpers.Int8Ptr(-84),
-				Mac5:      helpers.StringPtr("f0b0140c96bb"),
-				Rssi5:     helpers.Int8Ptr(-56),
-				Mac6:      helpers.StringPtr("deadbeef4242"),
-				Rssi6:     helpers.Int8Ptr(-42),
-				Mac7:      helpers.StringPtr("deadbeef4242"),
-				Rssi7:     helpers.Int8Ptr(-42),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -88},
+					{Mac: "e0286d8a9478", Rssi: -62},
+					{Mac: "726c9a74b58d", Rssi: -85},
+					{Mac: "726cdac8b89d", Rssi: -84},
+					{Mac: "f0b0140c96bb", Rssi: -56},
+					{Mac: "deadbeef4242", Rssi: -42},
+					{Mac: "deadbeef4242", Rssi: -42},
+				},
 			},
 		},
 		{
@@ -436,18 +426,14 @@ func TestDecode(t *testing.T) {
 				ConfigId:     0,
 				ConfigChange: false,
 				Moving:       false,
-				Mac1:         "e0286d8aabfc",
-				Rssi1:        -69,
-				Mac2:         helpers.StringPtr("ec6c9a74b58f"),
-				Rssi2:        helpers.Int8Ptr(-78),
-				Mac3:         helpers.StringPtr("726c9a74b58d"),
-				Rssi3:        helpers.Int8Ptr(-79),
-				Mac4:         helpers.StringPtr("e0286d8a9478"),
-				Rssi4:        helpers.Int8Ptr(-53),
-				Mac5:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi5:        helpers.Int8Ptr(-46),
-				Mac6:         helpers.StringPtr("260122180d42"),
-				Rssi6:        helpers.Int8Ptr(-83),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -69},
+					{Mac: "ec6c9a74b58f", Rssi: -78},
+					{Mac: "726c9a74b58d", Rssi: -79},
+					{Mac: "e0286d8a9478", Rssi: -53},
+					{Mac: "f0b0140c96bb", Rssi: -46},
+					{Mac: "260122180d42", Rssi: -83},
+				},
 			},
 		},
 		{
@@ -460,18 +446,14 @@ func TestDecode(t *testing.T) {
 				ConfigId:     0,
 				ConfigChange: false,
 				Moving:       true,
-				Mac1:         "e0286d8aabfc",
-				Rssi1:        -69,
-				Mac2:         helpers.StringPtr("ec6c9a74b58f"),
-				Rssi2:        helpers.Int8Ptr(-78),
-				Mac3:         helpers.StringPtr("726c9a74b58d"),
-				Rssi3:        helpers.Int8Ptr(-79),
-				Mac4:         helpers.StringPtr("e0286d8a9478"),
-				Rssi4:        helpers.Int8Ptr(-53),
-				Mac5:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi5:        helpers.Int8Ptr(-46),
-				Mac6:         helpers.StringPtr("260122180d42"),
-				Rssi6:        helpers.Int8Ptr(-83),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -69},
+					{Mac: "ec6c9a74b58f", Rssi: -78},
+					{Mac: "726c9a74b58d", Rssi: -79},
+					{Mac: "e0286d8a9478", Rssi: -53},
+					{Mac: "f0b0140c96bb", Rssi: -46},
+					{Mac: "260122180d42", Rssi: -83},
+				},
 			},
 		},
 		{
@@ -483,8 +465,9 @@ func TestDecode(t *testing.T) {
 				ConfigId:     0,
 				ConfigChange: false,
 				Moving:       true,
-				Mac1:         "e0286d8aabfc",
-				Rssi1:        -69,
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -69},
+				},
 			},
 		},
 		{
@@ -496,8 +479,9 @@ func TestDecode(t *testing.T) {
 				ConfigId:     7,
 				ConfigChange: true,
 				Moving:       false,
-				Mac1:         "e0286d8aabfc",
-				Rssi1:        -69,
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -69},
+				},
 			},
 		},
 		{
@@ -694,14 +678,12 @@ func TestDecode(t *testing.T) {
 				Timestamp: time.Date(2024, 8, 20, 9, 11, 41, 0, time.UTC),
 				Battery:   3.969,
 				TTF:       time.Duration(24) * time.Second,
-				Mac1:      "e0286d8aabfc",
-				Rssi1:     -87,
-				Mac2:      helpers.StringPtr("f0b0140c96bb"),
-				Rssi2:     helpers.Int8Ptr(-56),
-				Mac3:      helpers.StringPtr("726c9a74b58d"),
-				Rssi3:     helpers.Int8Ptr(-88),
-				Mac4:      helpers.StringPtr("e0286d8a9478"),
-				Rssi4:     helpers.Int8Ptr(-65),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -87},
+					{Mac: "f0b0140c96bb", Rssi: -56},
+					{Mac: "726c9a74b58d", Rssi: -88},
+					{Mac: "e0286d8a9478", Rssi: -65},
+				},
 			},
 		},
 		{
@@ -716,12 +698,11 @@ func TestDecode(t *testing.T) {
 				Timestamp: time.Date(2024, 8, 20, 13, 13, 52, 0, time.UTC),
 				Battery:   3.795,
 				TTF:       time.Duration(74) * time.Second,
-				Mac1:      "f0b0140c96bb",
-				Rssi1:     -77,
-				Mac2:      helpers.StringPtr("e0286d8a9478"),
-				Rssi2:     helpers.Int8Ptr(-61),
-				Mac3:      helpers.StringPtr("fc848e9b5571"),
-				Rssi3:     helpers.Int8Ptr(-62),
+				AccessPoints: []AccessPoint{
+					{Mac: "f0b0140c96bb", Rssi: -77},
+					{Mac: "e0286d8a9478", Rssi: -61},
+					{Mac: "fc848e9b5571", Rssi: -62},
+				},
 			},
 		},
 		{
@@ -736,14 +717,12 @@ func TestDecode(t *testing.T) {
 				Timestamp: time.Date(2024, 8, 20, 13, 13, 52, 0, time.UTC),
 				Battery:   3.795,
 				TTF:       time.Duration(74) * time.Second,
-				Mac1:      "f0b0140c96bb",
-				Rssi1:     -77,
-				Mac2:      helpers.StringPtr("e0286d8a9478"),
-				Rssi2:     helpers.Int8Ptr(-61),
-				Mac3:      helpers.StringPtr("fc848e9b5571"),
-				Rssi3:     helpers.Int8Ptr(-62),
-				Mac4:      helpers.StringPtr("deadbeef4242"),
-				Rssi4:     helpers.Int8Ptr(-42),
+				AccessPoints: []AccessPoint{
+					{Mac: "f0b0140c96bb", Rssi: -77},
+					{Mac: "e0286d8a9478", Rssi: -61},
+					{Mac: "fc848e9b5571", Rssi: -62},
+					{Mac: "deadbeef4242", Rssi: -42},
+				},
 			},
 		}, {
 			port:           50,
@@ -758,14 +737,12 @@ func TestDecode(t *testing.T) {
 				Timestamp: time.Date(2024, 8, 20, 13, 13, 52, 0, time.UTC),
 				Battery:   3.795,
 				TTF:       time.Duration(74) * time.Second,
-				Mac1:      "f0b0140c96bb",
-				Rssi1:     -77,
-				Mac2:      helpers.StringPtr("e0286d8a9478"),
-				Rssi2:     helpers.Int8Ptr(-61),
-				Mac3:      helpers.StringPtr("fc848e9b5571"),
-				Rssi3:     helpers.Int8Ptr(-62),
-				Mac4:      helpers.StringPtr("deadbeef4242"),
-				Rssi4:     helpers.Int8Ptr(-42),
+				AccessPoints: []AccessPoint{
+					{Mac: "f0b0140c96bb", Rssi: -77},
+					{Mac: "e0286d8a9478", Rssi: -61},
+					{Mac: "fc848e9b5571", Rssi: -62},
+					{Mac: "deadbeef4242", Rssi: -42},
+				},
 			},
 		},
 		{
@@ -780,8 +757,9 @@ func TestDecode(t *testing.T) {
 				Timestamp: time.Date(2024, 8, 20, 13, 13, 52, 0, time.UTC),
 				Battery:   3.795,
 				TTF:       time.Duration(74) * time.Second,
-				Mac1:      "a1b2c3d4e5f6",
-				Rssi1:     -72,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -72},
+				},
 			},
 		},
 		{
@@ -796,8 +774,9 @@ func TestDecode(t *testing.T) {
 				Timestamp: time.Date(2024, 8, 20, 13, 13, 52, 0, time.UTC),
 				Battery:   3.795,
 				TTF:       time.Duration(74) * time.Second,
-				Mac1:      "a1b2c3d4e5f6",
-				Rssi1:     -72,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -72},
+				},
 			},
 		},
 		{
@@ -814,8 +793,9 @@ func TestDecode(t *testing.T) {
 				Timestamp:    time.Date(2021, 2, 16, 6, 33, 21, 0, time.UTC),
 				Battery:      3.873,
 				TTF:          time.Duration(49) * time.Second,
-				Mac1:         "a1b2c3d4e5f6",
-				Rssi1:        -64,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -64},
+				},
 			},
 		},
 		{
@@ -834,14 +814,12 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(52) * time.Second,
 				PDOP:         5.5,
 				Satellites:   6,
-				Mac1:         "726c9a74b58d",
-				Rssi1:        -79,
-				Mac2:         helpers.StringPtr("fcf528f8634f"),
-				Rssi2:        helpers.Int8Ptr(-75),
-				Mac3:         helpers.StringPtr("52a8db7bd6b5"),
-				Rssi3:        helpers.Int8Ptr(-71),
-				Mac4:         helpers.StringPtr("e0286d8aabfc"),
-				Rssi4:        helpers.Int8Ptr(-68),
+				AccessPoints: []AccessPoint{
+					{Mac: "726c9a74b58d", Rssi: -79},
+					{Mac: "fcf528f8634f", Rssi: -75},
+					{Mac: "52a8db7bd6b5", Rssi: -71},
+					{Mac: "e0286d8aabfc", Rssi: -68},
+				},
 			},
 		},
 		{
@@ -860,8 +838,9 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(52) * time.Second,
 				PDOP:         5.5,
 				Satellites:   6,
-				Mac1:         "726c9a74b58d",
-				Rssi1:        -79,
+				AccessPoints: []AccessPoint{
+					{Mac: "726c9a74b58d", Rssi: -79},
+				},
 			},
 		},
 		{
@@ -880,8 +859,9 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(52) * time.Second,
 				PDOP:         5.5,
 				Satellites:   6,
-				Mac1:         "726c9a74b58d",
-				Rssi1:        -79,
+				AccessPoints: []AccessPoint{
+					{Mac: "726c9a74b58d", Rssi: -79},
+				},
 			},
 		},
 		{
@@ -900,8 +880,9 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(52) * time.Second,
 				PDOP:         5.5,
 				Satellites:   6,
-				Mac1:         "726c9a74b58d",
-				Rssi1:        -79,
+				AccessPoints: []AccessPoint{
+					{Mac: "726c9a74b58d", Rssi: -79},
+				},
 			},
 		},
 		{
@@ -920,8 +901,9 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(52) * time.Second,
 				PDOP:         5.5,
 				Satellites:   6,
-				Mac1:         "726c9a74b58d",
-				Rssi1:        -79,
+				AccessPoints: []AccessPoint{
+					{Mac: "726c9a74b58d", Rssi: -79},
+				},
 			},
 		},
 		{
@@ -940,8 +922,9 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(160) * time.Second,
 				PDOP:         8,
 				Satellites:   4,
-				Mac1:         "a1b2c3d4e5f6",
-				Rssi1:        -72,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -72},
+				},
 			},
 		},
 		{
@@ -952,16 +935,13 @@ func TestDecode(t *testing.T) {
 				Timestamp:   time.Date(2024, 8, 20, 14, 18, 34, 0, time.UTC),
 				DutyCycle:   false,
 				Moving:      false,
-				Mac1:        "e0286d8aabfc",
-				Rssi1:       -79,
-				Mac2:        helpers.StringPtr("e0286d8a9478"),
-				Rssi2:       helpers.Int8Ptr(-62),
-				Mac3:        helpers.StringPtr("ec6c9a74b58f"),
-				Rssi3:       helpers.Int8Ptr(-83),
-				Mac4:        helpers.StringPtr("726c9a74b58d"),
-				Rssi4:       helpers.Int8Ptr(-83),
-				Mac5:        helpers.StringPtr("f0b0140c96bb"),
-				Rssi5:       helpers.Int8Ptr(-48),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -79},
+					{Mac: "e0286d8a9478", Rssi: -62},
+					{Mac: "ec6c9a74b58f", Rssi: -83},
+					{Mac: "726c9a74b58d", Rssi: -83},
+					{Mac: "f0b0140c96bb", Rssi: -48},
+				},
 			},
 		},
 		{
@@ -972,16 +952,13 @@ func TestDecode(t *testing.T) {
 				Timestamp:   time.Date(2024, 8, 20, 14, 18, 34, 0, time.UTC),
 				DutyCycle:   true,
 				Moving:      false,
-				Mac1:        "e0286d8aabfc",
-				Rssi1:       -79,
-				Mac2:        helpers.StringPtr("e0286d8a9478"),
-				Rssi2:       helpers.Int8Ptr(-62),
-				Mac3:        helpers.StringPtr("ec6c9a74b58f"),
-				Rssi3:       helpers.Int8Ptr(-83),
-				Mac4:        helpers.StringPtr("726c9a74b58d"),
-				Rssi4:       helpers.Int8Ptr(-83),
-				Mac5:        helpers.StringPtr("f0b0140c96bb"),
-				Rssi5:       helpers.Int8Ptr(-48),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -79},
+					{Mac: "e0286d8a9478", Rssi: -62},
+					{Mac: "ec6c9a74b58f", Rssi: -83},
+					{Mac: "726c9a74b58d", Rssi: -83},
+					{Mac: "f0b0140c96bb", Rssi: -48},
+				},
 			},
 		},
 		{
@@ -992,18 +969,14 @@ func TestDecode(t *testing.T) {
 				Timestamp:   time.Date(2024, 9, 21, 2, 28, 29, 0, time.UTC),
 				DutyCycle:   false,
 				Moving:      true,
-				Mac1:        "c4eb438ddde2",
-				Rssi1:       -91,
-				Mac2:        helpers.StringPtr("04e31aea1b01"),
-				Rssi2:       helpers.Int8Ptr(-89),
-				Mac3:        helpers.StringPtr("245a4c7a0d2e"),
-				Rssi3:       helpers.Int8Ptr(-64),
-				Mac4:        helpers.StringPtr("26e98d560d2e"),
-				Rssi4:       helpers.Int8Ptr(-69),
-				Mac5:        helpers.StringPtr("ccd42ef92ed4"),
-				Rssi5:       helpers.Int8Ptr(-82),
-				Mac6:        helpers.StringPtr("704f5708e1d1"),
-				Rssi6:       helpers.Int8Ptr(-71),
+				AccessPoints: []AccessPoint{
+					{Mac: "c4eb438ddde2", Rssi: -91},
+					{Mac: "04e31aea1b01", Rssi: -89},
+					{Mac: "245a4c7a0d2e", Rssi: -64},
+					{Mac: "26e98d560d2e", Rssi: -69},
+					{Mac: "ccd42ef92ed4", Rssi: -82},
+					{Mac: "704f5708e1d1", Rssi: -71},
+				},
 			},
 		},
 		{
@@ -1015,18 +988,14 @@ func TestDecode(t *testing.T) {
 				Timestamp:   time.Date(2024, 9, 21, 2, 28, 29, 0, time.UTC),
 				DutyCycle:   true,
 				Moving:      true,
-				Mac1:        "c4eb438ddde2",
-				Rssi1:       -91,
-				Mac2:        helpers.StringPtr("04e31aea1b01"),
-				Rssi2:       helpers.Int8Ptr(-89),
-				Mac3:        helpers.StringPtr("245a4c7a0d2e"),
-				Rssi3:       helpers.Int8Ptr(-64),
-				Mac4:        helpers.StringPtr("26e98d560d2e"),
-				Rssi4:       helpers.Int8Ptr(-69),
-				Mac5:        helpers.StringPtr("ccd42ef92ed4"),
-				Rssi5:       helpers.Int8Ptr(-82),
-				Mac6:        helpers.StringPtr("704f5708e1d1"),
-				Rssi6:       helpers.Int8Ptr(-71),
+				AccessPoints: []AccessPoint{
+					{Mac: "c4eb438ddde2", Rssi: -91},
+					{Mac: "04e31aea1b01", Rssi: -89},
+					{Mac: "245a4c7a0d2e", Rssi: -64},
+					{Mac: "26e98d560d2e", Rssi: -69},
+					{Mac: "ccd42ef92ed4", Rssi: -82},
+					{Mac: "704f5708e1d1", Rssi: -71},
+				},
 			},
 		},
 		{
@@ -1037,8 +1006,9 @@ func TestDecode(t *testing.T) {
 				Timestamp:   time.Date(2024, 9, 21, 2, 28, 29, 0, time.UTC),
 				DutyCycle:   true,
 				Moving:      false,
-				Mac1:        "c4eb438ddde2",
-				Rssi1:       -91,
+				AccessPoints: []AccessPoint{
+					{Mac: "c4eb438ddde2", Rssi: -91},
+				},
 			},
 		},
 		{
@@ -1049,8 +1019,9 @@ func TestDecode(t *testing.T) {
 				Timestamp:   time.Date(2024, 9, 21, 2, 28, 29, 0, time.UTC),
 				DutyCycle:   true,
 				Moving:      false,
-				Mac1:        "c4eb438ddde2",
-				Rssi1:       -91,
+				AccessPoints: []AccessPoint{
+					{Mac: "c4eb438ddde2", Rssi: -91},
+				},
 			},
 		},
 		{
@@ -1164,14 +1135,12 @@ func TestDecode(t *testing.T) {
 				Timestamp:    time.Date(2024, 8, 20, 9, 11, 41, 0, time.UTC),
 				Battery:      3.969,
 				TTF:          time.Duration(24) * time.Second,
-				Mac1:         "e0286d8aabfc",
-				Rssi1:        -87,
-				Mac2:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi2:        helpers.Int8Ptr(-56),
-				Mac3:         helpers.StringPtr("726c9a74b58d"),
-				Rssi3:        helpers.Int8Ptr(-88),
-				Mac4:         helpers.StringPtr("e0286d8a9478"),
-				Rssi4:        helpers.Int8Ptr(-65),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -87},
+					{Mac: "f0b0140c96bb", Rssi: -56},
+					{Mac: "726c9a74b58d", Rssi: -88},
+					{Mac: "e0286d8a9478", Rssi: -65},
+				},
 			},
 		},
 		{
@@ -1189,14 +1158,12 @@ func TestDecode(t *testing.T) {
 				Timestamp:    time.Date(2024, 8, 20, 9, 11, 41, 0, time.UTC),
 				Battery:      3.969,
 				TTF:          time.Duration(24) * time.Second,
-				Mac1:         "e0286d8aabfc",
-				Rssi1:        -87,
-				Mac2:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi2:        helpers.Int8Ptr(-56),
-				Mac3:         helpers.StringPtr("726c9a74b58d"),
-				Rssi3:        helpers.Int8Ptr(-88),
-				Mac4:         helpers.StringPtr("e0286d8a9478"),
-				Rssi4:        helpers.Int8Ptr(-65),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -87},
+					{Mac: "f0b0140c96bb", Rssi: -56},
+					{Mac: "726c9a74b58d", Rssi: -88},
+					{Mac: "e0286d8a9478", Rssi: -65},
+				},
 			},
 		},
 		{
@@ -1214,14 +1181,12 @@ func TestDecode(t *testing.T) {
 				Timestamp:    time.Date(2024, 8, 20, 9, 16, 8, 0, time.UTC),
 				Battery:      3.947,
 				TTF:          time.Duration(27) * time.Second,
-				Mac1:         "e0286d0a6f42",
-				Rssi1:        -93,
-				Mac2:         helpers.StringPtr("000000000044"),
-				Rssi2:        helpers.Int8Ptr(-92),
-				Mac3:         helpers.StringPtr("e0286d8a9478"),
-				Rssi3:        helpers.Int8Ptr(-65),
-				Mac4:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi4:        helpers.Int8Ptr(-55),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d0a6f42", Rssi: -93},
+					{Mac: "000000000044", Rssi: -92},
+					{Mac: "e0286d8a9478", Rssi: -65},
+					{Mac: "f0b0140c96bb", Rssi: -55},
+				},
 			},
 		},
 		{
@@ -1239,14 +1204,12 @@ func TestDecode(t *testing.T) {
 				Timestamp:    time.Date(2024, 8, 20, 9, 16, 8, 0, time.UTC),
 				Battery:      3.947,
 				TTF:          time.Duration(27) * time.Second,
-				Mac1:         "e0286d0a6f42",
-				Rssi1:        -93,
-				Mac2:         helpers.StringPtr("000000000044"),
-				Rssi2:        helpers.Int8Ptr(-92),
-				Mac3:         helpers.StringPtr("e0286d8a9478"),
-				Rssi3:        helpers.Int8Ptr(-65),
-				Mac4:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi4:        helpers.Int8Ptr(-55),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d0a6f42", Rssi: -93},
+					{Mac: "000000000044", Rssi: -92},
+					{Mac: "e0286d8a9478", Rssi: -65},
+					{Mac: "f0b0140c96bb", Rssi: -55},
+				},
 			},
 		},
 		{
@@ -1265,14 +1228,12 @@ func TestDecode(t *testing.T) {
 				Timestamp:    time.Date(2024, 8, 20, 9, 16, 8, 0, time.UTC),
 				Battery:      3.947,
 				TTF:          time.Duration(27) * time.Second,
-				Mac1:         "e0286d0a6f42",
-				Rssi1:        -93,
-				Mac2:         helpers.StringPtr("000000000044"),
-				Rssi2:        helpers.Int8Ptr(-92),
-				Mac3:         helpers.StringPtr("e0286d8a9478"),
-				Rssi3:        helpers.Int8Ptr(-65),
-				Mac4:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi4:        helpers.Int8Ptr(-55),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d0a6f42", Rssi: -93},
+					{Mac: "000000000044", Rssi: -92},
+					{Mac: "e0286d8a9478", Rssi: -65},
+					{Mac: "f0b0140c96bb", Rssi: -55},
+				},
 			},
 		},
 		{
@@ -1290,8 +1251,9 @@ func TestDecode(t *testing.T) {
 				Timestamp:    time.Date(2024, 8, 20, 9, 16, 8, 0, time.UTC),
 				Battery:      3.947,
 				TTF:          time.Duration(27) * time.Second,
-				Mac1:         "a1b2c3d4e5f6",
-				Rssi1:        -62,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -62},
+				},
 			},
 		},
 		{
@@ -1309,8 +1271,9 @@ func TestDecode(t *testing.T) {
 				Timestamp:    time.Date(2024, 8, 20, 9, 16, 8, 0, time.UTC),
 				Battery:      3.947,
 				TTF:          time.Duration(27) * time.Second,
-				Mac1:         "a1b2c3d4e5f6",
-				Rssi1:        -62,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -62},
+				},
 			},
 		},
 		{
@@ -1328,8 +1291,9 @@ func TestDecode(t *testing.T) {
 				Timestamp:    time.Date(2021, 2, 16, 6, 33, 21, 0, time.UTC),
 				Battery:      3.873,
 				TTF:          time.Duration(49) * time.Second,
-				Mac1:         "a1b2c3d4e5f6",
-				Rssi1:        -80,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -80},
+				},
 			},
 		},
 		{
@@ -1349,10 +1313,10 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(24) * time.Second,
 				PDOP:         1.5,
 				Satellites:   11,
-				Mac1:         "e0286d8a9478",
-				Rssi1:        -53,
-				Mac2:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi2:        helpers.Int8Ptr(-50),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8a9478", Rssi: -53},
+					{Mac: "f0b0140c96bb", Rssi: -50},
+				},
 			},
 		},
 		{
@@ -1372,14 +1336,12 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(24) * time.Second,
 				PDOP:         1.5,
 				Satellites:   11,
-				Mac1:         "e0286d8a9478",
-				Rssi1:        -53,
-				Mac2:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi2:        helpers.Int8Ptr(-50),
-				Mac3:         helpers.StringPtr("deadbeef4242"),
-				Rssi3:        helpers.Int8Ptr(-42),
-				Mac4:         helpers.StringPtr("deadbeef4242"),
-				Rssi4:        helpers.Int8Ptr(-42),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8a9478", Rssi: -53},
+					{Mac: "f0b0140c96bb", Rssi: -50},
+					{Mac: "deadbeef4242", Rssi: -42},
+					{Mac: "deadbeef4242", Rssi: -42},
+				},
 			},
 		},
 		{
@@ -1400,14 +1362,12 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(24) * time.Second,
 				PDOP:         1.5,
 				Satellites:   11,
-				Mac1:         "e0286d8a9478",
-				Rssi1:        -53,
-				Mac2:         helpers.StringPtr("f0b0140c96bb"),
-				Rssi2:        helpers.Int8Ptr(-50),
-				Mac3:         helpers.StringPtr("deadbeef4242"),
-				Rssi3:        helpers.Int8Ptr(-42),
-				Mac4:         helpers.StringPtr("deadbeef4242"),
-				Rssi4:        helpers.Int8Ptr(-42),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8a9478", Rssi: -53},
+					{Mac: "f0b0140c96bb", Rssi: -50},
+					{Mac: "deadbeef4242", Rssi: -42},
+					{Mac: "deadbeef4242", Rssi: -42},
+				},
 			},
 		},
 		{
@@ -1427,8 +1387,9 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(24) * time.Second,
 				PDOP:         1.5,
 				Satellites:   11,
-				Mac1:         "a1b2c3d4e5f6",
-				Rssi1:        -64,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -64},
+				},
 			},
 		},
 		{
@@ -1448,8 +1409,9 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(24) * time.Second,
 				PDOP:         1.5,
 				Satellites:   11,
-				Mac1:         "a1b2c3d4e5f6",
-				Rssi1:        -64,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -64},
+				},
 			},
 		},
 		{
@@ -1469,8 +1431,9 @@ func TestDecode(t *testing.T) {
 				TTF:          time.Duration(160) * time.Second,
 				PDOP:         8,
 				Satellites:   4,
-				Mac1:         "a1b2c3d4e5f6",
-				Rssi1:        -88,
+				AccessPoints: []AccessPoint{
+					{Mac: "a1b2c3d4e5f6", Rssi: -88},
+				},
 			},
 		},
 		{
@@ -1878,6 +1841,124 @@ func TestInvalidHexString(t *testing.T) {
 	}
 }
 
+func TestDecodeBytes(t *testing.T) {
+	d := NewTagSLv1Decoder()
+
+	expected, err := d.Decode(context.TODO(), "8002cdcd1300744f5e166018040b14341a", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	payload, err := decoder.FromBase64("gALNzRMAdE9eFmAYBAsUNBo=")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := d.DecodeBytes(context.TODO(), payload, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %v\ngot: %v", expected, got)
+	}
+
+	_, err = d.DecodeBytes(context.TODO(), payload[:5], 1)
+	if err == nil || !errors.Is(err, helpers.ErrPayloadTooShort) {
+		t.Errorf("expected payload too short, got %v", err)
+	}
+
+	_, err = d.DecodeBytes(context.TODO(), payload, 0)
+	if err == nil || !errors.Is(err, helpers.ErrPortNotSupported) {
+		t.Errorf("expected port not supported, got %v", err)
+	}
+}
+
+func TestLenient(t *testing.T) {
+	d := NewTagSLv1Decoder(WithLenient(true))
+
+	// the time of the position is missing
+	got, err := d.Decode(context.TODO(), "8002cdcd1300744f5e1660", 1)
+
+	var partial *helpers.PartialDecodeError
+	if !errors.As(err, &partial) {
+		t.Fatalf("expected partial decode error, got %v", err)
+	}
+
+	expected := []helpers.FieldError{
+		{Name: "Year", Offset: 11, Length: 1, Available: 0},
+		{Name: "Month", Offset: 12, Length: 1, Available: 0},
+		{Name: "Day", Offset: 13, Length: 1, Available: 0},
+		{Name: "Hour", Offset: 14, Length: 1, Available: 0},
+		{Name: "Minute", Offset: 15, Length: 1, Available: 0},
+		{Name: "Second", Offset: 16, Length: 1, Available: 0},
+	}
+	if !reflect.DeepEqual(partial.Fields, expected) {
+		t.Errorf("expected missing fields %v, got %v", expected, partial.Fields)
+	}
+
+	data := got.Data.(Port1Payload)
+	if data.Latitude != 47.041811 || data.Longitude != 7.622494 || data.Altitude != 572.8 {
+		t.Errorf("expected position to be decoded, got %v", data)
+	}
+
+	// payloads that are too long are still rejected
+	_, err = d.Decode(context.TODO(), "8002cdcd1300744f5e166018040b14341a00", 1)
+	if !errors.Is(err, helpers.ErrPayloadTooLong) {
+		t.Errorf("expected payload too long, got %v", err)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	d := NewTagSLv1Decoder()
+
+	_, err := d.Decode(context.TODO(), "8002cdcd1300744f5e1660", 1)
+
+	var decodeError *helpers.DecodeError
+	if !errors.As(err, &decodeError) || !errors.Is(err, helpers.ErrPayloadTooShort) {
+		t.Fatalf("expected decode error for payload too short, got %v", err)
+	}
+	if decodeError.Device() != "tagsl/v1" || decodeError.Port() != 1 {
+		t.Errorf("expected tagsl/v1 on port 1, got %s on port %d", decodeError.Device(), decodeError.Port())
+	}
+	if decodeError.ExpectedLength() != 17 || decodeError.ActualLength() != 11 {
+		t.Errorf("expected 11 of 17 bytes, got %d of %d", decodeError.ActualLength(), decodeError.ExpectedLength())
+	}
+	if hex.EncodeToString(decodeError.Raw()) != "cd1300744f5e1660" || decodeError.RawOffset() != 3 {
+		t.Errorf("unexpected raw bytes %x at offset %d", decodeError.Raw(), decodeError.RawOffset())
+	}
+
+	_, err = d.Decode(context.TODO(), "8002cdcd1300744f5e1660", 0)
+	if !errors.As(err, &decodeError) || !errors.Is(err, helpers.ErrPortNotSupported) || decodeError.Port() != 0 {
+		t.Errorf("expected decode error for port not supported, got %v", err)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	d := NewTagSLv1Decoder().(decoder.Explainer)
+
+	explanations, err := d.Explain(context.TODO(), []byte{0x80, 0x02, 0xcd, 0xcd, 0x13, 0x00, 0x74, 0x4f, 0x5e, 0x16, 0x60, 0x18, 0x04, 0x0b, 0x14, 0x34, 0x1a}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(explanations) != 13 {
+		t.Fatalf("expected 13 fields, got %d", len(explanations))
+	}
+
+	latitude := explanations[4]
+	if latitude.Name != "Latitude" || latitude.Start != 1 || latitude.Length != 4 || latitude.Hex != "02cdcd13" {
+		t.Errorf("unexpected explanation of latitude %+v", latitude)
+	}
+	if latitude.Integer == nil || *latitude.Integer != 47041811 || latitude.Value != 47.041811 || !latitude.Valid {
+		t.Errorf("unexpected value of latitude %+v", latitude)
+	}
+
+	_, err = d.Explain(context.TODO(), nil, 0)
+	if !errors.Is(err, helpers.ErrPortNotSupported) {
+		t.Errorf("expected port not supported, got %v", err)
+	}
+}
+
 func TestFullDecode(t *testing.T) {
 	tests := []struct {
 		payload        string
@@ -1912,20 +1993,15 @@ func TestFullDecode(t *testing.T) {
 				ConfigId:     1,
 				ConfigChange: true,
 				Moving:       false,
-				Mac1:         "24651155ce55",
-				Rssi1:        -72,
-				Mac2:         helpers.StringPtr("602232e20f52"),
-				Rssi2:        helpers.Int8Ptr(-80),
-				Mac3:         helpers.StringPtr("ac8ba91fedaa"),
-				Rssi3:        helpers.Int8Ptr(-91),
-				Mac4:         helpers.StringPtr("603197f93781"),
-				Rssi4:        helpers.Int8Ptr(-87),
-				Mac5:         helpers.StringPtr("0aecdafa5fe8"),
-				Rssi5:        helpers.Int8Ptr(-68),
-				Mac6:         helpers.StringPtr("02ecdafa5fe8"),
-				Rssi6:        helpers.Int8Ptr(-67),
-				Mac7:         helpers.StringPtr("8c59c3c960f0"),
-				Rssi7:        helpers.Int8Ptr(-91),
+				AccessPoints: []AccessPoint{
+					{Mac: "24651155ce55", Rssi: -72},
+					{Mac: "602232e20f52", Rssi: -80},
+					{Mac: "ac8ba91fedaa", Rssi: -91},
+					{Mac: "603197f93781", Rssi: -87},
+					{Mac: "0aecdafa5fe8", Rssi: -68},
+					{Mac: "02ecdafa5fe8", Rssi: -67},
+					{Mac: "8c59c3c960f0", Rssi: -91},
+				},
 			},
 		},
 		{
@@ -1933,18 +2009,14 @@ func TestFullDecode(t *testing.T) {
 			payload: "66ec04bb00e0286d8aabfcbbec6c9a74b58fb2726c9a74b58db1e0286d8a9478cbf0b0140c96bbd2260122180d42ad",
 			expectedData: Port7Payload{
 				Timestamp: time.Date(2024, 9, 19, 11, 2, 19, 0, time.UTC),
-				Mac1:      "e0286d8aabfc",
-				Rssi1:     -69,
-				Mac2:      helpers.StringPtr("ec6c9a74b58f"),
-				Rssi2:     helpers.Int8Ptr(-78),
-				Mac3:      helpers.StringPtr("726c9a74b58d"),
-				Rssi3:     helpers.Int8Ptr(-79),
-				Mac4:      helpers.StringPtr("e0286d8a9478"),
-				Rssi4:     helpers.Int8Ptr(-53),
-				Mac5:      helpers.StringPtr("f0b0140c96bb"),
-				Rssi5:     helpers.Int8Ptr(-46),
-				Mac6:      helpers.StringPtr("260122180d42"),
-				Rssi6:     helpers.Int8Ptr(-83),
+				AccessPoints: []AccessPoint{
+					{Mac: "e0286d8aabfc", Rssi: -69},
+					{Mac: "ec6c9a74b58f", Rssi: -78},
+					{Mac: "726c9a74b58d", Rssi: -79},
+					{Mac: "e0286d8a9478", Rssi: -53},
+					{Mac: "f0b0140c96bb", Rssi: -46},
+					{Mac: "260122180d42", Rssi: -83},
+				},
 			},
 		},
 		{
@@ -1957,18 +2029,14 @@ func TestFullDecode(t *testing.T) {
 				ConfigId:     0,
 				ConfigChange: false,
 				Moving:       true,
-				Mac1:         "72a741b1e238",
-				Rssi1:        -75,
-				Mac2:         helpers.StringPtr("72a741b1e08b"),
-				Rssi2:        helpers.Int8Ptr(-80),
-				Mac3:         helpers.StringPtr("3498b5c583e2"),
-				Rssi3:        helpers.Int8Ptr(-79),
-				Mac4:         helpers.StringPtr("72a741b1e0cd"),
-				Rssi4:        helpers.Int8Ptr(-89),
-				Mac5:         helpers.StringPtr("72a741beed4c"),
-				Rssi5:        helpers.Int8Ptr(-60),
-				Mac6:         helpers.StringPtr("72a741beef53"),
-				Rssi6:        helpers.Int8Ptr(-73),
+				AccessPoints: []AccessPoint{
+					{Mac: "72a741b1e238", Rssi: -75},
+					{Mac: "72a741b1e08b", Rssi: -80},
+					{Mac: "3498b5c583e2", Rssi: -79},
+					{Mac: "72a741b1e0cd", Rssi: -89},
+					{Mac: "72a741beed4c", Rssi: -60},
+					{Mac: "72a741beef53", Rssi: -73},
+				},
 			},
 		},
 		{
@@ -2308,7 +2376,7 @@ func TestMarshal(t *testing.T) {
 		{
 			payload:  "822f0101f052fab920feafd0e4158b38b9afe05994cb2f5cb2a1b2c3d4e5f6aea1b2c3d4e5f6aea1b2c3d4e5f6ae",
 			port:     3,
-			expected: []string{"\"scanPointer\": 33327", "\"mac1\": \"f052fab920fe\"", "\"rssi1\": -81"},
+			expected: []string{"\"scanPointer\": 33327", "\"mac\": \"f052fab920fe\"", "\"rssi\": -81"},
 		},
 		{
 			payload:  "0000012c00000e1000001c200078012c05dc02020100010200002328",
@@ -2318,7 +2386,7 @@ func TestMarshal(t *testing.T) {
 		{
 			payload:  "00e0286d8aabfca8e0286d8a9478c2726c9a74b58dab726cdac8b89dacf0b0140c96bbc8deadbeef4242d6deadbeef4242d6",
 			port:     5,
-			expected: []string{"\"moving\": false", "\"mac1\": \"e0286d8aabfc\"", "\"rssi1\": -88"},
+			expected: []string{"\"moving\": false", "\"mac\": \"e0286d8aabfc\"", "\"rssi\": -88"},
 		},
 		{
 			payload:  "01",
@@ -2328,7 +2396,7 @@ func TestMarshal(t *testing.T) {
 		{
 			payload:  "66ec04bb00e0286d8aabfcbbec6c9a74b58fb2726c9a74b58db1e0286d8a9478cbf0b0140c96bbd2260122180d42ad",
 			port:     7,
-			expected: []string{"\"timestamp\": \"2024-09-19T11:02:19Z\"", "\"mac1\": \"e0286d8aabfc\"", "\"rssi1\": -69"},
+			expected: []string{"\"timestamp\": \"2024-09-19T11:02:19Z\"", "\"mac\": \"e0286d8aabfc\"", "\"rssi\": -69"},
 		},
 		{
 			payload:  "012c141e9c455738304543434343460078012c01a8c0",
@@ -2348,17 +2416,17 @@ func TestMarshal(t *testing.T) {
 		{
 			payload:  "0002d30c9300824c87117966c45dcd0f8118e0286d8aabfca9f0b0140c96bbc8726c9a74b58da8e0286d8a9478bf",
 			port:     50,
-			expected: []string{"\"moving\": false", "\"timestamp\": \"2024-08-20T09:11:41Z\"", "\"mac1\": \"e0286d8aabfc\"", "\"rssi1\": -87"},
+			expected: []string{"\"moving\": false", "\"timestamp\": \"2024-08-20T09:11:41Z\"", "\"mac\": \"e0286d8aabfc\"", "\"rssi\": -87"},
 		},
 		{
 			payload:  "0002d30ba000824ace1122671b983e0eea340b06726c9a74b58db1fcf528f8634fb552a8db7bd6b5b9e0286d8aabfcbc",
 			port:     51,
-			expected: []string{"\"moving\": false", "\"timestamp\": \"2024-10-25T13:08:14Z\"", "\"pdop\": \"5.5m\"", "\"mac1\": \"726c9a74b58d\"", "\"rssi1\": -79"},
+			expected: []string{"\"moving\": false", "\"timestamp\": \"2024-10-25T13:08:14Z\"", "\"pdop\": \"5.5m\"", "\"mac\": \"726c9a74b58d\"", "\"rssi\": -79"},
 		},
 		{
 			payload:  "000166c4a5ba80e0286d8aabfcb1e0286d8a9478c2ec6c9a74b58fad726c9a74b58dadf0b0140c96bbd0a1b2c3d4e5f6ae",
 			port:     105,
-			expected: []string{"\"moving\": false", "\"mac1\": \"e0286d8aabfc\"", "\"rssi1\": -79"},
+			expected: []string{"\"moving\": false", "\"mac\": \"e0286d8aabfc\"", "\"rssi\": -79"},
 		},
 		{
 			payload:  "00020002d309ae008247c5113966c45d640f7e2e0707",
@@ -2368,12 +2436,12 @@ func TestMarshal(t *testing.T) {
 		{
 			payload:  "00020002d30c9300824c87117966c45dcd0f8118e0286d8aabfca9f0b0140c96bbc8726c9a74b58da8e0286d8a9478bf",
 			port:     150,
-			expected: []string{"\"moving\": false", "\"timestamp\": \"2024-08-20T09:11:41Z\"", "\"mac1\": \"e0286d8aabfc\"", "\"rssi1\": -87", "\"ttf\": \"24s\""},
+			expected: []string{"\"moving\": false", "\"timestamp\": \"2024-08-20T09:11:41Z\"", "\"mac\": \"e0286d8aabfc\"", "\"rssi\": -87", "\"ttf\": \"24s\""},
 		},
 		{
 			payload:  "00000002d30b27008247b81312671bd164133718030be0286d8a9478cbf0b0140c96bbcea1b2c3d4e5f6aea1b2c3d4e5f6ae",
 			port:     151,
-			expected: []string{"\"moving\": false", "\"timestamp\": \"2024-10-25T17:12:04Z\"", "\"pdop\": \"1.5m\"", "\"mac1\": \"e0286d8a9478\"", "\"rssi1\": -53", "\"ttf\": \"24s\""},
+			expected: []string{"\"moving\": false", "\"timestamp\": \"2024-10-25T17:12:04Z\"", "\"pdop\": \"1.5m\"", "\"mac\": \"e0286d8a9478\"", "\"rssi\": -53", "\"ttf\": \"24s\""},
 		},
 		{
 			payload:  "01",
@@ -2493,3 +2561,120 @@ func TestResetReason(t *testing.T) {
 		})
 	}
 }
+
+func TestDevice(t *testing.T) {
+	device, err := decoder.Lookup("tagsl/v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d, err := decoder.New("tagsl/v1", decoder.DeviceOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for port := range 256 {
+		_, err := d.DecodeBytes(context.TODO(), []byte{}, uint8(port))
+		supported := !errors.Is(err, helpers.ErrPortNotSupported)
+		if supported != slices.Contains(device.Ports, uint8(port)) {
+			t.Errorf("port %d: expected supported %v, got %v", port, !supported, supported)
+		}
+	}
+
+	ports := []uint8{}
+	for _, info := range d.(decoder.Catalog).Ports() {
+		ports = append(ports, info.Port)
+	}
+	if ports = slices.Compact(ports); !slices.Equal(ports, device.Ports) {
+		t.Errorf("expected catalog of ports %v, got %v", device.Ports, ports)
+	}
+}
+
+func TestTelemetry(t *testing.T) {
+	d := NewTagSLv1Decoder()
+
+	uplink, err := d.Decode(context.TODO(), "0002d30b070082491f11256718d9fe0ede190505", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	telemetry := uplink.Telemetry()
+
+	expectedPosition := &decoder.TelemetryPosition{
+		Latitude:   47.385351,
+		Longitude:  8.538399,
+		Altitude:   438.9,
+		TTF:        helpers.DurationPtr(time.Duration(25) * time.Second),
+		PDOP:       helpers.Float64Ptr(2.5),
+		Satellites: helpers.Uint8Ptr(5),
+	}
+	if !reflect.DeepEqual(telemetry.Position, expectedPosition) {
+		t.Errorf("expected position %+v, got %+v", expectedPosition, telemetry.Position)
+	}
+	if telemetry.Battery == nil || telemetry.Battery.Voltage == nil || *telemetry.Battery.Voltage != 3.806 {
+		t.Errorf("expected battery voltage 3.806, got %+v", telemetry.Battery)
+	}
+	if telemetry.Timestamp == nil || !telemetry.Timestamp.Equal(time.Date(2024, 10, 23, 11, 11, 58, 0, time.UTC)) {
+		t.Errorf("expected timestamp, got %v", telemetry.Timestamp)
+	}
+	if telemetry.Moving == nil || telemetry.Config == nil || telemetry.Config.ConfigId == nil {
+		t.Errorf("expected moving flag and config id, got %+v", telemetry)
+	}
+	if telemetry.WiFi != nil || telemetry.Environment != nil {
+		t.Errorf("expected no wifi and environment, got %+v", telemetry)
+	}
+}
+
+func TestOutputProfile(t *testing.T) {
+	d := NewTagSLv1Decoder()
+
+	uplink, err := d.Decode(context.TODO(), "0002d30b070082491f11256718d9fe0ede190505", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		profile  decoder.OutputProfile
+		expected map[string]any
+	}{
+		{
+			profile: decoder.ProfileDisplay,
+			expected: map[string]any{
+				"altitude":  "438.9m",
+				"battery":   "3.806v",
+				"ttf":       "25s",
+				"pdop":      "2.5m",
+				"timestamp": "2024-10-23T11:11:58Z",
+			},
+		},
+		{
+			profile: decoder.ProfileMachine,
+			expected: map[string]any{
+				"altitude":  438.9,
+				"battery":   3.806,
+				"ttf":       25.0,
+				"pdop":      2.5,
+				"timestamp": "2024-10-23T11:11:58Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.profile), func(t *testing.T) {
+			data, err := decoder.MarshalProfile(uplink.Data, test.profile)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var fields map[string]any
+			if err := json.Unmarshal(data, &fields); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for key, value := range test.expected {
+				if fields[key] != value {
+					t.Errorf("expected %s to be %v, got %v", key, value, fields[key])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package tagsl
+
+import "github.com/truvami/decoder/pkg/decoder"
+
+const docs = "https://docs.truvami.com/docs/payloads/tag-S"
+
+// supportedPorts are the uplink ports the decoder supports.
+var supportedPorts = []uint8{1, 2, 3, 4, 5, 6, 7, 8, 10, 15, 50, 51, 105, 110, 150, 151, 198, 199}
+
+func init() {
+	decoder.Register(decoder.Device{
+		Name:        "tagsl",
+		Version:     "v1",
+		Description: "tag S / L",
+		Ports:       supportedPorts,
+		Features: []decoder.Feature{
+			decoder.FeatureTimestamp,
+			decoder.FeatureResetReason,
+			decoder.FeatureGNSS,
+			decoder.FeatureBuffered,
+			decoder.FeatureBattery,
+			decoder.FeatureWiFi,
+			decoder.FeatureBle,
+			decoder.FeatureButton,
+			decoder.FeatureConfig,
+			decoder.FeatureConfigChange,
+			decoder.FeatureMoving,
+			decoder.FeatureDutyCycle,
+			decoder.FeatureFirmwareVersion,
+			decoder.FeatureHardwareVersion,
+		},
+		New: func(options decoder.DeviceOptions) (decoder.Decoder, error) {
+			return NewTagSLv1Decoder(WithSkipValidation(options.SkipValidation), WithLenient(options.Lenient)), nil
+		},
+	})
+}
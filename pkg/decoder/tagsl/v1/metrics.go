@@ -0,0 +1,13 @@
+package tagsl
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	tagSlBleScanIncompleteCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "truvami_tagsl_v1_ble_scan_incomplete_total",
+		Help: "The total number of tag S / L BLE scans whose messages did not all arrive before the timeout",
+	})
+)
@@ -25,14 +25,9 @@ import (
 // | 19   | 1    | Time to fix                               | uint8                  |
 // | 20   | 1    | Position dilution of precision            | uint8, 1/2 meter       |
 // | 21   | 1    | Number of satellites                      | uint8, 		            |
-// | 22   | 6    | Mac 1                                     | uint8[6]               |
-// | 28   | 1    | Rssi 1                                    | int8                   |
-// | 29   | 6    | Mac 2                                     | uint8[6]               |
-// | 35   | 1    | Rssi 2                                    | int8                   |
-// | 36   | 6    | Mac 3                                     | uint8[6]               |
-// | 42   | 1    | Rssi 3                                    | int8                   |
-// | 43   | 6    | Mac 4                                     | uint8[6]               |
-// | 49   | 1    | Rssi 4                                    | int8                   |
+// | 22   | 7    | Access point (repeated up to 4 times)     | record                 |
+// | +0   | 6    |   Mac                                     | uint8[6]               |
+// | +6   | 1    |   Rssi                                    | int8                   |
 // +------+------+-------------------------------------------+------------------------+
 
 // Timestamp for the Wi-Fi scanning is TSGNSS – TTF + 10 seconds.
@@ -50,14 +45,7 @@ type Port151Payload struct {
 	TTF          time.Duration `json:"ttf"`
 	PDOP         float64       `json:"pdop"`
 	Satellites   uint8         `json:"satellites" validate:"gte=3,lte=27"`
-	Mac1         string        `json:"mac1"`
-	Rssi1        int8          `json:"rssi1" validate:"gte=-120,lte=-20"`
-	Mac2         *string       `json:"mac2"`
-	Rssi2        *int8         `json:"rssi2" validate:"gte=-120,lte=-20"`
-	Mac3         *string       `json:"mac3"`
-	Rssi3        *int8         `json:"rssi3" validate:"gte=-120,lte=-20"`
-	Mac4         *string       `json:"mac4"`
-	Rssi4        *int8         `json:"rssi4" validate:"gte=-120,lte=-20"`
+	AccessPoints []AccessPoint `json:"accessPoints"`
 }
 
 func (p Port151Payload) MarshalJSON() ([]byte, error) {
@@ -131,37 +119,7 @@ func (p Port151Payload) GetLowBattery() *bool {
 }
 
 func (p Port151Payload) GetAccessPoints() []decoder.AccessPoint {
-	accessPoints := []decoder.AccessPoint{}
-
-	if p.Mac1 != "" && p.Rssi1 != 0 {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  p.Mac1,
-			RSSI: &p.Rssi1,
-		})
-	}
-
-	if p.Mac2 != nil && p.Rssi2 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac2,
-			RSSI: p.Rssi2,
-		})
-	}
-
-	if p.Mac3 != nil && p.Rssi3 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac3,
-			RSSI: p.Rssi3,
-		})
-	}
-
-	if p.Mac4 != nil && p.Rssi4 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac4,
-			RSSI: p.Rssi4,
-		})
-	}
-
-	return accessPoints
+	return accessPoints(p.AccessPoints)
 }
 
 func (p Port151Payload) IsBuffered() bool {
@@ -22,14 +22,9 @@ import (
 // | 11   | 4    | Unix timestamp                            | uint32                 |
 // | 15   | 2    | Battery voltage                           | uint16, mV             |
 // | 17   | 1    | Time to fix                               | uint8                  |
-// | 18   | 6    | Mac 1                                     | uint8[6]               |
-// | 24   | 1    | Rssi 1                                    | int8                   |
-// | 25   | 6    | Mac 2                                     | uint8[6]               |
-// | 31   | 1    | Rssi 2                                    | int8                   |
-// | 32   | 6    | Mac 3                                     | uint8[6]               |
-// | 38   | 1    | Rssi 3                                    | int8                   |
-// | 39   | 6    | Mac 4                                     | uint8[6]               |
-// | 45   | 1    | Rssi 4                                    | int8                   |
+// | 18   | 7    | Access point (repeated up to 4 times)     | record                 |
+// | +0   | 6    |   Mac                                     | uint8[6]               |
+// | +6   | 1    |   Rssi                                    | int8                   |
 // +------+------+-------------------------------------------+------------------------+
 
 // Timestamp for the Wi-Fi scanning is TSGNSS – TTF + 10 seconds.
@@ -44,46 +39,23 @@ type Port50Payload struct {
 	Timestamp    time.Time     `json:"timestamp"`
 	Battery      float64       `json:"battery" validate:"gte=1,lte=5"`
 	TTF          time.Duration `json:"ttf"`
-	Mac1         string        `json:"mac1"`
-	Rssi1        int8          `json:"rssi1" validate:"gte=-120,lte=-20"`
-	Mac2         *string       `json:"mac2"`
-	Rssi2        *int8         `json:"rssi2" validate:"gte=-120,lte=-20"`
-	Mac3         *string       `json:"mac3"`
-	Rssi3        *int8         `json:"rssi3" validate:"gte=-120,lte=-20"`
-	Mac4         *string       `json:"mac4"`
-	Rssi4        *int8         `json:"rssi4" validate:"gte=-120,lte=-20"`
+	AccessPoints []AccessPoint `json:"accessPoints"`
 }
 
 func (p Port50Payload) MarshalJSON() ([]byte, error) {
 	type Alias Port50Payload
 	return json.Marshal(&struct {
 		*Alias
-		Altitude  string  `json:"altitude"`
-		Timestamp string  `json:"timestamp"`
-		Battery   string  `json:"battery"`
-		TTF       string  `json:"ttf"`
-		Mac1      string  `json:"mac1"`
-		Rssi1     int8    `json:"rssi1"`
-		Mac2      *string `json:"mac2"`
-		Rssi2     *int8   `json:"rssi2"`
-		Mac3      *string `json:"mac3"`
-		Rssi3     *int8   `json:"rssi3"`
-		Mac4      *string `json:"mac4"`
-		Rssi4     *int8   `json:"rssi4"`
+		Altitude  string `json:"altitude"`
+		Timestamp string `json:"timestamp"`
+		Battery   string `json:"battery"`
+		TTF       string `json:"ttf"`
 	}{
 		Alias:     (*Alias)(&p),
 		Altitude:  fmt.Sprintf("%.1fm", p.Altitude),
 		Timestamp: p.Timestamp.Format(time.RFC3339),
 		Battery:   fmt.Sprintf("%.3fv", p.Battery),
 		TTF:       p.TTF.String(),
-		Mac1:      p.Mac1,
-		Rssi1:     p.Rssi1,
-		Mac2:      p.Mac2,
-		Rssi2:     p.Rssi2,
-		Mac3:      p.Mac3,
-		Rssi3:     p.Rssi3,
-		Mac4:      p.Mac4,
-		Rssi4:     p.Rssi4,
 	})
 }
 
@@ -136,37 +108,7 @@ func (p Port50Payload) GetLowBattery() *bool {
 }
 
 func (p Port50Payload) GetAccessPoints() []decoder.AccessPoint {
-	accessPoints := []decoder.AccessPoint{}
-
-	if p.Mac1 != "" {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  p.Mac1,
-			RSSI: &p.Rssi1,
-		})
-	}
-
-	if p.Mac2 != nil && p.Rssi2 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac2,
-			RSSI: p.Rssi2,
-		})
-	}
-
-	if p.Mac3 != nil && p.Rssi3 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac3,
-			RSSI: p.Rssi3,
-		})
-	}
-
-	if p.Mac4 != nil && p.Rssi4 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac4,
-			RSSI: p.Rssi4,
-		})
-	}
-
-	return accessPoints
+	return accessPoints(p.AccessPoints)
 }
 
 func (p Port50Payload) IsMoving() bool {
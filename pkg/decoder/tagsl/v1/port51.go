@@ -24,14 +24,9 @@ import (
 // | 17   | 1    | Time to fix                               | uint8                  |
 // | 18   | 1    | Position dilution of precision            | uint8, 1/2 meter       |
 // | 19   | 1    | Number of satellites                      | uint8, 		            |
-// | 20   | 6    | Mac 1                                     | uint8[6]               |
-// | 26   | 1    | Rssi 1                                    | int8                   |
-// | 27   | 6    | Mac 2                                     | uint8[6]               |
-// | 33   | 1    | Rssi 2                                    | int8                   |
-// | 34   | 6    | Mac 3                                     | uint8[6]               |
-// | 40   | 1    | Rssi 3                                    | int8                   |
-// | 41   | 6    | Mac 4                                     | uint8[6]               |
-// | 47   | 1    | Rssi 4                                    | int8                   |
+// | 20   | 7    | Access point (repeated up to 4 times)     | record                 |
+// | +0   | 6    |   Mac                                     | uint8[6]               |
+// | +6   | 1    |   Rssi                                    | int8                   |
 // +------+------+-------------------------------------------+------------------------+
 
 // Timestamp for the Wi-Fi scanning is TSGNSS – TTF + 10 seconds.
@@ -48,34 +43,19 @@ type Port51Payload struct {
 	TTF          time.Duration `json:"ttf"`
 	PDOP         float64       `json:"pdop"`
 	Satellites   uint8         `json:"satellites" validate:"gte=3,lte=27"`
-	Mac1         string        `json:"mac1"`
-	Rssi1        int8          `json:"rssi1" validate:"gte=-120,lte=-20"`
-	Mac2         *string       `json:"mac2"`
-	Rssi2        *int8         `json:"rssi2" validate:"gte=-120,lte=-20"`
-	Mac3         *string       `json:"mac3"`
-	Rssi3        *int8         `json:"rssi3" validate:"gte=-120,lte=-20"`
-	Mac4         *string       `json:"mac4"`
-	Rssi4        *int8         `json:"rssi4" validate:"gte=-120,lte=-20"`
+	AccessPoints []AccessPoint `json:"accessPoints"`
 }
 
 func (p Port51Payload) MarshalJSON() ([]byte, error) {
 	type Alias Port51Payload
 	return json.Marshal(&struct {
 		*Alias
-		Altitude   string  `json:"altitude"`
-		Timestamp  string  `json:"timestamp"`
-		Battery    string  `json:"battery"`
-		TTF        string  `json:"ttf"`
-		PDOP       string  `json:"pdop"`
-		Satellites uint8   `json:"satellites"`
-		Mac1       string  `json:"mac1"`
-		Rssi1      int8    `json:"rssi1"`
-		Mac2       *string `json:"mac2"`
-		Rssi2      *int8   `json:"rssi2"`
-		Mac3       *string `json:"mac3"`
-		Rssi3      *int8   `json:"rssi3"`
-		Mac4       *string `json:"mac4"`
-		Rssi4      *int8   `json:"rssi4"`
+		Altitude   string `json:"altitude"`
+		Timestamp  string `json:"timestamp"`
+		Battery    string `json:"battery"`
+		TTF        string `json:"ttf"`
+		PDOP       string `json:"pdop"`
+		Satellites uint8  `json:"satellites"`
 	}{
 		Alias:      (*Alias)(&p),
 		Altitude:   fmt.Sprintf("%.1fm", p.Altitude),
@@ -84,14 +64,6 @@ func (p Port51Payload) MarshalJSON() ([]byte, error) {
 		TTF:        p.TTF.String(),
 		PDOP:       fmt.Sprintf("%.1fm", p.PDOP),
 		Satellites: p.Satellites,
-		Mac1:       p.Mac1,
-		Rssi1:      p.Rssi1,
-		Mac2:       p.Mac2,
-		Rssi2:      p.Rssi2,
-		Mac3:       p.Mac3,
-		Rssi3:      p.Rssi3,
-		Mac4:       p.Mac4,
-		Rssi4:      p.Rssi4,
 	})
 }
 
@@ -144,37 +116,7 @@ func (p Port51Payload) GetLowBattery() *bool {
 }
 
 func (p Port51Payload) GetAccessPoints() []decoder.AccessPoint {
-	accessPoints := []decoder.AccessPoint{}
-
-	if p.Mac1 != "" && p.Rssi1 != 0 {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  p.Mac1,
-			RSSI: &p.Rssi1,
-		})
-	}
-
-	if p.Mac2 != nil && p.Rssi2 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac2,
-			RSSI: p.Rssi2,
-		})
-	}
-
-	if p.Mac3 != nil && p.Rssi3 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac3,
-			RSSI: p.Rssi3,
-		})
-	}
-
-	if p.Mac4 != nil && p.Rssi4 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac4,
-			RSSI: p.Rssi4,
-		})
-	}
-
-	return accessPoints
+	return accessPoints(p.AccessPoints)
 }
 
 func (p Port51Payload) IsMoving() bool {
@@ -15,38 +15,18 @@ import (
 // | 4    | 1    | Config change flag                        | uint1     |
 // | 4    | 1    | Reserved                                  | uint1     |
 // | 4    | 1    | Moving flag                               | uint1     |
-// | 5    | 6    | Mac 1                                     | uint8[6]  |
-// | 11   | 1    | Rssi 1                                    | int8      |
-// | 12   | 6    | Mac 2                                     | uint8[6]  |
-// | 18   | 1    | Rssi 2                                    | int8      |
-// | 19   | 6    | Mac 3                                     | uint8[6]  |
-// | 25   | 1    | Rssi 3                                    | int8      |
-// | 26   | 6    | Mac 4                                     | uint8[6]  |
-// | 32   | 1    | Rssi 4                                    | int8      |
-// | 33   | 6    | Mac 5                                     | uint8[6]  |
-// | 39   | 1    | Rssi 5                                    | int8      |
-// | 40   | 6    | Mac 6                                     | uint8[6]  |
-// | 46   | 1    | Rssi 6                                    | int8      |
+// | 5    | 7    | Access point (repeated up to 6 times)     | record    |
+// | +0   | 6    |   Mac                                     | uint8[6]  |
+// | +6   | 1    |   Rssi                                    | int8      |
 // +------+------+-------------------------------------------+-----------+
 
 type Port7Payload struct {
-	Timestamp    time.Time `json:"timestamp"`
-	DutyCycle    bool      `json:"dutyCycle"`
-	ConfigId     uint8     `json:"configId" validate:"gte=0,lte=15"`
-	ConfigChange bool      `json:"configChange"`
-	Moving       bool      `json:"moving"`
-	Mac1         string    `json:"mac1"`
-	Rssi1        int8      `json:"rssi1" validate:"gte=-120,lte=-20"`
-	Mac2         *string   `json:"mac2"`
-	Rssi2        *int8     `json:"rssi2" validate:"gte=-120,lte=-20"`
-	Mac3         *string   `json:"mac3"`
-	Rssi3        *int8     `json:"rssi3" validate:"gte=-120,lte=-20"`
-	Mac4         *string   `json:"mac4"`
-	Rssi4        *int8     `json:"rssi4" validate:"gte=-120,lte=-20"`
-	Mac5         *string   `json:"mac5"`
-	Rssi5        *int8     `json:"rssi5" validate:"gte=-120,lte=-20"`
-	Mac6         *string   `json:"mac6"`
-	Rssi6        *int8     `json:"rssi6" validate:"gte=-120,lte=-20"`
+	Timestamp    time.Time     `json:"timestamp"`
+	DutyCycle    bool          `json:"dutyCycle"`
+	ConfigId     uint8         `json:"configId" validate:"gte=0,lte=15"`
+	ConfigChange bool          `json:"configChange"`
+	Moving       bool          `json:"moving"`
+	AccessPoints []AccessPoint `json:"accessPoints"`
 }
 
 var _ decoder.UplinkFeatureTimestamp = &Port7Payload{}
@@ -60,51 +40,7 @@ func (p Port7Payload) GetTimestamp() *time.Time {
 }
 
 func (p Port7Payload) GetAccessPoints() []decoder.AccessPoint {
-	accessPoints := []decoder.AccessPoint{}
-
-	if p.Mac1 != "" && p.Rssi1 != 0 {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  p.Mac1,
-			RSSI: &p.Rssi1,
-		})
-	}
-
-	if p.Mac2 != nil && p.Rssi2 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac2,
-			RSSI: p.Rssi2,
-		})
-	}
-
-	if p.Mac3 != nil && p.Rssi3 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac3,
-			RSSI: p.Rssi3,
-		})
-	}
-
-	if p.Mac4 != nil && p.Rssi4 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac4,
-			RSSI: p.Rssi4,
-		})
-	}
-
-	if p.Mac5 != nil && p.Rssi5 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac5,
-			RSSI: p.Rssi5,
-		})
-	}
-
-	if p.Mac6 != nil && p.Rssi6 != nil {
-		accessPoints = append(accessPoints, decoder.AccessPoint{
-			MAC:  *p.Mac6,
-			RSSI: p.Rssi6,
-		})
-	}
-
-	return accessPoints
+	return accessPoints(p.AccessPoints)
 }
 
 func (p Port7Payload) IsMoving() bool {
@@ -0,0 +1,41 @@
+package tagsl
+
+import (
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+// AccessPoint is a record of the repeated access point group of the Wi-Fi ports.
+type AccessPoint struct {
+	Mac  string `json:"mac"`
+	Rssi int8   `json:"rssi" validate:"gte=-120,lte=-20"`
+}
+
+// Beacon is a record of the repeated beacon group of port 3.
+type Beacon struct {
+	Mac  string `json:"mac"`
+	Rssi int8   `json:"rssi" validate:"gte=-120,lte=-20"`
+}
+
+func accessPoints(records []AccessPoint) []decoder.AccessPoint {
+	accessPoints := []decoder.AccessPoint{}
+	for _, record := range records {
+		rssi := record.Rssi
+		accessPoints = append(accessPoints, decoder.AccessPoint{
+			MAC:  record.Mac,
+			RSSI: &rssi,
+		})
+	}
+	return accessPoints
+}
+
+func beacons(records []Beacon) []decoder.Beacon {
+	beacons := []decoder.Beacon{}
+	for _, record := range records {
+		rssi := record.Rssi
+		beacons = append(beacons, decoder.Beacon{
+			MAC:  record.Mac,
+			RSSI: &rssi,
+		})
+	}
+	return beacons
+}
@@ -0,0 +1,1657 @@
+// Code generated by decodergen. DO NOT EDIT.
+
+package tagxl
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+func init() {
+	common.RegisterGenerated(reflect.TypeOf(Port150Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 5, Length: 4, Transform: true},
+		},
+		Decode: decodePort150Payload,
+		Encode: encodePort150Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port151Payload{}), common.GeneratedCodec{
+		Tags: []common.TagLayout{
+			{Name: "AccelerometerEnabled", Tag: 0x40, Optional: true, BitOffset: 3, BitLength: 1},
+			{Name: "WifiEnabled", Tag: 0x40, Optional: true, BitOffset: 2, BitLength: 1},
+			{Name: "GnssEnabled", Tag: 0x40, Optional: true, BitOffset: 1, BitLength: 1},
+			{Name: "FirmwareUpgrade", Tag: 0x40, Optional: true, BitOffset: 0, BitLength: 1},
+			{Name: "LocalizationIntervalWhileMoving", Tag: 0x41, Optional: true, BitOffset: 16, BitLength: 16},
+			{Name: "LocalizationIntervalWhileSteady", Tag: 0x41, Optional: true, BitOffset: 0, BitLength: 16},
+			{Name: "AccelerometerWakeupThreshold", Tag: 0x42, Optional: true, BitOffset: 16, BitLength: 16},
+			{Name: "AccelerometerDelay", Tag: 0x42, Optional: true, BitOffset: 0, BitLength: 16},
+			{Name: "HeartbeatInterval", Tag: 0x43, Optional: true},
+			{Name: "AdvertisementFirmwareUpgradeInterval", Tag: 0x44, Optional: true},
+			{Name: "Battery", Tag: 0x45, Optional: true, Scale: 0.001},
+			{Name: "FirmwareHash", Tag: 0x46, Optional: true, Hex: true},
+			{Name: "RotationInvert", Tag: 0x47, Optional: true, BitOffset: 0, BitLength: 1},
+			{Name: "RotationConfirmed", Tag: 0x47, Optional: true, BitOffset: 1, BitLength: 1},
+			{Name: "ResetCount", Tag: 0x49, Optional: true},
+			{Name: "ResetCause", Tag: 0x4a, Optional: true},
+			{Name: "GnssScans", Tag: 0x4b, Optional: true, BitOffset: 16, BitLength: 16},
+			{Name: "WifiScans", Tag: 0x4b, Optional: true, BitOffset: 0, BitLength: 16},
+			{Name: "DataRate", Tag: 0x4e, Optional: true, Transform: true},
+		},
+		Decode: decodePort151Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port152Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Version", Start: 0, Length: 1},
+			{Name: "OldRotationState", Start: 2, Length: 1, Transform: true},
+			{Name: "NewRotationState", Start: 2, Length: 1, Transform: true},
+			{Name: "Timestamp", Start: 3, Length: 4, Transform: true},
+			{Name: "NumberOfRotations", Start: 7, Length: 2, Scale: 0.1},
+			{Name: "ElapsedSeconds", Start: 9, Length: 4},
+		},
+		Decode: decodePort152Payload,
+		Encode: encodePort152Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port152Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Version", Start: 0, Length: 1},
+			{Name: "SequenceNumber", Start: 2, Length: 1},
+			{Name: "OldRotationState", Start: 3, Length: 1, Transform: true},
+			{Name: "NewRotationState", Start: 3, Length: 1, Transform: true},
+			{Name: "Timestamp", Start: 4, Length: 4, Transform: true},
+			{Name: "NumberOfRotations", Start: 8, Length: 2, Scale: 0.1},
+			{Name: "ElapsedSeconds", Start: 10, Length: 4},
+		},
+		Decode: decodePort152Payload2,
+		Encode: encodePort152Payload2,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port197Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Version", Start: 0, Length: 1},
+			{Name: "Moving", Start: 0, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 1, Length: 6, MaxCount: 5, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort197Payload,
+		Encode: encodePort197Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port197Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Version", Start: 0, Length: 1},
+			{Name: "Moving", Start: 0, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 1, Length: 7, MaxCount: 5, Group: []common.FieldLayout{{Name: "Rssi", Start: 0, Length: 1}, {Name: "Mac", Start: 1, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort197Payload2,
+		Encode: encodePort197Payload2,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port198Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Version", Start: 0, Length: 1},
+			{Name: "Moving", Start: 0, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 1, Length: 6, MaxCount: 5, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort198Payload,
+		Encode: encodePort198Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port198Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Version", Start: 0, Length: 1},
+			{Name: "Moving", Start: 0, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 1, Length: 7, MaxCount: 5, Group: []common.FieldLayout{{Name: "Rssi", Start: 0, Length: 1}, {Name: "Mac", Start: 1, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort198Payload2,
+		Encode: encodePort198Payload2,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port200Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 0, Length: 4, Transform: true},
+			{Name: "Version", Start: 4, Length: 1},
+			{Name: "Moving", Start: 4, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 5, Length: 6, MaxCount: 5, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort200Payload,
+		Encode: encodePort200Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port200Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 0, Length: 4, Transform: true},
+			{Name: "Version", Start: 4, Length: 1},
+			{Name: "Moving", Start: 4, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 5, Group: []common.FieldLayout{{Name: "Rssi", Start: 0, Length: 1}, {Name: "Mac", Start: 1, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort200Payload2,
+		Encode: encodePort200Payload2,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port201Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 0, Length: 4, Transform: true},
+			{Name: "Version", Start: 4, Length: 1},
+			{Name: "Moving", Start: 4, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 5, Length: 6, MaxCount: 5, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort201Payload,
+		Encode: encodePort201Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port201Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 0, Length: 4, Transform: true},
+			{Name: "Version", Start: 4, Length: 1},
+			{Name: "Moving", Start: 4, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 5, Group: []common.FieldLayout{{Name: "Rssi", Start: 0, Length: 1}, {Name: "Mac", Start: 1, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort201Payload2,
+		Encode: encodePort201Payload2,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port212Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 0, Length: 4, Transform: true},
+			{Name: "Version", Start: 4, Length: 1},
+			{Name: "Moving", Start: 4, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 5, Length: 6, MaxCount: 5, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort212Payload,
+		Encode: encodePort212Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port212Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 0, Length: 4, Transform: true},
+			{Name: "Version", Start: 4, Length: 1},
+			{Name: "Moving", Start: 4, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 5, Group: []common.FieldLayout{{Name: "Rssi", Start: 0, Length: 1}, {Name: "Mac", Start: 1, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort212Payload2,
+		Encode: encodePort212Payload2,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port213Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 0, Length: 4, Transform: true},
+			{Name: "Version", Start: 4, Length: 1},
+			{Name: "Moving", Start: 4, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 5, Length: 6, MaxCount: 5, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort213Payload,
+		Encode: encodePort213Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port213Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 0, Length: 4, Transform: true},
+			{Name: "Version", Start: 4, Length: 1},
+			{Name: "Moving", Start: 4, Length: 1, Transform: true},
+			{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 5, Group: []common.FieldLayout{{Name: "Rssi", Start: 0, Length: 1}, {Name: "Mac", Start: 1, Length: 6, Hex: true}}},
+		},
+		Decode: decodePort213Payload2,
+		Encode: encodePort213Payload2,
+	})
+}
+
+func decodePort150Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port150Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 5, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort150Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port150Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(9)
+	var bytes []byte
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(5, 4, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort151Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port151Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	index := 3
+	for index < len(payload) {
+		tag, length, ok := reader.ReadTLV(index)
+		if !ok {
+			break
+		}
+		index += 2
+
+		raw := payload[index : index+length]
+		switch tag {
+		case 0x40:
+			// AccelerometerEnabled
+			config.Features = append(config.Features, config.Tags[0].Feature)
+			{
+				value := common.ExtractBits(raw, 3, 1)[0]&0x01 == 1
+				p.AccelerometerEnabled = &value
+			}
+
+			// WifiEnabled
+			config.Features = append(config.Features, config.Tags[1].Feature)
+			{
+				value := common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+				p.WifiEnabled = &value
+			}
+
+			// GnssEnabled
+			config.Features = append(config.Features, config.Tags[2].Feature)
+			{
+				value := common.ExtractBits(raw, 1, 1)[0]&0x01 == 1
+				p.GnssEnabled = &value
+			}
+
+			// FirmwareUpgrade
+			config.Features = append(config.Features, config.Tags[3].Feature)
+			{
+				value := common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+				p.FirmwareUpgrade = &value
+			}
+		case 0x41:
+			// LocalizationIntervalWhileMoving
+			config.Features = append(config.Features, config.Tags[4].Feature)
+			{
+				value := common.BytesToUint16(common.ExtractBits(raw, 16, 16))
+				p.LocalizationIntervalWhileMoving = &value
+			}
+			if err := common.ValidateField("LocalizationIntervalWhileMoving", p.LocalizationIntervalWhileMoving, "gte=60,lte=86400"); err != nil {
+				errs = append(errs, err)
+			}
+
+			// LocalizationIntervalWhileSteady
+			config.Features = append(config.Features, config.Tags[5].Feature)
+			{
+				value := common.BytesToUint16(common.ExtractBits(raw, 0, 16))
+				p.LocalizationIntervalWhileSteady = &value
+			}
+			if err := common.ValidateField("LocalizationIntervalWhileSteady", p.LocalizationIntervalWhileSteady, "gte=120,lte=86400"); err != nil {
+				errs = append(errs, err)
+			}
+		case 0x42:
+			// AccelerometerWakeupThreshold
+			config.Features = append(config.Features, config.Tags[6].Feature)
+			{
+				value := common.BytesToUint16(common.ExtractBits(raw, 16, 16))
+				p.AccelerometerWakeupThreshold = &value
+			}
+			if err := common.ValidateField("AccelerometerWakeupThreshold", p.AccelerometerWakeupThreshold, "gte=10,lte=8000"); err != nil {
+				errs = append(errs, err)
+			}
+
+			// AccelerometerDelay
+			config.Features = append(config.Features, config.Tags[7].Feature)
+			{
+				value := common.BytesToUint16(common.ExtractBits(raw, 0, 16))
+				p.AccelerometerDelay = &value
+			}
+			if err := common.ValidateField("AccelerometerDelay", p.AccelerometerDelay, "gte=1000,lte=10000"); err != nil {
+				errs = append(errs, err)
+			}
+		case 0x43:
+			config.Features = append(config.Features, config.Tags[8].Feature)
+			{
+				value := common.BytesToUint8(raw)
+				p.HeartbeatInterval = &value
+			}
+			if err := common.ValidateField("HeartbeatInterval", p.HeartbeatInterval, "gte=0,lte=168"); err != nil {
+				errs = append(errs, err)
+			}
+		case 0x44:
+			config.Features = append(config.Features, config.Tags[9].Feature)
+			{
+				value := common.BytesToUint8(raw)
+				p.AdvertisementFirmwareUpgradeInterval = &value
+			}
+			if err := common.ValidateField("AdvertisementFirmwareUpgradeInterval", p.AdvertisementFirmwareUpgradeInterval, "gte=1,lte=86400"); err != nil {
+				errs = append(errs, err)
+			}
+		case 0x45:
+			config.Features = append(config.Features, config.Tags[10].Feature)
+			{
+				value := float32(common.ScaledValue(raw, false, config.Tags[10].Scale, config.Tags[10].Offset))
+				p.Battery = &value
+			}
+			if err := common.ValidateField("Battery", p.Battery, "gte=1,lte=5"); err != nil {
+				errs = append(errs, err)
+			}
+		case 0x46:
+			config.Features = append(config.Features, config.Tags[11].Feature)
+			{
+				value := common.HexValue(raw)
+				p.FirmwareHash = &value
+			}
+		case 0x47:
+			// RotationInvert
+			config.Features = append(config.Features, config.Tags[12].Feature)
+			{
+				value := common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+				p.RotationInvert = &value
+			}
+
+			// RotationConfirmed
+			config.Features = append(config.Features, config.Tags[13].Feature)
+			{
+				value := common.ExtractBits(raw, 1, 1)[0]&0x01 == 1
+				p.RotationConfirmed = &value
+			}
+		case 0x49:
+			config.Features = append(config.Features, config.Tags[14].Feature)
+			{
+				value := common.BytesToUint16(raw)
+				p.ResetCount = &value
+			}
+		case 0x4a:
+			config.Features = append(config.Features, config.Tags[15].Feature)
+			{
+				value := common.BytesToUint32(raw)
+				p.ResetCause = &value
+			}
+		case 0x4b:
+			// GnssScans
+			config.Features = append(config.Features, config.Tags[16].Feature)
+			{
+				value := common.BytesToUint16(common.ExtractBits(raw, 16, 16))
+				p.GnssScans = &value
+			}
+
+			// WifiScans
+			config.Features = append(config.Features, config.Tags[17].Feature)
+			{
+				value := common.BytesToUint16(common.ExtractBits(raw, 0, 16))
+				p.WifiScans = &value
+			}
+		case 0x4e:
+			config.Features = append(config.Features, config.Tags[18].Feature)
+			if value := config.Tags[18].Transform(raw); value != nil {
+				converted := value.(decoder.DataRate)
+				p.DataRate = &converted
+			}
+		default:
+			common.SkipUnknownTLVTag(tag, length)
+		}
+		index += length
+	}
+
+	return reader.Result(p, errs)
+}
+
+func decodePort152Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port152Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Version
+	if raw, ok := reader.Read("Version", 0, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=1,lte=2"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// OldRotationState
+	if raw, ok := reader.Read("OldRotationState", 2, 1, false); ok {
+		if value := config.Fields[1].Transform(raw); value != nil {
+			p.OldRotationState = value.(uint8)
+		}
+		if err := common.ValidateField("OldRotationState", p.OldRotationState, "lte=3"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// NewRotationState
+	if raw, ok := reader.Read("NewRotationState", 2, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.NewRotationState = value.(uint8)
+		}
+		if err := common.ValidateField("NewRotationState", p.NewRotationState, "lte=3"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 3, 4, false); ok {
+		if value := config.Fields[3].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// NumberOfRotations
+	if raw, ok := reader.Read("NumberOfRotations", 7, 2, false); ok {
+		p.NumberOfRotations = float64(common.ScaledValue(raw, false, config.Fields[4].Scale, config.Fields[4].Offset))
+		if err := common.ValidateField("NumberOfRotations", p.NumberOfRotations, "gte=0"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ElapsedSeconds
+	if raw, ok := reader.Read("ElapsedSeconds", 9, 4, false); ok {
+		p.ElapsedSeconds = common.BytesToUint32(raw)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort152Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port152Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(13)
+	var bytes []byte
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(0, 1, bytes)
+
+	// OldRotationState
+	bytes = common.UintToBytes(uint64(p.OldRotationState), 1)
+	bytes = config.Fields[1].Transform(bytes).([]byte)
+	writer.Write(2, 1, bytes)
+
+	// NewRotationState
+	bytes = common.UintToBytes(uint64(p.NewRotationState), 1)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(2, 1, bytes)
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[3].Transform(bytes).([]byte)
+	writer.Write(3, 4, bytes)
+
+	// NumberOfRotations
+	bytes = common.UnscaledBytes(float64(p.NumberOfRotations), config.Fields[4].Scale, config.Fields[4].Offset, 2)
+	writer.Write(7, 2, bytes)
+
+	// ElapsedSeconds
+	bytes = common.UintToBytes(uint64(p.ElapsedSeconds), 4)
+	writer.Write(9, 4, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort152Payload2(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port152Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Version
+	if raw, ok := reader.Read("Version", 0, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=1,lte=2"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// SequenceNumber
+	if raw, ok := reader.Read("SequenceNumber", 2, 1, false); ok {
+		p.SequenceNumber = common.BytesToUint8(raw)
+		if err := common.ValidateField("SequenceNumber", p.SequenceNumber, "lte=255"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// OldRotationState
+	if raw, ok := reader.Read("OldRotationState", 3, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.OldRotationState = value.(uint8)
+		}
+		if err := common.ValidateField("OldRotationState", p.OldRotationState, "lte=3"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// NewRotationState
+	if raw, ok := reader.Read("NewRotationState", 3, 1, false); ok {
+		if value := config.Fields[3].Transform(raw); value != nil {
+			p.NewRotationState = value.(uint8)
+		}
+		if err := common.ValidateField("NewRotationState", p.NewRotationState, "lte=3"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 4, 4, false); ok {
+		if value := config.Fields[4].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// NumberOfRotations
+	if raw, ok := reader.Read("NumberOfRotations", 8, 2, false); ok {
+		p.NumberOfRotations = float64(common.ScaledValue(raw, false, config.Fields[5].Scale, config.Fields[5].Offset))
+		if err := common.ValidateField("NumberOfRotations", p.NumberOfRotations, "gte=0"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ElapsedSeconds
+	if raw, ok := reader.Read("ElapsedSeconds", 10, 4, false); ok {
+		p.ElapsedSeconds = common.BytesToUint32(raw)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort152Payload2(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port152Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(14)
+	var bytes []byte
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(0, 1, bytes)
+
+	// SequenceNumber
+	bytes = common.UintToBytes(uint64(p.SequenceNumber), 1)
+	writer.Write(2, 1, bytes)
+
+	// OldRotationState
+	bytes = common.UintToBytes(uint64(p.OldRotationState), 1)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(3, 1, bytes)
+
+	// NewRotationState
+	bytes = common.UintToBytes(uint64(p.NewRotationState), 1)
+	bytes = config.Fields[3].Transform(bytes).([]byte)
+	writer.Write(3, 1, bytes)
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[4].Transform(bytes).([]byte)
+	writer.Write(4, 4, bytes)
+
+	// NumberOfRotations
+	bytes = common.UnscaledBytes(float64(p.NumberOfRotations), config.Fields[5].Scale, config.Fields[5].Offset, 2)
+	writer.Write(8, 2, bytes)
+
+	// ElapsedSeconds
+	bytes = common.UintToBytes(uint64(p.ElapsedSeconds), 4)
+	writer.Write(10, 4, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort197Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port197Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Version
+	if raw, ok := reader.Read("Version", 0, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		if value := config.Fields[1].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 1+i*6, 6, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort197Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port197Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(31)
+	var bytes []byte
+	var err error
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(0, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[1].Transform(bytes).([]byte)
+	writer.Write(0, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(1, 6)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(1+i*6, 6)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(1+i*6, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort197Payload2(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port197Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Version
+	if raw, ok := reader.Read("Version", 0, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		if value := config.Fields[1].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 1+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		{
+			value := common.BytesToInt8(raw[0:1])
+			item.Rssi = &value
+		}
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		item.Mac = common.HexValue(raw[1:7])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort197Payload2(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port197Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(36)
+	var bytes []byte
+	var err error
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(0, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[1].Transform(bytes).([]byte)
+	writer.Write(0, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(1, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(1+i*7, 7)
+		if item.Rssi != nil {
+			bytes = common.IntToBytes(int64(*item.Rssi), 1)
+			writer.Write(1+i*7, 1, bytes)
+		} else {
+			writer.Write(1+i*7, 1, make([]byte, 1))
+		}
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(2+i*7, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort198Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port198Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Version
+	if raw, ok := reader.Read("Version", 0, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		if value := config.Fields[1].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 1+i*6, 6, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort198Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port198Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(31)
+	var bytes []byte
+	var err error
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(0, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[1].Transform(bytes).([]byte)
+	writer.Write(0, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(1, 6)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(1+i*6, 6)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(1+i*6, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort198Payload2(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port198Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Version
+	if raw, ok := reader.Read("Version", 0, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		if value := config.Fields[1].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 1+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		{
+			value := common.BytesToInt8(raw[0:1])
+			item.Rssi = &value
+		}
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		item.Mac = common.HexValue(raw[1:7])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort198Payload2(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port198Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(36)
+	var bytes []byte
+	var err error
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(0, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[1].Transform(bytes).([]byte)
+	writer.Write(0, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(1, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(1+i*7, 7)
+		if item.Rssi != nil {
+			bytes = common.IntToBytes(int64(*item.Rssi), 1)
+			writer.Write(1+i*7, 1, bytes)
+		} else {
+			writer.Write(1+i*7, 1, make([]byte, 1))
+		}
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(2+i*7, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort200Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port200Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 0, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Version
+	if raw, ok := reader.Read("Version", 4, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 4, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 5+i*6, 6, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort200Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port200Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(35)
+	var bytes []byte
+	var err error
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 4, bytes)
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(4, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(4, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(5, 6)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(5+i*6, 6)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(5+i*6, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort200Payload2(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port200Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 0, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Version
+	if raw, ok := reader.Read("Version", 4, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 4, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 5+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		{
+			value := common.BytesToInt8(raw[0:1])
+			item.Rssi = &value
+		}
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		item.Mac = common.HexValue(raw[1:7])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort200Payload2(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port200Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(40)
+	var bytes []byte
+	var err error
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 4, bytes)
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(4, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(4, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(5, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(5+i*7, 7)
+		if item.Rssi != nil {
+			bytes = common.IntToBytes(int64(*item.Rssi), 1)
+			writer.Write(5+i*7, 1, bytes)
+		} else {
+			writer.Write(5+i*7, 1, make([]byte, 1))
+		}
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(6+i*7, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort201Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port201Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 0, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Version
+	if raw, ok := reader.Read("Version", 4, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 4, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 5+i*6, 6, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort201Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port201Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(35)
+	var bytes []byte
+	var err error
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 4, bytes)
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(4, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(4, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(5, 6)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(5+i*6, 6)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(5+i*6, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort201Payload2(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port201Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 0, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Version
+	if raw, ok := reader.Read("Version", 4, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 4, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 5+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		{
+			value := common.BytesToInt8(raw[0:1])
+			item.Rssi = &value
+		}
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		item.Mac = common.HexValue(raw[1:7])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort201Payload2(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port201Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(40)
+	var bytes []byte
+	var err error
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 4, bytes)
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(4, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(4, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(5, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(5+i*7, 7)
+		if item.Rssi != nil {
+			bytes = common.IntToBytes(int64(*item.Rssi), 1)
+			writer.Write(5+i*7, 1, bytes)
+		} else {
+			writer.Write(5+i*7, 1, make([]byte, 1))
+		}
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(6+i*7, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort212Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port212Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 0, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Version
+	if raw, ok := reader.Read("Version", 4, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 4, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 5+i*6, 6, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort212Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port212Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(35)
+	var bytes []byte
+	var err error
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 4, bytes)
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(4, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(4, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(5, 6)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(5+i*6, 6)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(5+i*6, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort212Payload2(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port212Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 0, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Version
+	if raw, ok := reader.Read("Version", 4, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 4, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 5+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		{
+			value := common.BytesToInt8(raw[0:1])
+			item.Rssi = &value
+		}
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		item.Mac = common.HexValue(raw[1:7])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort212Payload2(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port212Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(40)
+	var bytes []byte
+	var err error
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 4, bytes)
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(4, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(4, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(5, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(5+i*7, 7)
+		if item.Rssi != nil {
+			bytes = common.IntToBytes(int64(*item.Rssi), 1)
+			writer.Write(5+i*7, 1, bytes)
+		} else {
+			writer.Write(5+i*7, 1, make([]byte, 1))
+		}
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(6+i*7, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort213Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port213Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 0, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Version
+	if raw, ok := reader.Read("Version", 4, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 4, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 5+i*6, 6, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort213Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port213Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(35)
+	var bytes []byte
+	var err error
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 4, bytes)
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(4, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(4, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(5, 6)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(5+i*6, 6)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(5+i*6, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort213Payload2(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port213Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 0, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Version
+	if raw, ok := reader.Read("Version", 4, 1, false); ok {
+		p.Version = common.BytesToUint8(raw)
+		if err := common.ValidateField("Version", p.Version, "gte=0,lte=1"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 4, 1, false); ok {
+		if value := config.Fields[2].Transform(raw); value != nil {
+			p.Moving = value.(bool)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 5; i++ {
+		raw, ok := reader.Read("AccessPoints", 5+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := AccessPoint{}
+		{
+			value := common.BytesToInt8(raw[0:1])
+			item.Rssi = &value
+		}
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		item.Mac = common.HexValue(raw[1:7])
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort213Payload2(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port213Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(40)
+	var bytes []byte
+	var err error
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 4, bytes)
+
+	// Version
+	bytes = common.UintToBytes(uint64(p.Version), 1)
+	writer.Write(4, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	bytes = config.Fields[2].Transform(bytes).([]byte)
+	writer.Write(4, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 5); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(5, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(5+i*7, 7)
+		if item.Rssi != nil {
+			bytes = common.IntToBytes(int64(*item.Rssi), 1)
+			writer.Write(5+i*7, 1, bytes)
+		} else {
+			writer.Write(5+i*7, 1, make([]byte, 1))
+		}
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(6+i*7, 6, bytes)
+	}
+
+	return writer.String(), nil
+}
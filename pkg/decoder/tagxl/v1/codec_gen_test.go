@@ -0,0 +1,203 @@
+package tagxl
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+var generatedTests = []struct {
+	port    uint8
+	payload string
+}{
+	{port: 150, payload: "4c07014c04681a4727"},
+	{port: 151, payload: "4c2a0940010f4104012c1c204204012c05dc43010644011e45020d4e4604f6c7d8104902000a4a0400000002"},
+	{port: 151, payload: "4c2d0a40010b410402581c204204012c05dc43010644011e45020d6c4604a25b545547010249020003"},
+	{port: 151, payload: "4c0b0245020d914b0403de0000"},
+	{port: 151, payload: "4c04014e0107"},
+	// unknown tag
+	{port: 151, payload: "4c0501ff020000"},
+	// battery out of range
+	{port: 151, payload: "4c050145020b10"},
+	{port: 152, payload: "010b1066acbe0c00a200000087"},
+	{port: 152, payload: "020c62206822f120000d00000024"},
+	{port: 197, payload: "003385f8ee30c2a0382c2601db"},
+	{port: 197, payload: "01cff0b0140c96bbcce4c32a622ea4c8e0286d8a9478b8e0286d8aabfcafa86e84e1a812"},
+	{port: 198, payload: "01d63385f8ee30c2d0a0382c2601db"},
+	{port: 200, payload: "68b9ac21003385f8ee30c2a0382c2601db"},
+	{port: 201, payload: "68bae3ab01d3f0b0140c96bbc7e4c32a622ea4c5e0286d8a9478b4e0286d8aabfcada86e84e1a812"},
+}
+
+func TestGeneratedCodec(t *testing.T) {
+	for _, test := range generatedTests {
+		t.Run(fmt.Sprintf("TestPort%vWith%v", test.port, test.payload), func(t *testing.T) {
+			bytes, err := common.HexStringToBytes(test.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			config, err := TagXLv1Decoder{}.getConfig(test.port, bytes)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !common.HasGenerated(&config) {
+				t.Fatalf("expected generated codec for port %v", test.port)
+			}
+
+			reflectConfig := config
+			reflectConfig.Features = append([]decoder.Feature{}, config.Features...)
+
+			got, gotErr := common.Decode(&test.payload, &config)
+			expected, expectedErr := common.DecodeReflect(&test.payload, &reflectConfig)
+
+			if fmt.Sprint(gotErr) != fmt.Sprint(expectedErr) {
+				t.Errorf("expected error %v, got %v", expectedErr, gotErr)
+			}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected: %v\ngot: %v", expected, got)
+			}
+			if !reflect.DeepEqual(config.Features, reflectConfig.Features) {
+				t.Errorf("expected features %v, got %v", reflectConfig.Features, config.Features)
+			}
+		})
+	}
+}
+
+func TestGeneratedCodecLenient(t *testing.T) {
+	for _, test := range generatedTests {
+		t.Run(fmt.Sprintf("TestPort%vWith%v", test.port, test.payload), func(t *testing.T) {
+			bytes, err := common.HexStringToBytes(test.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			config, err := TagXLv1Decoder{}.getConfig(test.port, bytes)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			config.Lenient = true
+
+			// drop the second half of the payload
+			payload := test.payload[:len(test.payload)/4*2]
+
+			reflectConfig := config
+			reflectConfig.Features = append([]decoder.Feature{}, config.Features...)
+
+			got, gotErr := common.Decode(&payload, &config)
+			expected, expectedErr := common.DecodeReflect(&payload, &reflectConfig)
+
+			if fmt.Sprint(gotErr) != fmt.Sprint(expectedErr) {
+				t.Errorf("expected error %v, got %v", expectedErr, gotErr)
+			}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected: %v\ngot: %v", expected, got)
+			}
+			if !reflect.DeepEqual(config.Features, reflectConfig.Features) {
+				t.Errorf("expected features %v, got %v", reflectConfig.Features, config.Features)
+			}
+		})
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	for _, test := range generatedTests {
+		bytes, err := common.HexStringToBytes(test.payload)
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+
+		config, err := TagXLv1Decoder{}.getConfig(test.port, bytes)
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+
+		// TLV payloads append to the features, always start from a copy
+		features := config.Features
+
+		b.Run(fmt.Sprintf("Port%v/Generated", test.port), func(b *testing.B) {
+			for b.Loop() {
+				config.Features = features[:len(features):len(features)]
+				_, _ = common.Decode(&test.payload, &config)
+			}
+		})
+		b.Run(fmt.Sprintf("Port%v/Reflect", test.port), func(b *testing.B) {
+			for b.Loop() {
+				config.Features = features[:len(features):len(features)]
+				_, _ = common.DecodeReflect(&test.payload, &config)
+			}
+		})
+	}
+}
+
+// TestDecodePort151Allocs bounds the allocations of BenchmarkDecodePort151, so
+// validating without the cached validation plan, which took 1996 allocations
+// per decode, fails the test.
+func TestDecodePort151Allocs(t *testing.T) {
+	payload := "4c2a0940010f4104012c1c204204012c05dc43010644011e45020d4e4604f6c7d8104902000a4a0400000002"
+	bytes, err := common.HexStringToBytes(payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config, err := TagXLv1Decoder{}.getConfig(151, bytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	features := config.Features
+
+	tests := []struct {
+		name   string
+		decode func(*string, *common.PayloadConfig) (any, error)
+		max    float64
+	}{
+		{name: "Generated", decode: common.Decode, max: 45},
+		{name: "Reflect", decode: common.DecodeReflect, max: 100},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				config.Features = features[:len(features):len(features)]
+				_, _ = test.decode(&payload, &config)
+			})
+			if allocs > test.max {
+				t.Errorf("expected at most %v allocations, got %v", test.max, allocs)
+			}
+		})
+	}
+}
+
+// BenchmarkDecodePort151 reports the allocations of a device settings payload,
+// which has the most validated tags of all ports.
+func BenchmarkDecodePort151(b *testing.B) {
+	payload := "4c2a0940010f4104012c1c204204012c05dc43010644011e45020d4e4604f6c7d8104902000a4a0400000002"
+	bytes, err := common.HexStringToBytes(payload)
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+
+	config, err := TagXLv1Decoder{}.getConfig(151, bytes)
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	features := config.Features
+
+	b.Run("Generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			config.Features = features[:len(features):len(features)]
+			_, _ = common.Decode(&payload, &config)
+		}
+	})
+	b.Run("Reflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			config.Features = features[:len(features):len(features)]
+			_, _ = common.DecodeReflect(&payload, &config)
+		}
+	})
+}
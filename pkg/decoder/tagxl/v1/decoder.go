@@ -2,6 +2,7 @@ package tagxl
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"reflect"
 	"time"
@@ -12,10 +13,13 @@ import (
 	"go.uber.org/zap"
 )
 
+//go:generate go run github.com/truvami/decoder/internal/decodergen
+
 type Option func(*TagXLv1Decoder)
 
 type TagXLv1Decoder struct {
 	skipValidation bool
+	lenient        bool
 	logger         *zap.Logger
 
 	// Legacy v1 solver for backward compatibility (kept for existing tests and ports)
@@ -25,6 +29,12 @@ type TagXLv1Decoder struct {
 	// Preferred v2 solver (used for GNSS NAV grouping ports 192/193/194/195/199/210/211 when available)
 	v2Solver         solver.SolverV2
 	fallbackV2Solver solver.SolverV2
+
+	// Optional buffer collecting the NAV messages of a GNSS-NG group for a multi-frame solver
+	multiFrameSolver solver.MultiFrameSolver
+	groupStore       common.FragmentStore
+	groupTimeout     time.Duration
+	groups           *gnssGroups
 }
 
 func NewTagXLv1Decoder(ctx context.Context, solver solver.SolverV1, logger *zap.Logger, options ...Option) decoder.Decoder {
@@ -42,6 +52,10 @@ func NewTagXLv1Decoder(ctx context.Context, solver solver.SolverV1, logger *zap.
 		option(tagXLv1Decoder)
 	}
 
+	if tagXLv1Decoder.multiFrameSolver != nil {
+		tagXLv1Decoder.groups = newGNSSGroups(tagXLv1Decoder.multiFrameSolver, tagXLv1Decoder.groupStore, tagXLv1Decoder.groupTimeout)
+	}
+
 	return tagXLv1Decoder
 }
 
@@ -51,6 +65,13 @@ func WithSkipValidation(skipValidation bool) Option {
 	}
 }
 
+// WithLenient decodes truncated payloads as far as possible, see common.PayloadConfig.Lenient.
+func WithLenient(lenient bool) Option {
+	return func(t *TagXLv1Decoder) {
+		t.lenient = lenient
+	}
+}
+
 func WithFallbackSolver(fallbackSolver solver.SolverV1) Option {
 	return func(t *TagXLv1Decoder) {
 		t.fallbackSolver = fallbackSolver
@@ -71,6 +92,30 @@ func WithFallbackSolverV2(fallback solver.SolverV2) Option {
 	}
 }
 
+// WithMultiFrameSolver collects the NAV messages of a GNSS-NG group per DevEUI
+// until the end of group flag arrives and solves them together.
+func WithMultiFrameSolver(multiFrameSolver solver.MultiFrameSolver) Option {
+	return func(t *TagXLv1Decoder) {
+		t.multiFrameSolver = multiFrameSolver
+	}
+}
+
+// WithGroupStore keeps the NAV messages of the GNSS-NG groups in the store
+// instead of in memory, e.g. to share them between several decoders.
+func WithGroupStore(store common.FragmentStore) Option {
+	return func(t *TagXLv1Decoder) {
+		t.groupStore = store
+	}
+}
+
+// WithGroupTimeout sets how long a GNSS-NG group waits for its end of group
+// flag after its first message, 5 minutes by default.
+func WithGroupTimeout(timeout time.Duration) Option {
+	return func(t *TagXLv1Decoder) {
+		t.groupTimeout = timeout
+	}
+}
+
 // https://docs.truvami.com/docs/payloads/tag-xl
 func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadConfig, error) {
 	switch port {
@@ -92,50 +137,24 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 		}
 		return common.PayloadConfig{
 			Tags: []common.TagConfig{
-				{Name: "AccelerometerEnabled", Tag: 0x40, Optional: true, Feature: decoder.FeatureConfig, Transform: func(v any) any {
-					return ((v.([]byte)[0] >> 3) & 0x01) != 0
-				}},
-				{Name: "WifiEnabled", Tag: 0x40, Optional: true, Feature: decoder.FeatureConfig, Transform: func(v any) any {
-					return ((v.([]byte)[0] >> 2) & 0x01) != 0
-				}},
-				{Name: "GnssEnabled", Tag: 0x40, Optional: true, Feature: decoder.FeatureConfig, Transform: func(v any) any {
-					return ((v.([]byte)[0] >> 1) & 0x01) != 0
-				}},
-				{Name: "FirmwareUpgrade", Tag: 0x40, Optional: true, Feature: decoder.FeatureConfig, Transform: func(v any) any {
-					return (v.([]byte)[0] & 0x01) != 0
-				}},
-				{Name: "LocalizationIntervalWhileMoving", Tag: 0x41, Optional: true, Feature: decoder.FeatureConfig, Transform: func(v any) any {
-					return uint16((common.BytesToUint32(v.([]byte)) >> 16) & 0xffff)
-				}},
-				{Name: "LocalizationIntervalWhileSteady", Tag: 0x41, Optional: true, Feature: decoder.FeatureConfig, Transform: func(v any) any {
-					return uint16(common.BytesToUint32(v.([]byte)) & 0xffff)
-				}},
-				{Name: "AccelerometerWakeupThreshold", Tag: 0x42, Optional: true, Feature: decoder.FeatureConfig, Transform: func(v any) any {
-					return uint16((common.BytesToUint32(v.([]byte)) >> 16) & 0xffff)
-				}},
-				{Name: "AccelerometerDelay", Tag: 0x42, Optional: true, Feature: decoder.FeatureConfig, Transform: func(v any) any {
-					return uint16(common.BytesToUint32(v.([]byte)) & 0xffff)
-				}},
-				{Name: "HeartbeatInterval", Tag: 0x43, Optional: true},
+				{Name: "AccelerometerEnabled", Tag: 0x40, Optional: true, Feature: decoder.FeatureConfig, BitOffset: 3, BitLength: 1},
+				{Name: "WifiEnabled", Tag: 0x40, Optional: true, Feature: decoder.FeatureConfig, BitOffset: 2, BitLength: 1},
+				{Name: "GnssEnabled", Tag: 0x40, Optional: true, Feature: decoder.FeatureConfig, BitOffset: 1, BitLength: 1},
+				{Name: "FirmwareUpgrade", Tag: 0x40, Optional: true, Feature: decoder.FeatureConfig, BitOffset: 0, BitLength: 1},
+				{Name: "LocalizationIntervalWhileMoving", Tag: 0x41, Optional: true, Feature: decoder.FeatureConfig, BitOffset: 16, BitLength: 16, Unit: "s"},
+				{Name: "LocalizationIntervalWhileSteady", Tag: 0x41, Optional: true, Feature: decoder.FeatureConfig, BitOffset: 0, BitLength: 16, Unit: "s"},
+				{Name: "AccelerometerWakeupThreshold", Tag: 0x42, Optional: true, Feature: decoder.FeatureConfig, BitOffset: 16, BitLength: 16, Unit: "mg"},
+				{Name: "AccelerometerDelay", Tag: 0x42, Optional: true, Feature: decoder.FeatureConfig, BitOffset: 0, BitLength: 16, Unit: "ms"},
+				{Name: "HeartbeatInterval", Tag: 0x43, Optional: true, Unit: "h"},
 				{Name: "AdvertisementFirmwareUpgradeInterval", Tag: 0x44, Optional: true},
-				{Name: "Battery", Tag: 0x45, Optional: true, Feature: decoder.FeatureBattery, Transform: func(v any) any {
-					return float32(common.BytesToUint16(v.([]byte))) / 1000
-				}},
+				{Name: "Battery", Tag: 0x45, Optional: true, Feature: decoder.FeatureBattery, Scale: 0.001, Unit: "V"},
 				{Name: "FirmwareHash", Tag: 0x46, Optional: true, Feature: decoder.FeatureFirmwareVersion, Hex: true},
-				{Name: "RotationInvert", Tag: 0x47, Optional: true, Transform: func(v any) any {
-					return (v.([]byte)[0] & 0x01) != 0
-				}},
-				{Name: "RotationConfirmed", Tag: 0x47, Optional: true, Transform: func(v any) any {
-					return ((v.([]byte)[0] >> 1) & 0x01) != 0
-				}},
+				{Name: "RotationInvert", Tag: 0x47, Optional: true, BitOffset: 0, BitLength: 1},
+				{Name: "RotationConfirmed", Tag: 0x47, Optional: true, BitOffset: 1, BitLength: 1},
 				{Name: "ResetCount", Tag: 0x49, Optional: true},
 				{Name: "ResetCause", Tag: 0x4a, Optional: true},
-				{Name: "GnssScans", Tag: 0x4b, Optional: true, Transform: func(v any) any {
-					return uint16((common.BytesToUint32(v.([]byte)) >> 16) & 0xffff)
-				}},
-				{Name: "WifiScans", Tag: 0x4b, Optional: true, Transform: func(v any) any {
-					return uint16(common.BytesToUint32(v.([]byte)) & 0xffff)
-				}},
+				{Name: "GnssScans", Tag: 0x4b, Optional: true, BitOffset: 16, BitLength: 16},
+				{Name: "WifiScans", Tag: 0x4b, Optional: true, BitOffset: 0, BitLength: 16},
 				{Name: "DataRate", Tag: 0x4e, Optional: true, Transform: func(v any) any {
 					if b, ok := v.([]byte); ok && len(b) > 0 {
 						return DataRateFromUint8(b[0])
@@ -163,10 +182,8 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 						return common.BytesToUint8(v.([]byte)) & 0x0f
 					}},
 					{Name: "Timestamp", Start: 3, Length: 4, Transform: timestamp},
-					{Name: "NumberOfRotations", Start: 7, Length: 2, Transform: func(v any) any {
-						return float64(common.BytesToUint16(v.([]byte))) / 10
-					}},
-					{Name: "ElapsedSeconds", Start: 9, Length: 4},
+					{Name: "NumberOfRotations", Start: 7, Length: 2, Scale: 0.1},
+					{Name: "ElapsedSeconds", Start: 9, Length: 4, Unit: "s"},
 				},
 				TargetType: reflect.TypeOf(Port152Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureRotationState, decoder.FeatureTimestamp},
@@ -183,10 +200,8 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 						return common.BytesToUint8(v.([]byte)) & 0x0f
 					}},
 					{Name: "Timestamp", Start: 4, Length: 4, Transform: timestamp},
-					{Name: "NumberOfRotations", Start: 8, Length: 2, Transform: func(v any) any {
-						return float64(common.BytesToUint16(v.([]byte))) / 10
-					}},
-					{Name: "ElapsedSeconds", Start: 10, Length: 4},
+					{Name: "NumberOfRotations", Start: 8, Length: 2, Scale: 0.1},
+					{Name: "ElapsedSeconds", Start: 10, Length: 4, Unit: "s"},
 				},
 				TargetType: reflect.TypeOf(Port152Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureSequenceNumber, decoder.FeatureRotationState, decoder.FeatureTimestamp},
@@ -205,11 +220,9 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 				Fields: []common.FieldConfig{
 					{Name: "Version", Start: 0, Length: 1},
 					{Name: "Moving", Start: 0, Length: 1, Transform: alwaysFalse},
-					{Name: "Mac1", Start: 1, Length: 6, Hex: true},
-					{Name: "Mac2", Start: 7, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac3", Start: 13, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac4", Start: 19, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac5", Start: 25, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 1, Length: 6, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port197Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving},
@@ -219,16 +232,10 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 				Fields: []common.FieldConfig{
 					{Name: "Version", Start: 0, Length: 1},
 					{Name: "Moving", Start: 0, Length: 1, Transform: alwaysFalse},
-					{Name: "Rssi1", Start: 1, Length: 1},
-					{Name: "Mac1", Start: 2, Length: 6, Hex: true},
-					{Name: "Rssi2", Start: 8, Length: 1, Optional: true},
-					{Name: "Mac2", Start: 9, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi3", Start: 15, Length: 1, Optional: true},
-					{Name: "Mac3", Start: 16, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi4", Start: 22, Length: 1, Optional: true},
-					{Name: "Mac4", Start: 23, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi5", Start: 29, Length: 1, Optional: true},
-					{Name: "Mac5", Start: 30, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 1, Length: 7, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Rssi", Start: 0, Length: 1, Unit: "dBm"},
+						{Name: "Mac", Start: 1, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port197Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving},
@@ -247,11 +254,9 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 				Fields: []common.FieldConfig{
 					{Name: "Version", Start: 0, Length: 1},
 					{Name: "Moving", Start: 0, Length: 1, Transform: alwaysTrue},
-					{Name: "Mac1", Start: 1, Length: 6, Hex: true},
-					{Name: "Mac2", Start: 7, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac3", Start: 13, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac4", Start: 19, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac5", Start: 25, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 1, Length: 6, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port198Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving},
@@ -261,16 +266,10 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 				Fields: []common.FieldConfig{
 					{Name: "Version", Start: 0, Length: 1},
 					{Name: "Moving", Start: 0, Length: 1, Transform: alwaysTrue},
-					{Name: "Rssi1", Start: 1, Length: 1},
-					{Name: "Mac1", Start: 2, Length: 6, Hex: true},
-					{Name: "Rssi2", Start: 8, Length: 1, Optional: true},
-					{Name: "Mac2", Start: 9, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi3", Start: 15, Length: 1, Optional: true},
-					{Name: "Mac3", Start: 16, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi4", Start: 22, Length: 1, Optional: true},
-					{Name: "Mac4", Start: 23, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi5", Start: 29, Length: 1, Optional: true},
-					{Name: "Mac5", Start: 30, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 1, Length: 7, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Rssi", Start: 0, Length: 1, Unit: "dBm"},
+						{Name: "Mac", Start: 1, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port198Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving},
@@ -290,11 +289,9 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 					{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
 					{Name: "Version", Start: 4, Length: 1},
 					{Name: "Moving", Start: 4, Length: 1, Transform: alwaysFalse},
-					{Name: "Mac1", Start: 5, Length: 6, Hex: true},
-					{Name: "Mac2", Start: 11, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac3", Start: 17, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac4", Start: 23, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac5", Start: 29, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 5, Length: 6, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port200Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving, decoder.FeatureTimestamp, decoder.FeatureBuffered},
@@ -305,16 +302,10 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 					{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
 					{Name: "Version", Start: 4, Length: 1},
 					{Name: "Moving", Start: 4, Length: 1, Transform: alwaysFalse},
-					{Name: "Rssi1", Start: 5, Length: 1},
-					{Name: "Mac1", Start: 6, Length: 6, Hex: true},
-					{Name: "Rssi2", Start: 12, Length: 1, Optional: true},
-					{Name: "Mac2", Start: 13, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi3", Start: 19, Length: 1, Optional: true},
-					{Name: "Mac3", Start: 20, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi4", Start: 26, Length: 1, Optional: true},
-					{Name: "Mac4", Start: 27, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi5", Start: 33, Length: 1, Optional: true},
-					{Name: "Mac5", Start: 34, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Rssi", Start: 0, Length: 1, Unit: "dBm"},
+						{Name: "Mac", Start: 1, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port200Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving, decoder.FeatureTimestamp, decoder.FeatureBuffered},
@@ -334,11 +325,9 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 					{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
 					{Name: "Version", Start: 4, Length: 1},
 					{Name: "Moving", Start: 4, Length: 1, Transform: alwaysTrue},
-					{Name: "Mac1", Start: 5, Length: 6, Hex: true},
-					{Name: "Mac2", Start: 11, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac3", Start: 17, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac4", Start: 23, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac5", Start: 29, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 5, Length: 6, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port201Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving, decoder.FeatureTimestamp, decoder.FeatureBuffered},
@@ -349,16 +338,10 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 					{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
 					{Name: "Version", Start: 4, Length: 1},
 					{Name: "Moving", Start: 4, Length: 1, Transform: alwaysTrue},
-					{Name: "Rssi1", Start: 5, Length: 1},
-					{Name: "Mac1", Start: 6, Length: 6, Hex: true},
-					{Name: "Rssi2", Start: 12, Length: 1, Optional: true},
-					{Name: "Mac2", Start: 13, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi3", Start: 19, Length: 1, Optional: true},
-					{Name: "Mac3", Start: 20, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi4", Start: 26, Length: 1, Optional: true},
-					{Name: "Mac4", Start: 27, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi5", Start: 33, Length: 1, Optional: true},
-					{Name: "Mac5", Start: 34, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Rssi", Start: 0, Length: 1, Unit: "dBm"},
+						{Name: "Mac", Start: 1, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port201Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving, decoder.FeatureTimestamp, decoder.FeatureBuffered},
@@ -378,11 +361,9 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 					{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
 					{Name: "Version", Start: 4, Length: 1},
 					{Name: "Moving", Start: 4, Length: 1, Transform: alwaysFalse},
-					{Name: "Mac1", Start: 5, Length: 6, Hex: true},
-					{Name: "Mac2", Start: 11, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac3", Start: 17, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac4", Start: 23, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac5", Start: 29, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 5, Length: 6, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port212Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving, decoder.FeatureTimestamp},
@@ -393,16 +374,10 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 					{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
 					{Name: "Version", Start: 4, Length: 1},
 					{Name: "Moving", Start: 4, Length: 1, Transform: alwaysFalse},
-					{Name: "Rssi1", Start: 5, Length: 1},
-					{Name: "Mac1", Start: 6, Length: 6, Hex: true},
-					{Name: "Rssi2", Start: 12, Length: 1, Optional: true},
-					{Name: "Mac2", Start: 13, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi3", Start: 19, Length: 1, Optional: true},
-					{Name: "Mac3", Start: 20, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi4", Start: 26, Length: 1, Optional: true},
-					{Name: "Mac4", Start: 27, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi5", Start: 33, Length: 1, Optional: true},
-					{Name: "Mac5", Start: 34, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Rssi", Start: 0, Length: 1, Unit: "dBm"},
+						{Name: "Mac", Start: 1, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port212Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving, decoder.FeatureTimestamp},
@@ -422,11 +397,9 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 					{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
 					{Name: "Version", Start: 4, Length: 1},
 					{Name: "Moving", Start: 4, Length: 1, Transform: alwaysTrue},
-					{Name: "Mac1", Start: 5, Length: 6, Hex: true},
-					{Name: "Mac2", Start: 11, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac3", Start: 17, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac4", Start: 23, Length: 6, Optional: true, Hex: true},
-					{Name: "Mac5", Start: 29, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 5, Length: 6, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port213Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving, decoder.FeatureTimestamp},
@@ -437,16 +410,10 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 					{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
 					{Name: "Version", Start: 4, Length: 1},
 					{Name: "Moving", Start: 4, Length: 1, Transform: alwaysTrue},
-					{Name: "Rssi1", Start: 5, Length: 1},
-					{Name: "Mac1", Start: 6, Length: 6, Hex: true},
-					{Name: "Rssi2", Start: 12, Length: 1, Optional: true},
-					{Name: "Mac2", Start: 13, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi3", Start: 19, Length: 1, Optional: true},
-					{Name: "Mac3", Start: 20, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi4", Start: 26, Length: 1, Optional: true},
-					{Name: "Mac4", Start: 27, Length: 6, Optional: true, Hex: true},
-					{Name: "Rssi5", Start: 33, Length: 1, Optional: true},
-					{Name: "Mac5", Start: 34, Length: 6, Optional: true, Hex: true},
+					{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 5, Group: []common.FieldConfig{
+						{Name: "Rssi", Start: 0, Length: 1, Unit: "dBm"},
+						{Name: "Mac", Start: 1, Length: 6, Hex: true},
+					}},
 				},
 				TargetType: reflect.TypeOf(Port213Payload{}),
 				Features:   []decoder.Feature{decoder.FeatureWiFi, decoder.FeatureMoving, decoder.FeatureTimestamp},
@@ -458,6 +425,72 @@ func (t TagXLv1Decoder) getConfig(port uint8, payload []byte) (common.PayloadCon
 	return common.PayloadConfig{}, fmt.Errorf("%w: port %v not supported", common.ErrPortNotSupported, port)
 }
 
+// portVersions are the versions of the versioned ports and the offset of the
+// version byte in their payloads.
+var portVersions = map[uint8]struct {
+	index    int
+	versions []uint8
+}{
+	152: {0, []uint8{Port152Version1, Port152Version2}},
+	197: {0, []uint8{Port197Version1, Port197Version2}},
+	198: {0, []uint8{Port198Version1, Port198Version2}},
+	200: {4, []uint8{Port200Version1, Port200Version2}},
+	201: {4, []uint8{Port201Version1, Port201Version2}},
+	212: {Port212VersionIndex, []uint8{Port212Version1, Port212Version2}},
+	213: {Port213VersionIndex, []uint8{Port213Version1, Port213Version2}},
+}
+
+var _ decoder.Catalog = &TagXLv1Decoder{}
+
+// Ports describes the payloads of the supported ports.
+func (t TagXLv1Decoder) Ports() []decoder.PortInfo {
+	infos := []decoder.PortInfo{}
+	for _, port := range supportedPorts {
+		switch port {
+		case 192, 193, 194, 195, 199, 210, 211:
+			infos = append(infos, decoder.PortInfo{Port: port, Solver: true, Features: []decoder.Feature{decoder.FeatureGNSS}, Fields: []decoder.FieldInfo{}, Docs: docs})
+			continue
+		case 151:
+			config, err := t.getConfig(port, []byte{0x4c})
+			if err == nil {
+				infos = append(infos, common.DescribePort(port, nil, config, docs))
+			}
+			continue
+		}
+
+		versioned, ok := portVersions[port]
+		if !ok {
+			config, err := t.getConfig(port, nil)
+			if err == nil {
+				infos = append(infos, common.DescribePort(port, nil, config, docs))
+			}
+			continue
+		}
+
+		for _, version := range versioned.versions {
+			// a payload long enough for every header with only the version byte set
+			payload := make([]byte, versioned.index+1)
+			payload[versioned.index] = version
+			config, err := t.getConfig(port, payload)
+			if err == nil {
+				infos = append(infos, common.DescribePort(port, &version, config, docs))
+			}
+		}
+	}
+	return infos
+}
+
+var _ decoder.Explainer = &TagXLv1Decoder{}
+
+// Explain describes how each field of the payload is decoded on the port.
+func (t TagXLv1Decoder) Explain(ctx context.Context, payload []byte, port uint8) ([]decoder.FieldExplanation, error) {
+	config, err := t.getConfig(port, payload)
+	if err != nil {
+		return nil, err
+	}
+	return common.Explain(payload, &config)
+}
+
 /*
 GNSS solver routing and semantics:
 - Ports 192/193/194/195/199/210/211 are GNSS NAV grouping ports. When a v2 solver is configured, we prefer it.
@@ -470,119 +503,172 @@ GNSS solver routing and semantics:
   - 210: steady (Moving=false), timestamped payload (first 4 bytes UNIX seconds), rotation-triggered
   - 211: moving (Moving=true), timestamped payload (first 4 bytes UNIX seconds), rotation-triggered
 
+- With a multi-frame solver, NAV messages are collected per group until its end (gnss_group.go).
+
 - When no v2 solver is provided:
   - Ports 194/195/210/211 are not supported (they require timestamp stripping and explicit options).
   - Ports 192/193/199 fall back to the legacy v1 solver for backward compatibility.
 */
 func (t TagXLv1Decoder) Decode(ctx context.Context, data string, port uint8) (*decoder.DecodedUplink, error) {
+	payload, err := decoder.FromHex(data)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "tagxl/v1", port, nil)
+	}
+	return t.DecodeBytes(ctx, payload, port)
+}
+
+func (t TagXLv1Decoder) DecodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	options, err := decoder.OptionsFromContext(ctx, port)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, "tagxl/v1", port, nil)
+	}
+	return t.DecodeWithOptions(ctx, payload, options)
+}
+
+var _ decoder.OptionsDecoder = &TagXLv1Decoder{}
+
+// DecodeWithOptions decodes the binary payload on options.Port with the options of the uplink.
+func (t TagXLv1Decoder) DecodeWithOptions(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	uplink, err := t.decodeBytes(ctx, payload, options)
+	uplink.SetMetadata("tagxl/v1", payload, options)
+	return uplink, common.WrapDecodeError(err, "tagxl/v1", options.Port, payload)
+}
+
+func (t TagXLv1Decoder) decodeBytes(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	port := options.Port
 	switch port {
 	// GNSS NAV grouping ports now use the v2 solver when available.
 	case 192, 193, 194, 195, 199, 210, 211:
-		if t.v2Solver != nil {
-			devEui, _ := ctx.Value(decoder.DEVEUI_CONTEXT_KEY).(string)
-			fcnt, _ := ctx.Value(decoder.FCNT_CONTEXT_KEY).(int)
-			var movingPtr *bool
-			switch port {
-			case 192, 194, 210:
-				mv := false
-				movingPtr = &mv
-			case 193, 195, 211:
-				mv := true
-				movingPtr = &mv
-			default:
-				// leave nil unless explicitly known
-				movingPtr = nil
-			}
+		if t.groups != nil {
+			return t.solveGroup(ctx, payload, options)
+		}
+		return t.solveFrame(ctx, payload, options)
 
-			var tsPtr *time.Time
-			payloadForSolve := data
-
-			// For timestamped GNSS ports (194, 195, 210, 211), strip the leading 4-byte timestamp (big-endian)
-			if port == 194 || port == 195 || port == 210 || port == 211 {
-				bytes, err := common.HexStringToBytes(data)
-				if err != nil {
-					return nil, err
-				}
-				if len(bytes) < 5 {
-					return nil, common.ErrPayloadTooShort
-				}
-				secs := common.BytesToUint32(bytes[0:4])
-				ts := time.Unix(int64(secs), 0).UTC()
-				tsPtr = &ts
-
-				// Remove first 4 bytes (8 hex chars) from payload passed to solver
-				if len(data) < 8 {
-					return nil, common.ErrPayloadTooShort
-				}
-				payloadForSolve = data[8:]
-			}
+	default:
+		config, err := t.getConfig(port, payload)
+		if err != nil {
+			return nil, err
+		}
 
-			opts := solver.SolverV2Options{
-				DevEui:        devEui,
-				UplinkCounter: uint16(fcnt),
-				Port:          192, // always 192 for GNSS NAV grouping
-				Timestamp:     tsPtr,
-				Moving:        movingPtr,
-			}
+		config.Lenient = t.lenient
 
-			uplink, err := t.v2Solver.Solve(ctx, payloadForSolve, opts)
+		if !t.skipValidation {
+			err := common.ValidateBytesLength(payload, &config)
 			if err != nil {
-				if t.fallbackV2Solver == nil {
-					tagXlDecoderSolverFailedCounter.Inc()
-					return nil, common.WrapError(err, common.ErrSolverFailed)
-				}
-				uplink, err = t.fallbackV2Solver.Solve(ctx, payloadForSolve, opts)
-				if err != nil {
-					tagXlDecoderSolverFailedCounter.Inc()
-					return nil, common.WrapError(err, common.ErrSolverFailed)
-				}
-				tagXlDecoderSuccessfullyUsedFallbackSolverCounter.Inc()
+				return nil, err
 			}
-			return uplink, nil
 		}
 
-		// Fallback to legacy v1 solver when v2 is not provided (keeps backward compatibility).
-		// Note: legacy path does not support 194/195/210/211 since v1 solver expects header as first byte.
-		if port == 194 || port == 195 || port == 210 || port == 211 {
-			return nil, fmt.Errorf("%w: port %v not supported without v2 solver", common.ErrPortNotSupported, port)
+		decodedData, err := common.DecodeBytes(payload, &config)
+		return decoder.NewDecodedUplink(config.Features, decodedData), err
+	}
+}
+
+// solveFrame solves the NAV message of a GNSS NAV grouping port on its own
+// with the v2 solver if set and the legacy v1 solver otherwise.
+func (t TagXLv1Decoder) solveFrame(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	if t.v2Solver != nil {
+		payloadForSolve, opts, err := solverV2Options(payload, options)
+		if err != nil {
+			return nil, err
 		}
-		uplink, err := t.solver.Solve(ctx, data)
+
+		source := decoder.SolverPrimary
+		uplink, err := t.v2Solver.Solve(ctx, payloadForSolve, opts)
 		if err != nil {
-			if t.fallbackSolver == nil {
+			if t.fallbackV2Solver == nil {
 				tagXlDecoderSolverFailedCounter.Inc()
 				return nil, common.WrapError(err, common.ErrSolverFailed)
 			}
-
-			uplink, err = t.fallbackSolver.Solve(ctx, data)
+			uplink, err = t.fallbackV2Solver.Solve(ctx, payloadForSolve, opts)
 			if err != nil {
 				tagXlDecoderSolverFailedCounter.Inc()
 				return nil, common.WrapError(err, common.ErrSolverFailed)
 			}
 			tagXlDecoderSuccessfullyUsedFallbackSolverCounter.Inc()
+			source = decoder.SolverFallback
+		}
+		if uplink != nil {
+			uplink.Metadata.Solver = source
 		}
 		return uplink, nil
+	}
 
-	default:
-		bytes, err := common.HexStringToBytes(data)
-		if err != nil {
-			return nil, err
+	// Fallback to legacy v1 solver when v2 is not provided (keeps backward compatibility).
+	// Note: legacy path does not support 194/195/210/211 since v1 solver expects header as first byte.
+	port := options.Port
+	if port == 194 || port == 195 || port == 210 || port == 211 {
+		return nil, fmt.Errorf("%w: port %v not supported without v2 solver", common.ErrPortNotSupported, port)
+	}
+	// solvers expect the hex encoded payload
+	data := hex.EncodeToString(payload)
+
+	source := decoder.SolverPrimary
+	uplink, err := solver.SolveV1(ctx, t.solver, data, options)
+	if err != nil {
+		if t.fallbackSolver == nil {
+			tagXlDecoderSolverFailedCounter.Inc()
+			return nil, common.WrapError(err, common.ErrSolverFailed)
 		}
 
-		config, err := t.getConfig(port, bytes)
+		uplink, err = solver.SolveV1(ctx, t.fallbackSolver, data, options)
 		if err != nil {
-			return nil, err
+			tagXlDecoderSolverFailedCounter.Inc()
+			return nil, common.WrapError(err, common.ErrSolverFailed)
 		}
+		tagXlDecoderSuccessfullyUsedFallbackSolverCounter.Inc()
+		source = decoder.SolverFallback
+	}
+	if uplink != nil {
+		uplink.Metadata.Solver = source
+	}
+	return uplink, nil
+}
 
-		if !t.skipValidation {
-			err := common.ValidateLength(&data, &config)
-			if err != nil {
-				return nil, err
-			}
+// solverV2Options returns the hex encoded payload and the options the v2
+// solvers expect for the uplink of a GNSS NAV grouping port.
+func solverV2Options(payload []byte, options decoder.DecodeOptions) (string, solver.SolverV2Options, error) {
+	var fcnt uint32
+	if options.FCnt != nil {
+		fcnt = *options.FCnt
+	}
+	var movingPtr *bool
+	switch options.Port {
+	case 192, 194, 210:
+		mv := false
+		movingPtr = &mv
+	case 193, 195, 211:
+		mv := true
+		movingPtr = &mv
+	default:
+		// leave nil unless explicitly known
+		movingPtr = nil
+	}
+
+	var tsPtr *time.Time
+	data := hex.EncodeToString(payload)
+
+	// For timestamped GNSS ports (194, 195, 210, 211), strip the leading 4-byte timestamp (big-endian)
+	switch options.Port {
+	case 194, 195, 210, 211:
+		if len(payload) < 5 {
+			return "", solver.SolverV2Options{}, common.ErrPayloadTooShort
 		}
+		secs := common.BytesToUint32(payload[0:4])
+		ts := time.Unix(int64(secs), 0).UTC()
+		tsPtr = &ts
 
-		decodedData, err := common.Decode(&data, &config)
-		return decoder.NewDecodedUplink(config.Features, decodedData), err
+		// Remove first 4 bytes (8 hex chars) from payload passed to solver
+		data = data[8:]
 	}
+
+	return data, solver.SolverV2Options{
+		DevEui:        options.DevEUI,
+		UplinkCounter: uint16(fcnt),
+		Port:          192, // always 192 for GNSS NAV grouping
+		Timestamp:     tsPtr,
+		Moving:        movingPtr,
+	}, nil
 }
 
 func timestamp(v any) any {
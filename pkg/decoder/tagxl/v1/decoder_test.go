@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -438,8 +439,9 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "003385f8ee30c2",
 			expected: Port197Payload{
-				Rssi1:   nil,
-				Mac1:    "3385f8ee30c2",
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+				},
 				Moving:  false,
 				Version: Port197Version1,
 			},
@@ -448,9 +450,10 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "003385f8ee30c2a0382c2601db",
 			expected: Port197Payload{
-				Rssi1:   nil,
-				Mac1:    "3385f8ee30c2",
-				Mac2:    helpers.StringPtr("a0382c2601db"),
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+					{Mac: "a0382c2601db"},
+				},
 				Moving:  false,
 				Version: Port197Version1,
 			},
@@ -459,10 +462,11 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "00b5eded55a313a0b8b5e86e3194a765f3ad40",
 			expected: Port197Payload{
-				Rssi1:   nil,
-				Mac1:    "b5eded55a313",
-				Mac2:    helpers.StringPtr("a0b8b5e86e31"),
-				Mac3:    helpers.StringPtr("94a765f3ad40"),
+				AccessPoints: []AccessPoint{
+					{Mac: "b5eded55a313"},
+					{Mac: "a0b8b5e86e31"},
+					{Mac: "94a765f3ad40"},
+				},
 				Moving:  false,
 				Version: Port197Version1,
 			},
@@ -471,11 +475,12 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "006fbcfdd764347e7cbff22fc500dc0af60588010161302d9c",
 			expected: Port197Payload{
-				Rssi1:   nil,
-				Mac1:    "6fbcfdd76434",
-				Mac2:    helpers.StringPtr("7e7cbff22fc5"),
-				Mac3:    helpers.StringPtr("00dc0af60588"),
-				Mac4:    helpers.StringPtr("010161302d9c"),
+				AccessPoints: []AccessPoint{
+					{Mac: "6fbcfdd76434"},
+					{Mac: "7e7cbff22fc5"},
+					{Mac: "00dc0af60588"},
+					{Mac: "010161302d9c"},
+				},
 				Moving:  false,
 				Version: Port197Version1,
 			},
@@ -484,12 +489,13 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "00218f6c166fad59ea3bdec77df72faac81784263386a455d33592a063900b",
 			expected: Port197Payload{
-				Rssi1:   nil,
-				Mac1:    "218f6c166fad",
-				Mac2:    helpers.StringPtr("59ea3bdec77d"),
-				Mac3:    helpers.StringPtr("f72faac81784"),
-				Mac4:    helpers.StringPtr("263386a455d3"),
-				Mac5:    helpers.StringPtr("3592a063900b"),
+				AccessPoints: []AccessPoint{
+					{Mac: "218f6c166fad"},
+					{Mac: "59ea3bdec77d"},
+					{Mac: "f72faac81784"},
+					{Mac: "263386a455d3"},
+					{Mac: "3592a063900b"},
+				},
 				Moving:  false,
 				Version: Port197Version1,
 			},
@@ -498,8 +504,9 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "01d63385f8ee30c2",
 			expected: Port197Payload{
-				Rssi1:   helpers.Int8Ptr(-42),
-				Mac1:    "3385f8ee30c2",
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+				},
 				Moving:  false,
 				Version: Port197Version2,
 			},
@@ -508,10 +515,10 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "01d63385f8ee30c2d0a0382c2601db",
 			expected: Port197Payload{
-				Rssi1:   helpers.Int8Ptr(-42),
-				Mac1:    "3385f8ee30c2",
-				Rssi2:   helpers.Int8Ptr(-48),
-				Mac2:    helpers.StringPtr("a0382c2601db"),
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+					{Rssi: helpers.Int8Ptr(-48), Mac: "a0382c2601db"},
+				},
 				Moving:  false,
 				Version: Port197Version2,
 			},
@@ -520,12 +527,11 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "01c8b5eded55a313c0a0b8b5e86e31b894a765f3ad40",
 			expected: Port197Payload{
-				Rssi1:   helpers.Int8Ptr(-56),
-				Mac1:    "b5eded55a313",
-				Rssi2:   helpers.Int8Ptr(-64),
-				Mac2:    helpers.StringPtr("a0b8b5e86e31"),
-				Rssi3:   helpers.Int8Ptr(-72),
-				Mac3:    helpers.StringPtr("94a765f3ad40"),
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-56), Mac: "b5eded55a313"},
+					{Rssi: helpers.Int8Ptr(-64), Mac: "a0b8b5e86e31"},
+					{Rssi: helpers.Int8Ptr(-72), Mac: "94a765f3ad40"},
+				},
 				Moving:  false,
 				Version: Port197Version2,
 			},
@@ -534,14 +540,12 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "01bd6fbcfdd76434bb7e7cbff22fc5b900dc0af60588b7010161302d9c",
 			expected: Port197Payload{
-				Rssi1:   helpers.Int8Ptr(-67),
-				Mac1:    "6fbcfdd76434",
-				Rssi2:   helpers.Int8Ptr(-69),
-				Mac2:    helpers.StringPtr("7e7cbff22fc5"),
-				Rssi3:   helpers.Int8Ptr(-71),
-				Mac3:    helpers.StringPtr("00dc0af60588"),
-				Rssi4:   helpers.Int8Ptr(-73),
-				Mac4:    helpers.StringPtr("010161302d9c"),
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-67), Mac: "6fbcfdd76434"},
+					{Rssi: helpers.Int8Ptr(-69), Mac: "7e7cbff22fc5"},
+					{Rssi: helpers.Int8Ptr(-71), Mac: "00dc0af60588"},
+					{Rssi: helpers.Int8Ptr(-73), Mac: "010161302d9c"},
+				},
 				Moving:  false,
 				Version: Port197Version2,
 			},
@@ -550,16 +554,13 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "01b7218f6c166fadb359ea3bdec77daff72faac81784ab263386a455d3a73592a063900b",
 			expected: Port197Payload{
-				Rssi1:   helpers.Int8Ptr(-73),
-				Mac1:    "218f6c166fad",
-				Rssi2:   helpers.Int8Ptr(-77),
-				Mac2:    helpers.StringPtr("59ea3bdec77d"),
-				Rssi3:   helpers.Int8Ptr(-81),
-				Mac3:    helpers.StringPtr("f72faac81784"),
-				Rssi4:   helpers.Int8Ptr(-85),
-				Mac4:    helpers.StringPtr("263386a455d3"),
-				Rssi5:   helpers.Int8Ptr(-89),
-				Mac5:    helpers.StringPtr("3592a063900b"),
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-73), Mac: "218f6c166fad"},
+					{Rssi: helpers.Int8Ptr(-77), Mac: "59ea3bdec77d"},
+					{Rssi: helpers.Int8Ptr(-81), Mac: "f72faac81784"},
+					{Rssi: helpers.Int8Ptr(-85), Mac: "263386a455d3"},
+					{Rssi: helpers.Int8Ptr(-89), Mac: "3592a063900b"},
+				},
 				Moving:  false,
 				Version: Port197Version2,
 			},
@@ -568,16 +569,13 @@ func TestDecode(t *testing.T) {
 			port:    197,
 			payload: "01cff0b0140c96bbcce4c32a622ea4c8e0286d8a9478b8e0286d8aabfcafa86e84e1a812",
 			expected: Port197Payload{
-				Mac1:    "f0b0140c96bb",
-				Rssi1:   helpers.Int8Ptr(-49),
-				Mac2:    helpers.StringPtr("e4c32a622ea4"),
-				Rssi2:   helpers.Int8Ptr(-52),
-				Mac3:    helpers.StringPtr("e0286d8a9478"),
-				Rssi3:   helpers.Int8Ptr(-56),
-				Mac4:    helpers.StringPtr("e0286d8aabfc"),
-				Rssi4:   helpers.Int8Ptr(-72),
-				Mac5:    helpers.StringPtr("a86e84e1a812"),
-				Rssi5:   helpers.Int8Ptr(-81),
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-49), Mac: "f0b0140c96bb"},
+					{Rssi: helpers.Int8Ptr(-52), Mac: "e4c32a622ea4"},
+					{Rssi: helpers.Int8Ptr(-56), Mac: "e0286d8a9478"},
+					{Rssi: helpers.Int8Ptr(-72), Mac: "e0286d8aabfc"},
+					{Rssi: helpers.Int8Ptr(-81), Mac: "a86e84e1a812"},
+				},
 				Moving:  false,
 				Version: Port197Version2,
 			},
@@ -592,8 +590,9 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "003385f8ee30c2",
 			expected: Port198Payload{
-				Rssi1:   nil,
-				Mac1:    "3385f8ee30c2",
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+				},
 				Moving:  true,
 				Version: Port198Version1,
 			},
@@ -602,9 +601,10 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "003385f8ee30c2a0382c2601db",
 			expected: Port198Payload{
-				Rssi1:   nil,
-				Mac1:    "3385f8ee30c2",
-				Mac2:    helpers.StringPtr("a0382c2601db"),
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+					{Mac: "a0382c2601db"},
+				},
 				Moving:  true,
 				Version: Port198Version1,
 			},
@@ -613,10 +613,11 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "00b5eded55a313a0b8b5e86e3194a765f3ad40",
 			expected: Port198Payload{
-				Rssi1:   nil,
-				Mac1:    "b5eded55a313",
-				Mac2:    helpers.StringPtr("a0b8b5e86e31"),
-				Mac3:    helpers.StringPtr("94a765f3ad40"),
+				AccessPoints: []AccessPoint{
+					{Mac: "b5eded55a313"},
+					{Mac: "a0b8b5e86e31"},
+					{Mac: "94a765f3ad40"},
+				},
 				Moving:  true,
 				Version: Port198Version1,
 			},
@@ -625,11 +626,12 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "006fbcfdd764347e7cbff22fc500dc0af60588010161302d9c",
 			expected: Port198Payload{
-				Rssi1:   nil,
-				Mac1:    "6fbcfdd76434",
-				Mac2:    helpers.StringPtr("7e7cbff22fc5"),
-				Mac3:    helpers.StringPtr("00dc0af60588"),
-				Mac4:    helpers.StringPtr("010161302d9c"),
+				AccessPoints: []AccessPoint{
+					{Mac: "6fbcfdd76434"},
+					{Mac: "7e7cbff22fc5"},
+					{Mac: "00dc0af60588"},
+					{Mac: "010161302d9c"},
+				},
 				Moving:  true,
 				Version: Port198Version1,
 			},
@@ -638,12 +640,13 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "00218f6c166fad59ea3bdec77df72faac81784263386a455d33592a063900b",
 			expected: Port198Payload{
-				Rssi1:   nil,
-				Mac1:    "218f6c166fad",
-				Mac2:    helpers.StringPtr("59ea3bdec77d"),
-				Mac3:    helpers.StringPtr("f72faac81784"),
-				Mac4:    helpers.StringPtr("263386a455d3"),
-				Mac5:    helpers.StringPtr("3592a063900b"),
+				AccessPoints: []AccessPoint{
+					{Mac: "218f6c166fad"},
+					{Mac: "59ea3bdec77d"},
+					{Mac: "f72faac81784"},
+					{Mac: "263386a455d3"},
+					{Mac: "3592a063900b"},
+				},
 				Moving:  true,
 				Version: Port198Version1,
 			},
@@ -652,8 +655,9 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "01d63385f8ee30c2",
 			expected: Port198Payload{
-				Rssi1:   helpers.Int8Ptr(-42),
-				Mac1:    "3385f8ee30c2",
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+				},
 				Moving:  true,
 				Version: Port198Version2,
 			},
@@ -662,10 +666,10 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "01d63385f8ee30c2d0a0382c2601db",
 			expected: Port198Payload{
-				Rssi1:   helpers.Int8Ptr(-42),
-				Mac1:    "3385f8ee30c2",
-				Rssi2:   helpers.Int8Ptr(-48),
-				Mac2:    helpers.StringPtr("a0382c2601db"),
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+					{Rssi: helpers.Int8Ptr(-48), Mac: "a0382c2601db"},
+				},
 				Moving:  true,
 				Version: Port198Version2,
 			},
@@ -674,12 +678,11 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "01c8b5eded55a313c0a0b8b5e86e31b894a765f3ad40",
 			expected: Port198Payload{
-				Rssi1:   helpers.Int8Ptr(-56),
-				Mac1:    "b5eded55a313",
-				Rssi2:   helpers.Int8Ptr(-64),
-				Mac2:    helpers.StringPtr("a0b8b5e86e31"),
-				Rssi3:   helpers.Int8Ptr(-72),
-				Mac3:    helpers.StringPtr("94a765f3ad40"),
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-56), Mac: "b5eded55a313"},
+					{Rssi: helpers.Int8Ptr(-64), Mac: "a0b8b5e86e31"},
+					{Rssi: helpers.Int8Ptr(-72), Mac: "94a765f3ad40"},
+				},
 				Moving:  true,
 				Version: Port198Version2,
 			},
@@ -688,14 +691,12 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "01bd6fbcfdd76434bb7e7cbff22fc5b900dc0af60588b7010161302d9c",
 			expected: Port198Payload{
-				Rssi1:   helpers.Int8Ptr(-67),
-				Mac1:    "6fbcfdd76434",
-				Rssi2:   helpers.Int8Ptr(-69),
-				Mac2:    helpers.StringPtr("7e7cbff22fc5"),
-				Rssi3:   helpers.Int8Ptr(-71),
-				Mac3:    helpers.StringPtr("00dc0af60588"),
-				Rssi4:   helpers.Int8Ptr(-73),
-				Mac4:    helpers.StringPtr("010161302d9c"),
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-67), Mac: "6fbcfdd76434"},
+					{Rssi: helpers.Int8Ptr(-69), Mac: "7e7cbff22fc5"},
+					{Rssi: helpers.Int8Ptr(-71), Mac: "00dc0af60588"},
+					{Rssi: helpers.Int8Ptr(-73), Mac: "010161302d9c"},
+				},
 				Moving:  true,
 				Version: Port198Version2,
 			},
@@ -704,16 +705,13 @@ func TestDecode(t *testing.T) {
 			port:    198,
 			payload: "01b7218f6c166fadb359ea3bdec77daff72faac81784ab263386a455d3a73592a063900b",
 			expected: Port198Payload{
-				Rssi1:   helpers.Int8Ptr(-73),
-				Mac1:    "218f6c166fad",
-				Rssi2:   helpers.Int8Ptr(-77),
-				Mac2:    helpers.StringPtr("59ea3bdec77d"),
-				Rssi3:   helpers.Int8Ptr(-81),
-				Mac3:    helpers.StringPtr("f72faac81784"),
-				Rssi4:   helpers.Int8Ptr(-85),
-				Mac4:    helpers.StringPtr("263386a455d3"),
-				Rssi5:   helpers.Int8Ptr(-89),
-				Mac5:    helpers.StringPtr("3592a063900b"),
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-73), Mac: "218f6c166fad"},
+					{Rssi: helpers.Int8Ptr(-77), Mac: "59ea3bdec77d"},
+					{Rssi: helpers.Int8Ptr(-81), Mac: "f72faac81784"},
+					{Rssi: helpers.Int8Ptr(-85), Mac: "263386a455d3"},
+					{Rssi: helpers.Int8Ptr(-89), Mac: "3592a063900b"},
+				},
 				Moving:  true,
 				Version: Port198Version2,
 			},
@@ -729,10 +727,11 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac21003385f8ee30c2",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "3385f8ee30c2",
-				Moving:    false,
-				Version:   Port200Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+				},
+				Moving:  false,
+				Version: Port200Version1,
 			},
 		},
 		{
@@ -740,11 +739,12 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac21003385f8ee30c2a0382c2601db",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "3385f8ee30c2",
-				Mac2:      helpers.StringPtr("a0382c2601db"),
-				Moving:    false,
-				Version:   Port200Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+					{Mac: "a0382c2601db"},
+				},
+				Moving:  false,
+				Version: Port200Version1,
 			},
 		},
 		{
@@ -752,12 +752,13 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2100b5eded55a313a0b8b5e86e3194a765f3ad40",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "b5eded55a313",
-				Mac2:      helpers.StringPtr("a0b8b5e86e31"),
-				Mac3:      helpers.StringPtr("94a765f3ad40"),
-				Moving:    false,
-				Version:   Port200Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "b5eded55a313"},
+					{Mac: "a0b8b5e86e31"},
+					{Mac: "94a765f3ad40"},
+				},
+				Moving:  false,
+				Version: Port200Version1,
 			},
 		},
 		{
@@ -765,13 +766,14 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac21006fbcfdd764347e7cbff22fc500dc0af60588010161302d9c",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "6fbcfdd76434",
-				Mac2:      helpers.StringPtr("7e7cbff22fc5"),
-				Mac3:      helpers.StringPtr("00dc0af60588"),
-				Mac4:      helpers.StringPtr("010161302d9c"),
-				Moving:    false,
-				Version:   Port200Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "6fbcfdd76434"},
+					{Mac: "7e7cbff22fc5"},
+					{Mac: "00dc0af60588"},
+					{Mac: "010161302d9c"},
+				},
+				Moving:  false,
+				Version: Port200Version1,
 			},
 		},
 		{
@@ -779,14 +781,15 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2100218f6c166fad59ea3bdec77df72faac81784263386a455d33592a063900b",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "218f6c166fad",
-				Mac2:      helpers.StringPtr("59ea3bdec77d"),
-				Mac3:      helpers.StringPtr("f72faac81784"),
-				Mac4:      helpers.StringPtr("263386a455d3"),
-				Mac5:      helpers.StringPtr("3592a063900b"),
-				Moving:    false,
-				Version:   Port200Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "218f6c166fad"},
+					{Mac: "59ea3bdec77d"},
+					{Mac: "f72faac81784"},
+					{Mac: "263386a455d3"},
+					{Mac: "3592a063900b"},
+				},
+				Moving:  false,
+				Version: Port200Version1,
 			},
 		},
 		{
@@ -794,10 +797,11 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101d63385f8ee30c2",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-42),
-				Mac1:      "3385f8ee30c2",
-				Moving:    false,
-				Version:   Port200Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+				},
+				Moving:  false,
+				Version: Port200Version2,
 			},
 		},
 		{
@@ -805,12 +809,12 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101d63385f8ee30c2d0a0382c2601db",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-42),
-				Mac1:      "3385f8ee30c2",
-				Rssi2:     helpers.Int8Ptr(-48),
-				Mac2:      helpers.StringPtr("a0382c2601db"),
-				Moving:    false,
-				Version:   Port200Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+					{Rssi: helpers.Int8Ptr(-48), Mac: "a0382c2601db"},
+				},
+				Moving:  false,
+				Version: Port200Version2,
 			},
 		},
 		{
@@ -818,14 +822,13 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101c8b5eded55a313c0a0b8b5e86e31b894a765f3ad40",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-56),
-				Mac1:      "b5eded55a313",
-				Rssi2:     helpers.Int8Ptr(-64),
-				Mac2:      helpers.StringPtr("a0b8b5e86e31"),
-				Rssi3:     helpers.Int8Ptr(-72),
-				Mac3:      helpers.StringPtr("94a765f3ad40"),
-				Moving:    false,
-				Version:   Port200Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-56), Mac: "b5eded55a313"},
+					{Rssi: helpers.Int8Ptr(-64), Mac: "a0b8b5e86e31"},
+					{Rssi: helpers.Int8Ptr(-72), Mac: "94a765f3ad40"},
+				},
+				Moving:  false,
+				Version: Port200Version2,
 			},
 		},
 		{
@@ -833,16 +836,14 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101bd6fbcfdd76434bb7e7cbff22fc5b900dc0af60588b7010161302d9c",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-67),
-				Mac1:      "6fbcfdd76434",
-				Rssi2:     helpers.Int8Ptr(-69),
-				Mac2:      helpers.StringPtr("7e7cbff22fc5"),
-				Rssi3:     helpers.Int8Ptr(-71),
-				Mac3:      helpers.StringPtr("00dc0af60588"),
-				Rssi4:     helpers.Int8Ptr(-73),
-				Mac4:      helpers.StringPtr("010161302d9c"),
-				Moving:    false,
-				Version:   Port200Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-67), Mac: "6fbcfdd76434"},
+					{Rssi: helpers.Int8Ptr(-69), Mac: "7e7cbff22fc5"},
+					{Rssi: helpers.Int8Ptr(-71), Mac: "00dc0af60588"},
+					{Rssi: helpers.Int8Ptr(-73), Mac: "010161302d9c"},
+				},
+				Moving:  false,
+				Version: Port200Version2,
 			},
 		},
 		{
@@ -850,18 +851,15 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101b7218f6c166fadb359ea3bdec77daff72faac81784ab263386a455d3a73592a063900b",
 			expected: Port200Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-73),
-				Mac1:      "218f6c166fad",
-				Rssi2:     helpers.Int8Ptr(-77),
-				Mac2:      helpers.StringPtr("59ea3bdec77d"),
-				Rssi3:     helpers.Int8Ptr(-81),
-				Mac3:      helpers.StringPtr("f72faac81784"),
-				Rssi4:     helpers.Int8Ptr(-85),
-				Mac4:      helpers.StringPtr("263386a455d3"),
-				Rssi5:     helpers.Int8Ptr(-89),
-				Mac5:      helpers.StringPtr("3592a063900b"),
-				Moving:    false,
-				Version:   Port200Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-73), Mac: "218f6c166fad"},
+					{Rssi: helpers.Int8Ptr(-77), Mac: "59ea3bdec77d"},
+					{Rssi: helpers.Int8Ptr(-81), Mac: "f72faac81784"},
+					{Rssi: helpers.Int8Ptr(-85), Mac: "263386a455d3"},
+					{Rssi: helpers.Int8Ptr(-89), Mac: "3592a063900b"},
+				},
+				Moving:  false,
+				Version: Port200Version2,
 			},
 		},
 		{
@@ -875,18 +873,15 @@ func TestDecode(t *testing.T) {
 			payload: "68bae3ab01d3f0b0140c96bbc7e4c32a622ea4c5e0286d8a9478b4e0286d8aabfcada86e84e1a812",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 5, 13, 20, 43, 0, time.UTC),
-				Mac1:      "f0b0140c96bb",
-				Rssi1:     helpers.Int8Ptr(-45),
-				Mac2:      helpers.StringPtr("e4c32a622ea4"),
-				Rssi2:     helpers.Int8Ptr(-57),
-				Mac3:      helpers.StringPtr("e0286d8a9478"),
-				Rssi3:     helpers.Int8Ptr(-59),
-				Mac4:      helpers.StringPtr("e0286d8aabfc"),
-				Rssi4:     helpers.Int8Ptr(-76),
-				Mac5:      helpers.StringPtr("a86e84e1a812"),
-				Rssi5:     helpers.Int8Ptr(-83),
-				Moving:    true,
-				Version:   Port201Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-45), Mac: "f0b0140c96bb"},
+					{Rssi: helpers.Int8Ptr(-57), Mac: "e4c32a622ea4"},
+					{Rssi: helpers.Int8Ptr(-59), Mac: "e0286d8a9478"},
+					{Rssi: helpers.Int8Ptr(-76), Mac: "e0286d8aabfc"},
+					{Rssi: helpers.Int8Ptr(-83), Mac: "a86e84e1a812"},
+				},
+				Moving:  true,
+				Version: Port201Version2,
 			},
 		},
 		{
@@ -894,10 +889,11 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac21003385f8ee30c2",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "3385f8ee30c2",
-				Moving:    true,
-				Version:   Port201Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+				},
+				Moving:  true,
+				Version: Port201Version1,
 			},
 		},
 		{
@@ -905,11 +901,12 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac21003385f8ee30c2a0382c2601db",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "3385f8ee30c2",
-				Mac2:      helpers.StringPtr("a0382c2601db"),
-				Moving:    true,
-				Version:   Port201Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+					{Mac: "a0382c2601db"},
+				},
+				Moving:  true,
+				Version: Port201Version1,
 			},
 		},
 		{
@@ -917,12 +914,13 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2100b5eded55a313a0b8b5e86e3194a765f3ad40",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "b5eded55a313",
-				Mac2:      helpers.StringPtr("a0b8b5e86e31"),
-				Mac3:      helpers.StringPtr("94a765f3ad40"),
-				Moving:    true,
-				Version:   Port201Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "b5eded55a313"},
+					{Mac: "a0b8b5e86e31"},
+					{Mac: "94a765f3ad40"},
+				},
+				Moving:  true,
+				Version: Port201Version1,
 			},
 		},
 		{
@@ -930,13 +928,14 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac21006fbcfdd764347e7cbff22fc500dc0af60588010161302d9c",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "6fbcfdd76434",
-				Mac2:      helpers.StringPtr("7e7cbff22fc5"),
-				Mac3:      helpers.StringPtr("00dc0af60588"),
-				Mac4:      helpers.StringPtr("010161302d9c"),
-				Moving:    true,
-				Version:   Port201Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "6fbcfdd76434"},
+					{Mac: "7e7cbff22fc5"},
+					{Mac: "00dc0af60588"},
+					{Mac: "010161302d9c"},
+				},
+				Moving:  true,
+				Version: Port201Version1,
 			},
 		},
 		{
@@ -944,14 +943,15 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2100218f6c166fad59ea3bdec77df72faac81784263386a455d33592a063900b",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "218f6c166fad",
-				Mac2:      helpers.StringPtr("59ea3bdec77d"),
-				Mac3:      helpers.StringPtr("f72faac81784"),
-				Mac4:      helpers.StringPtr("263386a455d3"),
-				Mac5:      helpers.StringPtr("3592a063900b"),
-				Moving:    true,
-				Version:   Port201Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "218f6c166fad"},
+					{Mac: "59ea3bdec77d"},
+					{Mac: "f72faac81784"},
+					{Mac: "263386a455d3"},
+					{Mac: "3592a063900b"},
+				},
+				Moving:  true,
+				Version: Port201Version1,
 			},
 		},
 		{
@@ -959,10 +959,11 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101d63385f8ee30c2",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-42),
-				Mac1:      "3385f8ee30c2",
-				Moving:    true,
-				Version:   Port201Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+				},
+				Moving:  true,
+				Version: Port201Version2,
 			},
 		},
 		{
@@ -970,12 +971,12 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101d63385f8ee30c2d0a0382c2601db",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-42),
-				Mac1:      "3385f8ee30c2",
-				Rssi2:     helpers.Int8Ptr(-48),
-				Mac2:      helpers.StringPtr("a0382c2601db"),
-				Moving:    true,
-				Version:   Port201Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+					{Rssi: helpers.Int8Ptr(-48), Mac: "a0382c2601db"},
+				},
+				Moving:  true,
+				Version: Port201Version2,
 			},
 		},
 		{
@@ -983,14 +984,13 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101c8b5eded55a313c0a0b8b5e86e31b894a765f3ad40",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-56),
-				Mac1:      "b5eded55a313",
-				Rssi2:     helpers.Int8Ptr(-64),
-				Mac2:      helpers.StringPtr("a0b8b5e86e31"),
-				Rssi3:     helpers.Int8Ptr(-72),
-				Mac3:      helpers.StringPtr("94a765f3ad40"),
-				Moving:    true,
-				Version:   Port201Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-56), Mac: "b5eded55a313"},
+					{Rssi: helpers.Int8Ptr(-64), Mac: "a0b8b5e86e31"},
+					{Rssi: helpers.Int8Ptr(-72), Mac: "94a765f3ad40"},
+				},
+				Moving:  true,
+				Version: Port201Version2,
 			},
 		},
 		{
@@ -998,16 +998,14 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101bd6fbcfdd76434bb7e7cbff22fc5b900dc0af60588b7010161302d9c",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-67),
-				Mac1:      "6fbcfdd76434",
-				Rssi2:     helpers.Int8Ptr(-69),
-				Mac2:      helpers.StringPtr("7e7cbff22fc5"),
-				Rssi3:     helpers.Int8Ptr(-71),
-				Mac3:      helpers.StringPtr("00dc0af60588"),
-				Rssi4:     helpers.Int8Ptr(-73),
-				Mac4:      helpers.StringPtr("010161302d9c"),
-				Moving:    true,
-				Version:   Port201Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-67), Mac: "6fbcfdd76434"},
+					{Rssi: helpers.Int8Ptr(-69), Mac: "7e7cbff22fc5"},
+					{Rssi: helpers.Int8Ptr(-71), Mac: "00dc0af60588"},
+					{Rssi: helpers.Int8Ptr(-73), Mac: "010161302d9c"},
+				},
+				Moving:  true,
+				Version: Port201Version2,
 			},
 		},
 		{
@@ -1015,18 +1013,15 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101b7218f6c166fadb359ea3bdec77daff72faac81784ab263386a455d3a73592a063900b",
 			expected: Port201Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-73),
-				Mac1:      "218f6c166fad",
-				Rssi2:     helpers.Int8Ptr(-77),
-				Mac2:      helpers.StringPtr("59ea3bdec77d"),
-				Rssi3:     helpers.Int8Ptr(-81),
-				Mac3:      helpers.StringPtr("f72faac81784"),
-				Rssi4:     helpers.Int8Ptr(-85),
-				Mac4:      helpers.StringPtr("263386a455d3"),
-				Rssi5:     helpers.Int8Ptr(-89),
-				Mac5:      helpers.StringPtr("3592a063900b"),
-				Moving:    true,
-				Version:   Port201Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-73), Mac: "218f6c166fad"},
+					{Rssi: helpers.Int8Ptr(-77), Mac: "59ea3bdec77d"},
+					{Rssi: helpers.Int8Ptr(-81), Mac: "f72faac81784"},
+					{Rssi: helpers.Int8Ptr(-85), Mac: "263386a455d3"},
+					{Rssi: helpers.Int8Ptr(-89), Mac: "3592a063900b"},
+				},
+				Moving:  true,
+				Version: Port201Version2,
 			},
 		},
 		// Port 212: WiFi, non-moving, timestamped, rotation-triggered (same format as 200, no Buffered)
@@ -1041,10 +1036,11 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac21003385f8ee30c2",
 			expected: Port212Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "3385f8ee30c2",
-				Moving:    false,
-				Version:   Port212Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+				},
+				Moving:  false,
+				Version: Port212Version1,
 			},
 		},
 		{
@@ -1052,10 +1048,11 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101d63385f8ee30c2",
 			expected: Port212Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-42),
-				Mac1:      "3385f8ee30c2",
-				Moving:    false,
-				Version:   Port212Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+				},
+				Moving:  false,
+				Version: Port212Version2,
 			},
 		},
 		{
@@ -1063,18 +1060,15 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101b7218f6c166fadb359ea3bdec77daff72faac81784ab263386a455d3a73592a063900b",
 			expected: Port212Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-73),
-				Mac1:      "218f6c166fad",
-				Rssi2:     helpers.Int8Ptr(-77),
-				Mac2:      helpers.StringPtr("59ea3bdec77d"),
-				Rssi3:     helpers.Int8Ptr(-81),
-				Mac3:      helpers.StringPtr("f72faac81784"),
-				Rssi4:     helpers.Int8Ptr(-85),
-				Mac4:      helpers.StringPtr("263386a455d3"),
-				Rssi5:     helpers.Int8Ptr(-89),
-				Mac5:      helpers.StringPtr("3592a063900b"),
-				Moving:    false,
-				Version:   Port212Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-73), Mac: "218f6c166fad"},
+					{Rssi: helpers.Int8Ptr(-77), Mac: "59ea3bdec77d"},
+					{Rssi: helpers.Int8Ptr(-81), Mac: "f72faac81784"},
+					{Rssi: helpers.Int8Ptr(-85), Mac: "263386a455d3"},
+					{Rssi: helpers.Int8Ptr(-89), Mac: "3592a063900b"},
+				},
+				Moving:  false,
+				Version: Port212Version2,
 			},
 		},
 		// Port 213: WiFi, moving, timestamped, rotation-triggered (same format as 201, no Buffered)
@@ -1089,10 +1083,11 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac21003385f8ee30c2",
 			expected: Port213Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     nil,
-				Mac1:      "3385f8ee30c2",
-				Moving:    true,
-				Version:   Port213Version1,
+				AccessPoints: []AccessPoint{
+					{Mac: "3385f8ee30c2"},
+				},
+				Moving:  true,
+				Version: Port213Version1,
 			},
 		},
 		{
@@ -1100,10 +1095,11 @@ func TestDecode(t *testing.T) {
 			payload: "68b9ac2101d63385f8ee30c2",
 			expected: Port213Payload{
 				Timestamp: time.Date(2025, 9, 4, 15, 11, 29, 0, time.UTC),
-				Rssi1:     helpers.Int8Ptr(-42),
-				Mac1:      "3385f8ee30c2",
-				Moving:    true,
-				Version:   Port213Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-42), Mac: "3385f8ee30c2"},
+				},
+				Moving:  true,
+				Version: Port213Version2,
 			},
 		},
 		{
@@ -1111,18 +1107,15 @@ func TestDecode(t *testing.T) {
 			payload: "68bae3ab01d3f0b0140c96bbc7e4c32a622ea4c5e0286d8a9478b4e0286d8aabfcada86e84e1a812",
 			expected: Port213Payload{
 				Timestamp: time.Date(2025, 9, 5, 13, 20, 43, 0, time.UTC),
-				Mac1:      "f0b0140c96bb",
-				Rssi1:     helpers.Int8Ptr(-45),
-				Mac2:      helpers.StringPtr("e4c32a622ea4"),
-				Rssi2:     helpers.Int8Ptr(-57),
-				Mac3:      helpers.StringPtr("e0286d8a9478"),
-				Rssi3:     helpers.Int8Ptr(-59),
-				Mac4:      helpers.StringPtr("e0286d8aabfc"),
-				Rssi4:     helpers.Int8Ptr(-76),
-				Mac5:      helpers.StringPtr("a86e84e1a812"),
-				Rssi5:     helpers.Int8Ptr(-83),
-				Moving:    true,
-				Version:   Port213Version2,
+				AccessPoints: []AccessPoint{
+					{Rssi: helpers.Int8Ptr(-45), Mac: "f0b0140c96bb"},
+					{Rssi: helpers.Int8Ptr(-57), Mac: "e4c32a622ea4"},
+					{Rssi: helpers.Int8Ptr(-59), Mac: "e0286d8a9478"},
+					{Rssi: helpers.Int8Ptr(-76), Mac: "e0286d8aabfc"},
+					{Rssi: helpers.Int8Ptr(-83), Mac: "a86e84e1a812"},
+				},
+				Moving:  true,
+				Version: Port213Version2,
 			},
 		},
 	}
@@ -1646,3 +1639,85 @@ func TestNewTagXLv1DecoderSolver(t *testing.T) {
 		}
 	}
 }
+
+func TestDevice(t *testing.T) {
+	device, err := decoder.Lookup("tagxl/v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the GNSS NAV ports with a timestamp require the v2 solver
+	d := NewTagXLv1Decoder(context.TODO(), solver.NoopSolver{}, zap.NewNop(), WithSolverV2(solver.MockSolverV2{}))
+
+	for port := range 256 {
+		_, err := d.DecodeBytes(context.TODO(), []byte{}, uint8(port))
+		supported := !errors.Is(err, helpers.ErrPortNotSupported)
+		if supported != slices.Contains(device.Ports, uint8(port)) {
+			t.Errorf("port %d: expected supported %v, got %v", port, !supported, supported)
+		}
+	}
+
+	ports := []uint8{}
+	for _, info := range d.(decoder.Catalog).Ports() {
+		ports = append(ports, info.Port)
+	}
+	if ports = slices.Compact(ports); !slices.Equal(ports, device.Ports) {
+		t.Errorf("expected catalog of ports %v, got %v", device.Ports, ports)
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	failing := solver.MockSolverV2{Err: errors.New("solver failed")}
+
+	tests := []struct {
+		name     string
+		port     uint8
+		payload  string
+		options  []Option
+		expected decoder.SolverSource
+	}{
+		{
+			name:    "Payload",
+			port:    150,
+			payload: "4c07014c04681a4727",
+		},
+		{
+			name:     "Primary",
+			port:     192,
+			payload:  "deadbeef",
+			options:  []Option{WithSolverV2(solver.MockSolverV2{Data: decoder.NewDecodedUplink(nil, nil)})},
+			expected: decoder.SolverPrimary,
+		},
+		{
+			name:     "Fallback",
+			port:     192,
+			payload:  "deadbeef",
+			options:  []Option{WithSolverV2(failing), WithFallbackSolverV2(solver.MockSolverV2{Data: decoder.NewDecodedUplink(nil, nil)})},
+			expected: decoder.SolverFallback,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), decoder.DEVEUI_CONTEXT_KEY, "10ce45ffe0000001")
+			ctx = context.WithValue(ctx, decoder.FCNT_CONTEXT_KEY, 7)
+
+			d := NewTagXLv1Decoder(ctx, solver.MockSolverV1{}, zap.NewNop(), test.options...)
+			uplink, err := d.Decode(ctx, test.payload, test.port)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			metadata := uplink.Metadata
+			if metadata.Port != test.port || metadata.DeviceType != "tagxl" || metadata.DecoderVersion != "v1" || metadata.Payload != test.payload {
+				t.Errorf("unexpected metadata %+v", metadata)
+			}
+			if metadata.DevEUI != "10ce45ffe0000001" || metadata.FrameCounter == nil || *metadata.FrameCounter != 7 {
+				t.Errorf("expected DevEUI and frame counter from context, got %+v", metadata)
+			}
+			if metadata.Solver != test.expected {
+				t.Errorf("expected solver %q, got %q", test.expected, metadata.Solver)
+			}
+		})
+	}
+}
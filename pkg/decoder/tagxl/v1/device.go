@@ -0,0 +1,34 @@
+package tagxl
+
+import "github.com/truvami/decoder/pkg/decoder"
+
+const docs = "https://docs.truvami.com/docs/payloads/tag-xl"
+
+// supportedPorts are the uplink ports the decoder supports.
+var supportedPorts = []uint8{150, 151, 152, 192, 193, 194, 195, 197, 198, 199, 200, 201, 210, 211, 212, 213}
+
+func init() {
+	decoder.Register(decoder.Device{
+		Name:        "tagxl",
+		Version:     "v1",
+		Description: "tag XL",
+		Ports:       supportedPorts,
+		Features: []decoder.Feature{
+			decoder.FeatureTimestamp,
+			decoder.FeatureGNSS,
+			decoder.FeatureBuffered,
+			decoder.FeatureBattery,
+			decoder.FeatureWiFi,
+			decoder.FeatureConfig,
+			decoder.FeatureMoving,
+			decoder.FeatureFirmwareVersion,
+			decoder.FeatureRotationState,
+			decoder.FeatureSequenceNumber,
+			decoder.FeatureDataRate,
+		},
+		Solver: true,
+		New: func(options decoder.DeviceOptions) (decoder.Decoder, error) {
+			return NewTagXLv1Decoder(options.Context, options.Solver, options.Logger, WithSkipValidation(options.SkipValidation), WithLenient(options.Lenient)), nil
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package tagxl
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+	"github.com/truvami/decoder/pkg/solver"
+	"go.uber.org/zap"
+)
+
+// gnssGroupPrefix prefixes the store keys of the GNSS-NG groups, followed by
+// the DevEUI and the group token.
+const gnssGroupPrefix = "tagxl/v1/gnss/"
+
+// GNSSGroupPending is the data of a NAV message whose GNSS-NG group has not
+// ended yet.
+type GNSSGroupPending struct {
+	GroupToken uint8 `json:"groupToken"`
+	// Messages is the number of messages of the group received so far.
+	Messages int `json:"messages"`
+}
+
+// gnssGroups collects the NAV messages of the GNSS-NG groups. Decoders sharing
+// a store through WithGroupStore rely on FragmentStore.Update to collect the
+// messages atomically.
+type gnssGroups struct {
+	solver  solver.MultiFrameSolver
+	store   common.FragmentStore
+	timeout time.Duration
+}
+
+func newGNSSGroups(multiFrameSolver solver.MultiFrameSolver, store common.FragmentStore, timeout time.Duration) *gnssGroups {
+	if store == nil {
+		store = common.NewMemoryFragmentStore()
+	}
+	if timeout <= 0 {
+		timeout = 5 * time.Minute
+	}
+	return &gnssGroups{
+		solver:  multiFrameSolver,
+		store:   store,
+		timeout: timeout,
+	}
+}
+
+// solveGroup adds the NAV message to its group. Once the end of group flag
+// arrives, all messages of the group are passed to the multi-frame solver. If
+// that fails, the last message is solved on its own like without groups.
+func (t TagXLv1Decoder) solveGroup(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	if options.DevEUI == "" {
+		return nil, fmt.Errorf("%w: GNSS-NG groups are collected by DevEUI", common.ErrMissingDevEUI)
+	}
+	if options.FCnt == nil {
+		return nil, fmt.Errorf("%w: GNSS-NG groups are ordered by frame counter", common.ErrMissingFrameCounter)
+	}
+
+	frame, _, err := solverV2Options(payload, options)
+	if err != nil {
+		return nil, err
+	}
+	message, _ := hex.DecodeString(frame)
+	header, err := common.DecodeGNSSNGHeader(message)
+	if err != nil {
+		return nil, err
+	}
+
+	receivedAt := time.Now()
+	if options.ReceivedAt != nil {
+		receivedAt = *options.ReceivedAt
+	}
+
+	frames, count, err := t.groups.add(ctx, options, header, payload, receivedAt)
+	if err != nil {
+		return nil, err
+	}
+	if frames == nil {
+		return decoder.NewDecodedUplink([]decoder.Feature{}, GNSSGroupPending{GroupToken: header.GroupToken, Messages: count}), nil
+	}
+
+	uplink, err := t.groups.solver.SolveFrames(ctx, frames)
+	if err == nil {
+		tagXlDecoderGNSSGroupSolvedCounter.Inc()
+		if uplink != nil {
+			uplink.Metadata.Solver = decoder.SolverPrimary
+		}
+		return uplink, nil
+	}
+
+	t.logger.Warn("multi-frame solver failed, solving the last message of the group", zap.String("devEui", options.DevEUI), zap.Uint8("groupToken", header.GroupToken), zap.Error(err))
+	uplink, err = t.solveFrame(ctx, payload, options)
+	if err != nil {
+		return nil, err
+	}
+	if uplink != nil {
+		if uplink.Metadata.Solver == decoder.SolverPrimary {
+			tagXlDecoderSuccessfullyUsedFallbackSolverCounter.Inc()
+		}
+		uplink.Metadata.Solver = decoder.SolverFallback
+	}
+	return uplink, nil
+}
+
+// add stores the uplink in the group of its token by frame counter, which
+// orders the messages and drops repeated ones. The fragments hold the port
+// followed by the payload. It returns the frames of the group at its end and
+// nil before, and the number of messages.
+func (g *gnssGroups) add(ctx context.Context, options decoder.DecodeOptions, header common.GNSSNGHeader, payload []byte, receivedAt time.Time) ([]solver.Frame, int, error) {
+	var fragments map[int][]byte
+	stale := false
+	key := fmt.Sprintf("%s%s/%d", gnssGroupPrefix, options.DevEUI, header.GroupToken)
+	err := g.store.Update(ctx, key, func(group common.FragmentGroup, ok bool) (*common.FragmentGroup, error) {
+		// the group token is reused by a new group
+		stale = ok && receivedAt.Sub(group.ReceivedAt) >= g.timeout
+		if stale {
+			ok = false
+		}
+		if !ok {
+			group = common.FragmentGroup{
+				Fragments:  map[int][]byte{},
+				ReceivedAt: receivedAt,
+			}
+		}
+		group.Fragments[int(*options.FCnt)] = append([]byte{options.Port}, payload...)
+		fragments = group.Fragments
+
+		if !header.EndOfGroup {
+			return &group, nil
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if stale {
+		tagXlDecoderGNSSGroupExpiredCounter.Inc()
+	}
+	if !header.EndOfGroup {
+		return nil, len(fragments), nil
+	}
+
+	frames := []solver.Frame{}
+	for _, index := range slices.Sorted(maps.Keys(fragments)) {
+		fcnt := uint32(index)
+		fragment := fragments[index]
+		data, opts, err := solverV2Options(fragment[1:], decoder.DecodeOptions{DevEUI: options.DevEUI, FCnt: &fcnt, Port: fragment[0]})
+		if err != nil {
+			return nil, 0, err
+		}
+		frames = append(frames, solver.Frame{Payload: data, Options: opts})
+	}
+	return frames, len(frames), nil
+}
+
+// ExpireGroups removes the GNSS-NG groups whose first message arrived at least
+// the group timeout before now without their end of group flag and returns how
+// many were removed.
+func (t TagXLv1Decoder) ExpireGroups(ctx context.Context, now time.Time) (int, error) {
+	if t.groups == nil {
+		return 0, nil
+	}
+
+	keys, err := t.groups.store.Keys(ctx, gnssGroupPrefix)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, key := range keys {
+		removed := false
+		err := t.groups.store.Update(ctx, key, func(group common.FragmentGroup, ok bool) (*common.FragmentGroup, error) {
+			removed = ok && now.Sub(group.ReceivedAt) >= t.groups.timeout
+			if ok && !removed {
+				return &group, nil
+			}
+			return nil, nil
+		})
+		if err != nil {
+			return expired, err
+		}
+		if removed {
+			tagXlDecoderGNSSGroupExpiredCounter.Inc()
+			expired++
+		}
+	}
+	return expired, nil
+}
@@ -0,0 +1,313 @@
+package tagxl
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+	"github.com/truvami/decoder/pkg/solver"
+)
+
+// captureMultiFrameSolver captures the frames passed to SolveFrames.
+type captureMultiFrameSolver struct {
+	frames [][]solver.Frame
+	resp   *decoder.DecodedUplink
+	err    error
+}
+
+func (c *captureMultiFrameSolver) SolveFrames(ctx context.Context, frames []solver.Frame) (*decoder.DecodedUplink, error) {
+	c.frames = append(c.frames, frames)
+	return c.resp, c.err
+}
+
+// payloads returns the payloads of the captured frames.
+func (c *captureMultiFrameSolver) payloads() [][]string {
+	payloads := [][]string{}
+	for _, frames := range c.frames {
+		group := []string{}
+		for _, frame := range frames {
+			group = append(group, frame.Payload)
+		}
+		payloads = append(payloads, group)
+	}
+	return payloads
+}
+
+func TestGNSSGroups(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type message struct {
+		devEui  string
+		port    uint8
+		fcnt    uint32
+		payload string
+		offset  time.Duration
+	}
+
+	tests := []struct {
+		name     string
+		messages []message
+		expected [][]string
+	}{
+		{
+			name:     "single message",
+			messages: []message{{port: 192, fcnt: 1, payload: "85aabb"}},
+			expected: [][]string{{"85aabb"}},
+		},
+		{
+			name: "in order",
+			messages: []message{
+				{port: 192, fcnt: 1, payload: "05aabb"},
+				{port: 192, fcnt: 2, payload: "05ccdd"},
+				{port: 192, fcnt: 3, payload: "85eeff"},
+			},
+			expected: [][]string{{"05aabb", "05ccdd", "85eeff"}},
+		},
+		{
+			name: "out of order and repeated",
+			messages: []message{
+				{port: 193, fcnt: 3, payload: "05ccdd"},
+				{port: 193, fcnt: 2, payload: "05aabb"},
+				{port: 193, fcnt: 3, payload: "05ccdd"},
+				{port: 193, fcnt: 4, payload: "85eeff"},
+			},
+			expected: [][]string{{"05aabb", "05ccdd", "85eeff"}},
+		},
+		{
+			name: "timestamped",
+			messages: []message{
+				{port: 194, fcnt: 1, payload: "6553f10005aabb"},
+				{port: 194, fcnt: 2, payload: "6553f10885ccdd"},
+			},
+			expected: [][]string{{"05aabb", "85ccdd"}},
+		},
+		{
+			name: "tokens and devices",
+			messages: []message{
+				{port: 192, fcnt: 1, payload: "05aabb"},
+				{port: 192, fcnt: 2, payload: "06ccdd"},
+				{devEui: "0000000000000001", port: 192, fcnt: 1, payload: "05eeff"},
+				{port: 192, fcnt: 3, payload: "8600aa"},
+				{port: 192, fcnt: 4, payload: "8500bb"},
+			},
+			expected: [][]string{{"06ccdd", "8600aa"}, {"05aabb", "8500bb"}},
+		},
+		{
+			name: "expired",
+			messages: []message{
+				{port: 192, fcnt: 1, payload: "05aabb"},
+				{port: 192, fcnt: 2, payload: "85ccdd", offset: 10 * time.Minute},
+			},
+			expected: [][]string{{"85ccdd"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := &captureMultiFrameSolver{
+				resp: decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureGNSS}, &fakeGNSSData{lat: 47, lon: 8}),
+			}
+			dec := NewTagXLv1Decoder(context.TODO(), solver.MockSolverV1{}, newLogger(), WithMultiFrameSolver(capture))
+
+			for _, message := range test.messages {
+				devEui := message.devEui
+				if devEui == "" {
+					devEui = "0011223344556677"
+				}
+				fcnt := message.fcnt
+				receivedAt := start.Add(message.offset)
+				payload, _ := hex.DecodeString(message.payload)
+
+				uplink, err := decoder.DecodeWithOptions(context.Background(), dec, payload, decoder.DecodeOptions{DevEUI: devEui, FCnt: &fcnt, Port: message.port, ReceivedAt: &receivedAt})
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if payload[len(payload)-3]&common.GNSSNGHeaderEndOfGroupMask == 0 {
+					if _, ok := uplink.Data.(GNSSGroupPending); !ok {
+						t.Fatalf("expected pending group, got %T", uplink.Data)
+					}
+				} else if uplink.Metadata.Solver != decoder.SolverPrimary {
+					t.Fatalf("expected solved uplink, got %+v", uplink.Metadata)
+				}
+			}
+
+			if !reflect.DeepEqual(capture.payloads(), test.expected) {
+				t.Errorf("expected frames %v, got %v", test.expected, capture.payloads())
+			}
+		})
+	}
+}
+
+func TestGNSSGroupsOptions(t *testing.T) {
+	capture := &captureMultiFrameSolver{resp: decoder.NewDecodedUplink([]decoder.Feature{}, nil)}
+	dec := NewTagXLv1Decoder(context.TODO(), solver.MockSolverV1{}, newLogger(), WithMultiFrameSolver(capture))
+
+	for i, data := range []string{"6553f10005aabb", "6553f10885aabb"} {
+		fcnt := uint32(70000 + i)
+		payload, _ := hex.DecodeString(data)
+		_, err := decoder.DecodeWithOptions(context.Background(), dec, payload, decoder.DecodeOptions{DevEUI: "0011223344556677", FCnt: &fcnt, Port: uint8(210 + i)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// every frame keeps the options of its own uplink
+	for i, frame := range capture.frames[0] {
+		options := frame.Options
+		if options.DevEui != "0011223344556677" || options.UplinkCounter != uint16(70000+i) || options.Port != 192 {
+			t.Errorf("unexpected options %+v", options)
+		}
+		if options.Moving == nil || *options.Moving != (i == 1) {
+			t.Errorf("expected moving %v, got %v", i == 1, options.Moving)
+		}
+		if options.Timestamp == nil || !options.Timestamp.Equal(time.Unix(int64(1700000000+8*i), 0)) {
+			t.Errorf("unexpected timestamp %v", options.Timestamp)
+		}
+	}
+}
+
+func TestGNSSGroupsFallback(t *testing.T) {
+	solved := decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureGNSS}, &fakeGNSSData{lat: 47, lon: 8})
+
+	tests := []struct {
+		name     string
+		options  []Option
+		expected error
+	}{
+		{
+			name:    "v2 solver",
+			options: []Option{WithSolverV2(solver.MockSolverV2{Data: solved})},
+		},
+		{
+			name: "v1 solver",
+		},
+		{
+			name:     "failed",
+			options:  []Option{WithSolverV2(solver.MockSolverV2{Err: errors.New("unavailable")})},
+			expected: common.ErrSolverFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := append(test.options, WithMultiFrameSolver(solver.MockMultiFrameSolver{Err: errors.New("unavailable")}))
+			dec := NewTagXLv1Decoder(context.TODO(), solver.MockSolverV1{Data: solved}, newLogger(), options...)
+
+			fcnt := uint32(1)
+			payload, _ := hex.DecodeString("85aabb")
+			uplink, err := decoder.DecodeWithOptions(context.Background(), dec, payload, decoder.DecodeOptions{DevEUI: "0011223344556677", FCnt: &fcnt, Port: 192})
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+			if test.expected == nil && uplink.Metadata.Solver != decoder.SolverFallback {
+				t.Errorf("expected fallback solver, got %+v", uplink.Metadata)
+			}
+		})
+	}
+}
+
+func TestExpireGroups(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := common.NewMemoryFragmentStore()
+	dec := NewTagXLv1Decoder(context.TODO(), solver.MockSolverV1{}, newLogger(),
+		WithMultiFrameSolver(solver.MockMultiFrameSolver{}),
+		WithGroupStore(store),
+		WithGroupTimeout(time.Minute),
+	)
+
+	for i, payload := range []string{"05aabb", "06ccdd"} {
+		fcnt := uint32(i)
+		receivedAt := start.Add(time.Duration(i) * 30 * time.Second)
+		bytes, _ := hex.DecodeString(payload)
+		_, err := decoder.DecodeWithOptions(context.Background(), dec, bytes, decoder.DecodeOptions{DevEUI: "0011223344556677", FCnt: &fcnt, Port: 192, ReceivedAt: &receivedAt})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	expired, err := dec.(*TagXLv1Decoder).ExpireGroups(context.Background(), start.Add(time.Minute))
+	if err != nil || expired != 1 {
+		t.Fatalf("expected 1 expired group, got %d %v", expired, err)
+	}
+
+	keys, _ := store.Keys(context.Background(), "")
+	if !reflect.DeepEqual(keys, []string{"tagxl/v1/gnss/0011223344556677/6"}) {
+		t.Errorf("unexpected keys %v", keys)
+	}
+}
+
+func TestGNSSGroupsErrors(t *testing.T) {
+	fcnt := uint32(1)
+
+	tests := []struct {
+		name     string
+		port     uint8
+		payload  string
+		options  decoder.DecodeOptions
+		expected error
+	}{
+		{name: "empty", port: 192, payload: "", expected: common.ErrGNSSNGHeaderByteMissing},
+		{name: "short timestamp", port: 194, payload: "6553f1", expected: common.ErrPayloadTooShort},
+		{name: "no DevEUI", port: 192, payload: "85aabb", options: decoder.DecodeOptions{FCnt: &fcnt}, expected: common.ErrMissingDevEUI},
+		{name: "no frame counter", port: 192, payload: "85aabb", options: decoder.DecodeOptions{DevEUI: "0011223344556677"}, expected: common.ErrMissingFrameCounter},
+		{name: "no single-frame solver", port: 194, payload: "6553f10085aabb", expected: common.ErrPortNotSupported},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dec := NewTagXLv1Decoder(context.TODO(), solver.MockSolverV1{}, newLogger(), WithMultiFrameSolver(solver.MockMultiFrameSolver{Err: errors.New("unavailable")}))
+			payload, _ := hex.DecodeString(test.payload)
+			options := test.options
+			if options.DevEUI == "" && options.FCnt == nil {
+				options = decoder.DecodeOptions{DevEUI: "0011223344556677", FCnt: &fcnt}
+			}
+			options.Port = test.port
+			_, err := decoder.DecodeWithOptions(context.Background(), dec, payload, options)
+			if !errors.Is(err, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestGNSSGroupsSharedStore(t *testing.T) {
+	store := common.NewMemoryFragmentStore()
+	capture := &captureMultiFrameSolver{resp: decoder.NewDecodedUplink([]decoder.Feature{}, nil)}
+	payloads := []string{"05aabb", "05ccdd", "05eeff"}
+
+	var wg sync.WaitGroup
+	for i, data := range payloads {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// every message is decoded by another decoder sharing the store
+			dec := NewTagXLv1Decoder(context.TODO(), solver.MockSolverV1{}, newLogger(), WithMultiFrameSolver(capture), WithGroupStore(store))
+			fcnt := uint32(i)
+			payload, _ := hex.DecodeString(data)
+			_, err := decoder.DecodeWithOptions(context.Background(), dec, payload, decoder.DecodeOptions{DevEUI: "0011223344556677", FCnt: &fcnt, Port: 192})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	fcnt := uint32(len(payloads))
+	payload, _ := hex.DecodeString("8500aa")
+	dec := NewTagXLv1Decoder(context.TODO(), solver.MockSolverV1{}, newLogger(), WithMultiFrameSolver(capture), WithGroupStore(store))
+	_, err := decoder.DecodeWithOptions(context.Background(), dec, payload, decoder.DecodeOptions{DevEUI: "0011223344556677", FCnt: &fcnt, Port: 192})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := [][]string{{"05aabb", "05ccdd", "05eeff", "8500aa"}}
+	if !reflect.DeepEqual(capture.payloads(), expected) {
+		t.Errorf("expected frames %v, got %v", expected, capture.payloads())
+	}
+}
@@ -3,6 +3,8 @@ package tagxl
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -271,3 +273,46 @@ func TestGNSS_SolverV2_194_195_TimestampStrippedAndPassed(t *testing.T) {
 		t.Fatalf("expected GNSS and Timestamp features in result")
 	}
 }
+
+type captureOptionsSolverV1 struct {
+	lastOptions decoder.DecodeOptions
+}
+
+func (c *captureOptionsSolverV1) Solve(ctx context.Context, payload string) (*decoder.DecodedUplink, error) {
+	return nil, errors.New("expected SolveWithOptions")
+}
+
+func (c *captureOptionsSolverV1) SolveWithOptions(ctx context.Context, payload string, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	c.lastOptions = options
+	return decoder.NewDecodedUplink(nil, nil), nil
+}
+
+func TestDecodeWithOptions(t *testing.T) {
+	fcnt := uint32(70000)
+	options := decoder.DecodeOptions{DevEUI: "0011223344556677", FCnt: &fcnt, Port: 194}
+	payload := []byte{0x68, 0xba, 0xd3, 0x25, 0x09, 0xab}
+
+	v2 := &captureSolverV2{resp: decoder.NewDecodedUplink(nil, nil)}
+	d := NewTagXLv1Decoder(context.TODO(), solver.MockSolverV1{}, newLogger(), WithSolverV2(v2))
+	uplink, err := decoder.DecodeWithOptions(context.Background(), d, payload, options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v2.lastOptions.DevEui != options.DevEUI || v2.lastOptions.UplinkCounter != uint16(fcnt) {
+		t.Errorf("expected DevEUI and 16-bit counter from options, got %+v", v2.lastOptions)
+	}
+	if uplink.Metadata.FrameCounter == nil || *uplink.Metadata.FrameCounter != fcnt {
+		t.Errorf("expected 32-bit frame counter in metadata, got %+v", uplink.Metadata)
+	}
+
+	options.Port = 192
+	v1 := &captureOptionsSolverV1{}
+	d = NewTagXLv1Decoder(context.TODO(), v1, newLogger())
+	_, err = decoder.DecodeWithOptions(context.Background(), d, payload, options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(v1.lastOptions, options) {
+		t.Errorf("expected options %+v, got %+v", options, v1.lastOptions)
+	}
+}
@@ -14,4 +14,12 @@ var (
 		Name: "truvami_tagxl_v1_decoder_successfully_used_fallback_solver_total",
 		Help: "The total number of tag XL decodes that successfully used a fallback solver",
 	})
+	tagXlDecoderGNSSGroupSolvedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "truvami_tagxl_v1_decoder_gnss_group_solved_total",
+		Help: "The total number of tag XL GNSS-NG groups solved with a multi-frame solver",
+	})
+	tagXlDecoderGNSSGroupExpiredCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "truvami_tagxl_v1_decoder_gnss_group_expired_total",
+		Help: "The total number of tag XL GNSS-NG groups dropped before their end of group flag arrived",
+	})
 )
@@ -0,0 +1,23 @@
+package tagxl
+
+import (
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+// AccessPoint is a record of the repeated access point group of the Wi-Fi ports.
+// Version 1 payloads carry no RSSI values.
+type AccessPoint struct {
+	Rssi *int8  `json:"rssi" validate:"gte=-120,lte=-20"`
+	Mac  string `json:"mac"`
+}
+
+func accessPoints(records []AccessPoint) []decoder.AccessPoint {
+	accessPoints := []decoder.AccessPoint{}
+	for _, record := range records {
+		accessPoints = append(accessPoints, decoder.AccessPoint{
+			MAC:  record.Mac,
+			RSSI: record.Rssi,
+		})
+	}
+	return accessPoints
+}
@@ -0,0 +1,273 @@
+package decoder
+
+import "time"
+
+// Telemetry is a device agnostic record of a decoded uplink. It is filled from
+// the feature interfaces the decoded data implements, a section is nil if the
+// uplink does not have the feature.
+type Telemetry struct {
+	Features  []Feature  `json:"features"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+
+	Moving         *bool `json:"moving,omitempty"`
+	DutyCycle      *bool `json:"dutyCycle,omitempty"`
+	ButtonPressed  *bool `json:"buttonPressed,omitempty"`
+	SequenceNumber *uint `json:"sequenceNumber,omitempty"`
+
+	Buffer      *TelemetryBuffer      `json:"buffer,omitempty"`
+	Position    *TelemetryPosition    `json:"position,omitempty"`
+	Indoor      *TelemetryIndoor      `json:"indoor,omitempty"`
+	Battery     *TelemetryBattery     `json:"battery,omitempty"`
+	Environment *TelemetryEnvironment `json:"environment,omitempty"`
+	WiFi        *TelemetryWiFi        `json:"wifi,omitempty"`
+	Ble         *TelemetryBle         `json:"ble,omitempty"`
+	Config      *TelemetryConfig      `json:"config,omitempty"`
+	Firmware    *TelemetryFirmware    `json:"firmware,omitempty"`
+	Rotation    *TelemetryRotation    `json:"rotation,omitempty"`
+	Reset       *TelemetryReset       `json:"reset,omitempty"`
+}
+
+// TelemetryBuffer describes uplinks which were buffered on the device.
+type TelemetryBuffer struct {
+	Buffered bool    `json:"buffered"`
+	Level    *uint16 `json:"level,omitempty"`
+}
+
+// TelemetryPosition is a GNSS position, see UplinkFeatureGNSS.
+type TelemetryPosition struct {
+	Latitude   float64        `json:"latitude"`
+	Longitude  float64        `json:"longitude"`
+	Altitude   float64        `json:"altitude"`
+	Accuracy   *float64       `json:"accuracy,omitempty"`
+	TTF        *time.Duration `json:"ttf,omitempty"`
+	PDOP       *float64       `json:"pdop,omitempty"`
+	Satellites *uint8         `json:"satellites,omitempty"`
+}
+
+// TelemetryIndoor is an indoor position, see UplinkFeatureIndoorPosition.
+type TelemetryIndoor struct {
+	Latitude  float64  `json:"latitude"`
+	Longitude float64  `json:"longitude"`
+	Accuracy  *float64 `json:"accuracy,omitempty"`
+	Floor     *int     `json:"floor,omitempty"`
+	Zone      string   `json:"zone,omitempty"`
+}
+
+// TelemetryBattery holds the battery and photovoltaic voltage of the device.
+type TelemetryBattery struct {
+	Voltage             *float64 `json:"voltage,omitempty"`
+	Low                 *bool    `json:"low,omitempty"`
+	PhotovoltaicVoltage *float32 `json:"photovoltaicVoltage,omitempty"`
+}
+
+// TelemetryEnvironment holds the sensor values of the environment of the device.
+type TelemetryEnvironment struct {
+	Temperature *float32 `json:"temperature,omitempty"`
+	Humidity    *float32 `json:"humidity,omitempty"`
+	Pressure    *float32 `json:"pressure,omitempty"`
+}
+
+// TelemetryWiFi holds the WiFi access points detected by the device.
+type TelemetryWiFi struct {
+	AccessPoints []AccessPoint `json:"accessPoints"`
+	// Position is the position resolved from the access points, if any.
+	Position *WifiPosition `json:"position,omitempty"`
+}
+
+// TelemetryBle holds the BLE beacons detected by the device.
+type TelemetryBle struct {
+	Beacons []Beacon `json:"beacons"`
+}
+
+// TelemetryConfig is the configuration of the device, see UplinkFeatureConfig
+// and UplinkFeatureConfigChange.
+type TelemetryConfig struct {
+	ConfigId                 *uint8    `json:"configId,omitempty"`
+	ConfigChange             *bool     `json:"configChange,omitempty"`
+	Ble                      *bool     `json:"ble,omitempty"`
+	Gnss                     *bool     `json:"gnss,omitempty"`
+	Wifi                     *bool     `json:"wifi,omitempty"`
+	Acceleration             *bool     `json:"acceleration,omitempty"`
+	MovingInterval           *uint32   `json:"movingInterval,omitempty"`
+	SteadyInterval           *uint32   `json:"steadyInterval,omitempty"`
+	ConfigInterval           *uint32   `json:"configInterval,omitempty"`
+	GnssTimeout              *uint16   `json:"gnssTimeout,omitempty"`
+	AccelerometerThreshold   *uint16   `json:"accelerometerThreshold,omitempty"`
+	AccelerometerDelay       *uint16   `json:"accelerometerDelay,omitempty"`
+	BatteryInterval          *uint32   `json:"batteryInterval,omitempty"`
+	RejoinInterval           *uint32   `json:"rejoinInterval,omitempty"`
+	LowLightThreshold        *uint16   `json:"lowLightThreshold,omitempty"`
+	HighLightThreshold       *uint16   `json:"highLightThreshold,omitempty"`
+	LowTemperatureThreshold  *int8     `json:"lowTemperatureThreshold,omitempty"`
+	HighTemperatureThreshold *int8     `json:"highTemperatureThreshold,omitempty"`
+	AccessPointsThreshold    *uint8    `json:"accessPointsThreshold,omitempty"`
+	BatchSize                *uint16   `json:"batchSize,omitempty"`
+	BufferSize               *uint16   `json:"bufferSize,omitempty"`
+	DataRate                 *DataRate `json:"dataRate,omitempty"`
+}
+
+// TelemetryFirmware holds the firmware and hardware version of the device.
+type TelemetryFirmware struct {
+	FirmwareHash    *string `json:"firmwareHash,omitempty"`
+	FirmwareVersion *string `json:"firmwareVersion,omitempty"`
+	HardwareVersion *string `json:"hardwareVersion,omitempty"`
+}
+
+// TelemetryRotation is a rotation of the device, see UplinkFeatureRotationState.
+type TelemetryRotation struct {
+	OldState  RotationState `json:"oldState"`
+	NewState  RotationState `json:"newState"`
+	Rotations float64       `json:"rotations"`
+	Duration  time.Duration `json:"duration"`
+}
+
+// TelemetryReset holds the reason of the last reset of the device.
+type TelemetryReset struct {
+	Reason ResetReason `json:"reason"`
+}
+
+// Telemetry returns the device agnostic record of the uplink. A section is only
+// filled if the uplink has its feature and the data implements its interface.
+func (d DecodedUplink) Telemetry() Telemetry {
+	t := Telemetry{Features: d.features}
+	if t.Features == nil {
+		t.Features = []Feature{}
+	}
+
+	if v, ok := As[UplinkFeatureTimestamp](&d); ok {
+		t.Timestamp = v.GetTimestamp()
+	}
+	if v, ok := As[UplinkFeatureMoving](&d); ok {
+		t.Moving = ptr(v.IsMoving())
+	}
+	if v, ok := As[UplinkFeatureDutyCycle](&d); ok {
+		t.DutyCycle = ptr(v.IsDutyCycle())
+	}
+	if v, ok := As[UplinkFeatureButton](&d); ok {
+		t.ButtonPressed = ptr(v.GetPressed())
+	}
+	if v, ok := As[UplinkFeatureSequenceNumber](&d); ok {
+		t.SequenceNumber = ptr(v.GetSequenceNumber())
+	}
+
+	if v, ok := As[UplinkFeatureBuffered](&d); ok {
+		t.Buffer = &TelemetryBuffer{Buffered: v.IsBuffered(), Level: v.GetBufferLevel()}
+	}
+
+	if v, ok := As[UplinkFeatureGNSS](&d); ok {
+		t.Position = &TelemetryPosition{
+			Latitude:   v.GetLatitude(),
+			Longitude:  v.GetLongitude(),
+			Altitude:   v.GetAltitude(),
+			Accuracy:   v.GetAccuracy(),
+			TTF:        v.GetTTF(),
+			PDOP:       v.GetPDOP(),
+			Satellites: v.GetSatellites(),
+		}
+	}
+
+	if v, ok := As[UplinkFeatureIndoorPosition](&d); ok {
+		t.Indoor = &TelemetryIndoor{
+			Latitude:  v.GetLatitude(),
+			Longitude: v.GetLongitude(),
+			Accuracy:  v.GetAccuracy(),
+			Floor:     v.GetFloor(),
+			Zone:      v.GetZone(),
+		}
+	}
+
+	if v, ok := As[UplinkFeatureBattery](&d); ok {
+		t.Battery = &TelemetryBattery{Voltage: ptr(v.GetBatteryVoltage()), Low: v.GetLowBattery()}
+	}
+	if v, ok := As[UplinkFeaturePhotovoltaic](&d); ok {
+		if t.Battery == nil {
+			t.Battery = &TelemetryBattery{}
+		}
+		t.Battery.PhotovoltaicVoltage = ptr(v.GetPhotovoltaicVoltage())
+	}
+
+	environment := TelemetryEnvironment{}
+	if v, ok := As[UplinkFeatureTemperature](&d); ok {
+		environment.Temperature = ptr(v.GetTemperature())
+	}
+	if v, ok := As[UplinkFeatureHumidity](&d); ok {
+		environment.Humidity = ptr(v.GetHumidity())
+	}
+	if v, ok := As[UplinkFeaturePressure](&d); ok {
+		environment.Pressure = ptr(v.GetPressure())
+	}
+	if environment != (TelemetryEnvironment{}) {
+		t.Environment = &environment
+	}
+
+	if v, ok := As[UplinkFeatureWiFi](&d); ok {
+		t.WiFi = &TelemetryWiFi{AccessPoints: v.GetAccessPoints(), Position: d.Metadata.WifiPosition}
+	}
+	if v, ok := As[UplinkFeatureBle](&d); ok {
+		t.Ble = &TelemetryBle{Beacons: v.GetBeacons()}
+	}
+
+	config := TelemetryConfig{}
+	if v, ok := As[UplinkFeatureConfigChange](&d); ok {
+		config.ConfigId = v.GetConfigId()
+		config.ConfigChange = ptr(v.GetConfigChange())
+	}
+	if v, ok := As[UplinkFeatureConfig](&d); ok {
+		config.Ble = v.GetBle()
+		config.Gnss = v.GetGnss()
+		config.Wifi = v.GetWifi()
+		config.Acceleration = v.GetAcceleration()
+		config.MovingInterval = v.GetMovingInterval()
+		config.SteadyInterval = v.GetSteadyInterval()
+		config.ConfigInterval = v.GetConfigInterval()
+		config.GnssTimeout = v.GetGnssTimeout()
+		config.AccelerometerThreshold = v.GetAccelerometerThreshold()
+		config.AccelerometerDelay = v.GetAccelerometerDelay()
+		config.BatteryInterval = v.GetBatteryInterval()
+		config.RejoinInterval = v.GetRejoinInterval()
+		config.LowLightThreshold = v.GetLowLightThreshold()
+		config.HighLightThreshold = v.GetHighLightThreshold()
+		config.LowTemperatureThreshold = v.GetLowTemperatureThreshold()
+		config.HighTemperatureThreshold = v.GetHighTemperatureThreshold()
+		config.AccessPointsThreshold = v.GetAccessPointsThreshold()
+		config.BatchSize = v.GetBatchSize()
+		config.BufferSize = v.GetBufferSize()
+	}
+	if v, ok := featureData[UplinkFeatureConfig](d, FeatureDataRate); ok {
+		config.DataRate = v.GetDataRate()
+	}
+	if config != (TelemetryConfig{}) {
+		t.Config = &config
+	}
+
+	firmware := TelemetryFirmware{}
+	if v, ok := As[UplinkFeatureFirmwareVersion](&d); ok {
+		firmware.FirmwareHash = v.GetFirmwareHash()
+		firmware.FirmwareVersion = v.GetFirmwareVersion()
+	}
+	if v, ok := As[UplinkFeatureHardwareVersion](&d); ok {
+		firmware.HardwareVersion = ptr(v.GetHardwareVersion())
+	}
+	if firmware != (TelemetryFirmware{}) {
+		t.Firmware = &firmware
+	}
+
+	if v, ok := As[UplinkFeatureRotationState](&d); ok {
+		t.Rotation = &TelemetryRotation{
+			OldState:  v.GetOldRotationState(),
+			NewState:  v.GetNewRotationState(),
+			Rotations: v.GetRotations(),
+			Duration:  v.GetDuration(),
+		}
+	}
+
+	if v, ok := As[UplinkFeatureResetReason](&d); ok {
+		t.Reset = &TelemetryReset{Reason: v.GetResetReason()}
+	}
+
+	return t
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
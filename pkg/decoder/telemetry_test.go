@@ -0,0 +1,102 @@
+package decoder
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type telemetryPosition struct{}
+
+func (telemetryPosition) GetLatitude() float64           { return 47.1 }
+func (telemetryPosition) GetLongitude() float64          { return 8.5 }
+func (telemetryPosition) GetAltitude() float64           { return 420 }
+func (telemetryPosition) GetAccuracy() *float64          { return nil }
+func (telemetryPosition) GetTTF() *time.Duration         { return nil }
+func (telemetryPosition) GetPDOP() *float64              { return nil }
+func (telemetryPosition) GetSatellites() *uint8          { return nil }
+func (telemetryPosition) GetBatteryVoltage() float64     { return 3.9 }
+func (telemetryPosition) GetLowBattery() *bool           { return nil }
+func (telemetryPosition) IsMoving() bool                 { return true }
+func (telemetryPosition) GetAccessPoints() []AccessPoint { return []AccessPoint{{MAC: "e0286d8aabfc"}} }
+
+type telemetryEnvironment struct{}
+
+func (telemetryEnvironment) GetTemperature() float32         { return 21.5 }
+func (telemetryEnvironment) GetHumidity() float32            { return 40 }
+func (telemetryEnvironment) GetPhotovoltaicVoltage() float32 { return 1.2 }
+func (telemetryEnvironment) GetResetReason() ResetReason     { return ResetReasonWatchdog }
+
+func TestTelemetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		uplink   *DecodedUplink
+		expected Telemetry
+	}{
+		{
+			name:   "Position",
+			uplink: NewDecodedUplink([]Feature{FeatureGNSS, FeatureBattery, FeatureMoving, FeatureWiFi}, telemetryPosition{}),
+			expected: Telemetry{
+				Features: []Feature{FeatureGNSS, FeatureBattery, FeatureMoving, FeatureWiFi},
+				Moving:   ptr(true),
+				Position: &TelemetryPosition{Latitude: 47.1, Longitude: 8.5, Altitude: 420},
+				Battery:  &TelemetryBattery{Voltage: ptr(3.9)},
+				WiFi:     &TelemetryWiFi{AccessPoints: []AccessPoint{{MAC: "e0286d8aabfc"}}},
+			},
+		},
+		{
+			name: "WifiPosition",
+			uplink: &DecodedUplink{
+				features: []Feature{FeatureWiFi},
+				Data:     telemetryPosition{},
+				Metadata: Metadata{WifiPosition: &WifiPosition{Latitude: 47.2, Longitude: 8.4, Accuracy: 30, AccessPoints: 1}},
+			},
+			expected: Telemetry{
+				Features: []Feature{FeatureWiFi},
+				WiFi: &TelemetryWiFi{
+					AccessPoints: []AccessPoint{{MAC: "e0286d8aabfc"}},
+					Position:     &WifiPosition{Latitude: 47.2, Longitude: 8.4, Accuracy: 30, AccessPoints: 1},
+				},
+			},
+		},
+		{
+			name:   "MissingFeatures",
+			uplink: NewDecodedUplink([]Feature{FeatureGNSS}, telemetryPosition{}),
+			expected: Telemetry{
+				Features: []Feature{FeatureGNSS},
+				Position: &TelemetryPosition{Latitude: 47.1, Longitude: 8.5, Altitude: 420},
+			},
+		},
+		{
+			name:   "Environment",
+			uplink: NewDecodedUplink([]Feature{FeatureTemperature, FeatureHumidity, FeaturePhotovoltaic, FeatureResetReason}, telemetryEnvironment{}),
+			expected: Telemetry{
+				Features:    []Feature{FeatureTemperature, FeatureHumidity, FeaturePhotovoltaic, FeatureResetReason},
+				Battery:     &TelemetryBattery{PhotovoltaicVoltage: ptr(float32(1.2))},
+				Environment: &TelemetryEnvironment{Temperature: ptr(float32(21.5)), Humidity: ptr(float32(40))},
+				Reset:       &TelemetryReset{Reason: ResetReasonWatchdog},
+			},
+		},
+		{
+			name:   "NotImplemented",
+			uplink: NewDecodedUplink([]Feature{FeatureGNSS, FeaturePressure}, telemetryEnvironment{}),
+			expected: Telemetry{
+				Features: []Feature{FeatureGNSS, FeaturePressure},
+			},
+		},
+		{
+			name:     "Empty",
+			uplink:   NewDecodedUplink(nil, nil),
+			expected: Telemetry{Features: []Feature{}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			telemetry := test.uplink.Telemetry()
+			if !reflect.DeepEqual(telemetry, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, telemetry)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+// Package devices registers the decoders and encoders of all devices of this
+// module with decoder.DefaultRegistry. Import it for its side effects:
+//
+//	import _ "github.com/truvami/decoder/pkg/devices"
+package devices
+
+import (
+	_ "github.com/truvami/decoder/pkg/decoder/nomadxl/v1"
+	_ "github.com/truvami/decoder/pkg/decoder/nomadxs/v1"
+	_ "github.com/truvami/decoder/pkg/decoder/smartlabel/v1"
+	_ "github.com/truvami/decoder/pkg/decoder/tagsl/v1"
+	_ "github.com/truvami/decoder/pkg/decoder/tagxl/v1"
+	_ "github.com/truvami/decoder/pkg/encoder/nomadxs/v1"
+	_ "github.com/truvami/decoder/pkg/encoder/smartlabel/v1"
+	_ "github.com/truvami/decoder/pkg/encoder/tagsl/v1"
+)
@@ -0,0 +1,49 @@
+package devices
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/decoder"
+	tagxl "github.com/truvami/decoder/pkg/decoder/tagxl/v1"
+)
+
+func TestFeatures(t *testing.T) {
+	// undeclared are the features which are implemented by the payload type but
+	// intentionally not declared for a port, e.g. because the payload type is
+	// shared with a later version of the port.
+	undeclared := map[string][]decoder.Feature{
+		fmt.Sprintf("tagxl/v1:152:%d", tagxl.Port152Version1): {decoder.FeatureSequenceNumber},
+	}
+
+	for _, device := range decoder.Devices() {
+		t.Run(device.Path(), func(t *testing.T) {
+			ports, err := decoder.Ports(device.Path())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, port := range ports {
+				if port.PayloadType == nil {
+					continue
+				}
+
+				key := fmt.Sprintf("%s:%d", device.Path(), port.Port)
+				if port.Version != nil {
+					key = fmt.Sprintf("%s:%d", key, *port.Version)
+				}
+
+				features := slices.Concat(port.Features, undeclared[key])
+				err := decoder.CheckFeatures(features, port.PayloadType)
+				if err != nil {
+					t.Errorf("%s: %v", key, err)
+				}
+				if !errors.Is(decoder.CheckFeatures(port.Features, port.PayloadType), decoder.ErrFeatureMismatch) && len(undeclared[key]) > 0 {
+					t.Errorf("%s: expected %v to be undeclared", key, undeclared[key])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package devices
+
+import (
+	"context"
+	"testing"
+
+	"github.com/truvami/decoder/internal/fuzztest"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+// FuzzDecode decodes random payloads with every registered device on every
+// port, with and without length validation and in lenient mode. The seed
+// corpus in testdata/fuzz holds the payloads of the decoder tests, and every
+// supported port of a device is seeded with an empty payload.
+func FuzzDecode(f *testing.F) {
+	decoders := map[string][]decoder.Decoder{}
+	for _, device := range decoder.Devices() {
+		for _, options := range []decoder.DeviceOptions{
+			{},
+			{SkipValidation: true},
+			{SkipValidation: true, Lenient: true},
+		} {
+			d, err := decoder.New(device.Path(), options)
+			if err != nil {
+				f.Fatalf("building %s: %v", device.Path(), err)
+			}
+			decoders[device.Path()] = append(decoders[device.Path()], d)
+		}
+
+		for _, port := range device.Ports {
+			f.Add(device.Path(), port, []byte{})
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, device string, port uint8, payload []byte) {
+		devices, ok := decoders[device]
+		if !ok {
+			return
+		}
+
+		fuzztest.CheckAllocations(t, payload, func() {
+			for _, d := range devices {
+				_, _ = d.DecodeBytes(context.TODO(), payload, port)
+			}
+		})
+	})
+}
@@ -1,5 +1,13 @@
 package encoder
 
+import "reflect"
+
 type Encoder interface {
 	Encode(any, uint8) (any, error)
 }
+
+// PayloadTyper is implemented by encoders which can tell the payload struct they
+// expect for a port, e.g. to unmarshal requests into it.
+type PayloadTyper interface {
+	PayloadType(port uint8) (reflect.Type, error)
+}
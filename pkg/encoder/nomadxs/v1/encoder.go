@@ -5,10 +5,17 @@ import (
 	"reflect"
 
 	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
 	nomadxs "github.com/truvami/decoder/pkg/decoder/nomadxs/v1"
 	"github.com/truvami/decoder/pkg/encoder"
 )
 
+//go:generate go run github.com/truvami/decoder/internal/decodergen
+
+func init() {
+	decoder.RegisterEncoder("nomadxs/v1", NewNomadXSv1Encoder)
+}
+
 type NomadXSv1Encoder struct{}
 
 func NewNomadXSv1Encoder() encoder.Encoder {
@@ -16,7 +23,7 @@ func NewNomadXSv1Encoder() encoder.Encoder {
 }
 
 func (n NomadXSv1Encoder) Encode(data any, port uint8) (any, error) {
-	config, err := n.getConfig(port)
+	config, err := n.PayloadConfig(port)
 	if err != nil {
 		return nil, err
 	}
@@ -29,28 +36,41 @@ func (n NomadXSv1Encoder) Encode(data any, port uint8) (any, error) {
 	return payload, nil
 }
 
-func (n NomadXSv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
+// PayloadType returns the payload struct the encoder expects for the port.
+func (n NomadXSv1Encoder) PayloadType(port uint8) (reflect.Type, error) {
+	config, err := n.PayloadConfig(port)
+	if err != nil {
+		return nil, err
+	}
+	return config.TargetType, nil
+}
+
+// PayloadConfig returns the payload layout the encoder uses for the port.
+func (n NomadXSv1Encoder) PayloadConfig(port uint8) (common.PayloadConfig, error) {
 	switch port {
 	case 1:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Year", Start: 11, Length: 1},
 				{Name: "Month", Start: 12, Length: 1},
 				{Name: "Day", Start: 13, Length: 1},
 				{Name: "Hour", Start: 14, Length: 1},
 				{Name: "Minute", Start: 15, Length: 1},
 				{Name: "Second", Start: 16, Length: 1},
-				{Name: "TimeToFix", Start: 17, Length: 1, Transform: ttf},
+				{Name: "TimeToFix", Start: 17, Length: 1, Scale: 1, Unit: "s"},
 				{Name: "AmbientLight", Start: 18, Length: 2},
 				{Name: "AccelerometerXAxis", Start: 20, Length: 2},
 				{Name: "AccelerometerYAxis", Start: 22, Length: 2},
 				{Name: "AccelerometerZAxis", Start: 24, Length: 2},
-				{Name: "Temperature", Start: 26, Length: 2, Optional: true, Transform: temperature},
-				{Name: "Pressure", Start: 28, Length: 2, Optional: true, Transform: pressure},
+				{Name: "Temperature", Start: 26, Length: 2, Optional: true, Scale: 0.01, Unit: "°C"},
+				{Name: "Pressure", Start: 28, Length: 2, Optional: true, Scale: 0.1, Unit: "hPa"},
 			},
 			TargetType: reflect.TypeOf(nomadxs.Port1Payload{}),
 		}, nil
@@ -79,8 +99,11 @@ func (n NomadXSv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 15:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "LowBattery", Start: 0, Length: 1, Transform: lowBattery},
-				{Name: "Battery", Start: 1, Length: 2, Transform: battery},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "LowBattery", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Battery", Start: 1, Length: 2, Scale: 0.001, Unit: "V"},
 			},
 			TargetType: reflect.TypeOf(nomadxs.Port15Payload{}),
 		}, nil
@@ -88,39 +111,3 @@ func (n NomadXSv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
 
 	return common.PayloadConfig{}, fmt.Errorf("%w: port %v not supported", common.ErrPortNotSupported, port)
 }
-
-func moving(v any) any {
-	return common.BoolToBytes(common.BytesToBool(v.([]byte)), 0)
-}
-
-func latitude(v any) any {
-	return common.IntToBytes(int64(common.BytesToFloat64(v.([]byte))*1000000), 4)
-}
-
-func longitude(v any) any {
-	return common.IntToBytes(int64(common.BytesToFloat64(v.([]byte))*1000000), 4)
-}
-
-func altitude(v any) any {
-	return common.UintToBytes(uint64(common.BytesToFloat64(v.([]byte))*10), 2)
-}
-
-func ttf(v any) any {
-	return common.UintToBytes(uint64(common.BytesToInt64(v.([]byte))/1000000000), 1)
-}
-
-func temperature(v any) any {
-	return common.IntToBytes(int64((common.BytesToFloat32(v.([]byte)))*100), 2)
-}
-
-func pressure(v any) any {
-	return common.UintToBytes(uint64((common.BytesToFloat32(v.([]byte)))*10), 2)
-}
-
-func lowBattery(v any) any {
-	return common.BoolToBytes(common.BytesToBool(v.([]byte)), 0)
-}
-
-func battery(v any) any {
-	return common.UintToBytes(uint64(common.BytesToFloat64(v.([]byte))*1000), 2)
-}
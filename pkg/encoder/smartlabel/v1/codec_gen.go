@@ -0,0 +1,371 @@
+// Code generated by decodergen. DO NOT EDIT.
+
+package smartlabel
+
+import (
+	"reflect"
+
+	"github.com/truvami/decoder/pkg/common"
+	smartlabel "github.com/truvami/decoder/pkg/decoder/smartlabel/v1"
+)
+
+func init() {
+	common.RegisterGenerated(reflect.TypeOf(smartlabel.Port1Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "BatteryVoltage", Start: 0, Length: 2, Scale: 0.001},
+			{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Scale: 0.001},
+		},
+		Decode: decodePort1Payload,
+		Encode: encodePort1Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(smartlabel.Port2Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Temperature", Start: 0, Length: 2, Scale: 0.01},
+			{Name: "Humidity", Start: 2, Length: 1, Scale: 0.5},
+		},
+		Decode: decodePort2Payload,
+		Encode: encodePort2Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(smartlabel.Port11Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "BatteryVoltage", Start: 0, Length: 2, Scale: 0.001},
+			{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Scale: 0.001},
+			{Name: "Temperature", Start: 4, Length: 2, Scale: 0.01},
+			{Name: "Humidity", Start: 6, Length: 1, Scale: 0.5},
+		},
+		Decode: decodePort11Payload,
+		Encode: encodePort11Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port128Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DataRate", Start: 0, Length: 1, BitOffset: 0, BitLength: 3},
+			{Name: "Acceleration", Start: 0, Length: 1, BitOffset: 3, BitLength: 1},
+			{Name: "Wifi", Start: 0, Length: 1, BitOffset: 4, BitLength: 1},
+			{Name: "Gnss", Start: 0, Length: 1, BitOffset: 5, BitLength: 1},
+			{Name: "SteadyInterval", Start: 1, Length: 2},
+			{Name: "MovingInterval", Start: 3, Length: 2},
+			{Name: "HeartbeatInterval", Start: 5, Length: 1},
+			{Name: "AccelerationThreshold", Start: 6, Length: 2},
+			{Name: "AccelerationDelay", Start: 8, Length: 2},
+			{Name: "TemperaturePollingInterval", Start: 10, Length: 2},
+			{Name: "TemperatureUplinkInterval", Start: 12, Length: 2},
+			{Name: "TemperatureUpperThreshold", Start: 14, Length: 1},
+			{Name: "TemperatureLowerThreshold", Start: 15, Length: 1},
+			{Name: "AccessPointsThreshold", Start: 16, Length: 1},
+		},
+		Decode: decodePort128Payload,
+		Encode: encodePort128Payload,
+	})
+}
+
+func decodePort1Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := smartlabel.Port1Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// BatteryVoltage
+	if raw, ok := reader.Read("BatteryVoltage", 0, 2, false); ok {
+		p.BatteryVoltage = float32(common.ScaledValue(raw, false, config.Fields[0].Scale, config.Fields[0].Offset))
+		if err := common.ValidateField("BatteryVoltage", p.BatteryVoltage, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// PhotovoltaicVoltage
+	if raw, ok := reader.Read("PhotovoltaicVoltage", 2, 2, false); ok {
+		p.PhotovoltaicVoltage = float32(common.ScaledValue(raw, false, config.Fields[1].Scale, config.Fields[1].Offset))
+		if err := common.ValidateField("PhotovoltaicVoltage", p.PhotovoltaicVoltage, "gte=0,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort1Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(smartlabel.Port1Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(4)
+	var bytes []byte
+
+	// BatteryVoltage
+	bytes = common.UnscaledBytes(float64(p.BatteryVoltage), config.Fields[0].Scale, config.Fields[0].Offset, 2)
+	writer.Write(0, 2, bytes)
+
+	// PhotovoltaicVoltage
+	bytes = common.UnscaledBytes(float64(p.PhotovoltaicVoltage), config.Fields[1].Scale, config.Fields[1].Offset, 2)
+	writer.Write(2, 2, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort2Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := smartlabel.Port2Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Temperature
+	if raw, ok := reader.Read("Temperature", 0, 2, false); ok {
+		p.Temperature = float32(common.ScaledValue(raw, false, config.Fields[0].Scale, config.Fields[0].Offset))
+		if err := common.ValidateField("Temperature", p.Temperature, "gte=-20,lte=60"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Humidity
+	if raw, ok := reader.Read("Humidity", 2, 1, false); ok {
+		p.Humidity = float32(common.ScaledValue(raw, false, config.Fields[1].Scale, config.Fields[1].Offset))
+		if err := common.ValidateField("Humidity", p.Humidity, "gte=5,lte=95"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort2Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(smartlabel.Port2Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(3)
+	var bytes []byte
+
+	// Temperature
+	bytes = common.UnscaledBytes(float64(p.Temperature), config.Fields[0].Scale, config.Fields[0].Offset, 2)
+	writer.Write(0, 2, bytes)
+
+	// Humidity
+	bytes = common.UnscaledBytes(float64(p.Humidity), config.Fields[1].Scale, config.Fields[1].Offset, 1)
+	writer.Write(2, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort11Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := smartlabel.Port11Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// BatteryVoltage
+	if raw, ok := reader.Read("BatteryVoltage", 0, 2, false); ok {
+		p.BatteryVoltage = float32(common.ScaledValue(raw, false, config.Fields[0].Scale, config.Fields[0].Offset))
+		if err := common.ValidateField("BatteryVoltage", p.BatteryVoltage, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// PhotovoltaicVoltage
+	if raw, ok := reader.Read("PhotovoltaicVoltage", 2, 2, false); ok {
+		p.PhotovoltaicVoltage = float32(common.ScaledValue(raw, false, config.Fields[1].Scale, config.Fields[1].Offset))
+		if err := common.ValidateField("PhotovoltaicVoltage", p.PhotovoltaicVoltage, "gte=0,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Temperature
+	if raw, ok := reader.Read("Temperature", 4, 2, false); ok {
+		p.Temperature = float32(common.ScaledValue(raw, false, config.Fields[2].Scale, config.Fields[2].Offset))
+		if err := common.ValidateField("Temperature", p.Temperature, "gte=-20,lte=60"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Humidity
+	if raw, ok := reader.Read("Humidity", 6, 1, false); ok {
+		p.Humidity = float32(common.ScaledValue(raw, false, config.Fields[3].Scale, config.Fields[3].Offset))
+		if err := common.ValidateField("Humidity", p.Humidity, "gte=5,lte=95"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort11Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(smartlabel.Port11Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(7)
+	var bytes []byte
+
+	// BatteryVoltage
+	bytes = common.UnscaledBytes(float64(p.BatteryVoltage), config.Fields[0].Scale, config.Fields[0].Offset, 2)
+	writer.Write(0, 2, bytes)
+
+	// PhotovoltaicVoltage
+	bytes = common.UnscaledBytes(float64(p.PhotovoltaicVoltage), config.Fields[1].Scale, config.Fields[1].Offset, 2)
+	writer.Write(2, 2, bytes)
+
+	// Temperature
+	bytes = common.UnscaledBytes(float64(p.Temperature), config.Fields[2].Scale, config.Fields[2].Offset, 2)
+	writer.Write(4, 2, bytes)
+
+	// Humidity
+	bytes = common.UnscaledBytes(float64(p.Humidity), config.Fields[3].Scale, config.Fields[3].Offset, 1)
+	writer.Write(6, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort128Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port128Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DataRate
+	if raw, ok := reader.Read("DataRate", 0, 1, false); ok {
+		p.DataRate = common.BytesToUint8(common.ExtractBits(raw, 0, 3))
+		if err := common.ValidateField("DataRate", p.DataRate, "gte=0,lte=7"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Acceleration
+	if raw, ok := reader.Read("Acceleration", 0, 1, false); ok {
+		p.Acceleration = common.ExtractBits(raw, 3, 1)[0]&0x01 == 1
+	}
+
+	// Wifi
+	if raw, ok := reader.Read("Wifi", 0, 1, false); ok {
+		p.Wifi = common.ExtractBits(raw, 4, 1)[0]&0x01 == 1
+	}
+
+	// Gnss
+	if raw, ok := reader.Read("Gnss", 0, 1, false); ok {
+		p.Gnss = common.ExtractBits(raw, 5, 1)[0]&0x01 == 1
+	}
+
+	// SteadyInterval
+	if raw, ok := reader.Read("SteadyInterval", 1, 2, false); ok {
+		p.SteadyInterval = common.BytesToUint16(raw)
+	}
+
+	// MovingInterval
+	if raw, ok := reader.Read("MovingInterval", 3, 2, false); ok {
+		p.MovingInterval = common.BytesToUint16(raw)
+	}
+
+	// HeartbeatInterval
+	if raw, ok := reader.Read("HeartbeatInterval", 5, 1, false); ok {
+		p.HeartbeatInterval = common.BytesToUint8(raw)
+	}
+
+	// AccelerationThreshold
+	if raw, ok := reader.Read("AccelerationThreshold", 6, 2, false); ok {
+		p.AccelerationThreshold = common.BytesToUint16(raw)
+	}
+
+	// AccelerationDelay
+	if raw, ok := reader.Read("AccelerationDelay", 8, 2, false); ok {
+		p.AccelerationDelay = common.BytesToUint16(raw)
+	}
+
+	// TemperaturePollingInterval
+	if raw, ok := reader.Read("TemperaturePollingInterval", 10, 2, false); ok {
+		p.TemperaturePollingInterval = common.BytesToUint16(raw)
+	}
+
+	// TemperatureUplinkInterval
+	if raw, ok := reader.Read("TemperatureUplinkInterval", 12, 2, false); ok {
+		p.TemperatureUplinkInterval = common.BytesToUint16(raw)
+	}
+
+	// TemperatureUpperThreshold
+	if raw, ok := reader.Read("TemperatureUpperThreshold", 14, 1, false); ok {
+		p.TemperatureUpperThreshold = common.BytesToInt8(raw)
+	}
+
+	// TemperatureLowerThreshold
+	if raw, ok := reader.Read("TemperatureLowerThreshold", 15, 1, false); ok {
+		p.TemperatureLowerThreshold = common.BytesToInt8(raw)
+	}
+
+	// AccessPointsThreshold
+	if raw, ok := reader.Read("AccessPointsThreshold", 16, 1, false); ok {
+		p.AccessPointsThreshold = common.BytesToUint8(raw)
+		if err := common.ValidateField("AccessPointsThreshold", p.AccessPointsThreshold, "gte=1,lte=6"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort128Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port128Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(17)
+	var bytes []byte
+
+	// DataRate
+	bytes = common.UintToBytes(uint64(p.DataRate), 1)
+	writer.WriteBits(0, 1, 0, 3, bytes)
+
+	// Acceleration
+	bytes = common.BoolToBytes(p.Acceleration, 0)
+	writer.WriteBits(0, 1, 3, 1, bytes)
+
+	// Wifi
+	bytes = common.BoolToBytes(p.Wifi, 0)
+	writer.WriteBits(0, 1, 4, 1, bytes)
+
+	// Gnss
+	bytes = common.BoolToBytes(p.Gnss, 0)
+	writer.WriteBits(0, 1, 5, 1, bytes)
+
+	// SteadyInterval
+	bytes = common.UintToBytes(uint64(p.SteadyInterval), 2)
+	writer.Write(1, 2, bytes)
+
+	// MovingInterval
+	bytes = common.UintToBytes(uint64(p.MovingInterval), 2)
+	writer.Write(3, 2, bytes)
+
+	// HeartbeatInterval
+	bytes = common.UintToBytes(uint64(p.HeartbeatInterval), 1)
+	writer.Write(5, 1, bytes)
+
+	// AccelerationThreshold
+	bytes = common.UintToBytes(uint64(p.AccelerationThreshold), 2)
+	writer.Write(6, 2, bytes)
+
+	// AccelerationDelay
+	bytes = common.UintToBytes(uint64(p.AccelerationDelay), 2)
+	writer.Write(8, 2, bytes)
+
+	// TemperaturePollingInterval
+	bytes = common.UintToBytes(uint64(p.TemperaturePollingInterval), 2)
+	writer.Write(10, 2, bytes)
+
+	// TemperatureUplinkInterval
+	bytes = common.UintToBytes(uint64(p.TemperatureUplinkInterval), 2)
+	writer.Write(12, 2, bytes)
+
+	// TemperatureUpperThreshold
+	bytes = common.IntToBytes(int64(p.TemperatureUpperThreshold), 1)
+	writer.Write(14, 1, bytes)
+
+	// TemperatureLowerThreshold
+	bytes = common.IntToBytes(int64(p.TemperatureLowerThreshold), 1)
+	writer.Write(15, 1, bytes)
+
+	// AccessPointsThreshold
+	bytes = common.UintToBytes(uint64(p.AccessPointsThreshold), 1)
+	writer.Write(16, 1, bytes)
+
+	return writer.String(), nil
+}
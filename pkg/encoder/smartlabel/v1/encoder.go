@@ -2,14 +2,20 @@ package smartlabel
 
 import (
 	"fmt"
-	"math"
 	"reflect"
 
 	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
 	smartlabel "github.com/truvami/decoder/pkg/decoder/smartlabel/v1"
 	"github.com/truvami/decoder/pkg/encoder"
 )
 
+//go:generate go run github.com/truvami/decoder/internal/decodergen
+
+func init() {
+	decoder.RegisterEncoder("smartlabel/v1", NewSmartlabelv1Encoder)
+}
+
 type Smartlabelv1Encoder struct{}
 
 func NewSmartlabelv1Encoder() encoder.Encoder {
@@ -17,7 +23,7 @@ func NewSmartlabelv1Encoder() encoder.Encoder {
 }
 
 func (s Smartlabelv1Encoder) Encode(data any, port uint8) (any, error) {
-	config, err := s.getConfig(port)
+	config, err := s.PayloadConfig(port)
 	if err != nil {
 		return nil, err
 	}
@@ -30,38 +36,51 @@ func (s Smartlabelv1Encoder) Encode(data any, port uint8) (any, error) {
 	return payload, nil
 }
 
-func (t Smartlabelv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
+// PayloadType returns the payload struct the encoder expects for the port.
+func (s Smartlabelv1Encoder) PayloadType(port uint8) (reflect.Type, error) {
+	config, err := s.PayloadConfig(port)
+	if err != nil {
+		return nil, err
+	}
+	return config.TargetType, nil
+}
+
+// PayloadConfig returns the payload layout the encoder uses for the port.
+func (t Smartlabelv1Encoder) PayloadConfig(port uint8) (common.PayloadConfig, error) {
 	switch port {
 	case 1:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "BatteryVoltage", Start: 0, Length: 2, Transform: battery},
-				{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Transform: photovoltaic},
+				{Name: "BatteryVoltage", Start: 0, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Scale: 0.001, Unit: "V"},
 			},
 			TargetType: reflect.TypeOf(smartlabel.Port1Payload{}),
 		}, nil
 	case 2:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "Temperature", Start: 0, Length: 2, Transform: temperature},
-				{Name: "Humidity", Start: 2, Length: 1, Transform: humidity},
+				{Name: "Temperature", Start: 0, Length: 2, Scale: 0.01, Unit: "°C"},
+				{Name: "Humidity", Start: 2, Length: 1, Scale: 0.5, Unit: "%"},
 			},
 			TargetType: reflect.TypeOf(smartlabel.Port2Payload{}),
 		}, nil
 	case 11:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "BatteryVoltage", Start: 0, Length: 2, Transform: battery},
-				{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Transform: photovoltaic},
-				{Name: "Temperature", Start: 4, Length: 2, Transform: temperature},
-				{Name: "Humidity", Start: 6, Length: 1, Transform: humidity},
+				{Name: "BatteryVoltage", Start: 0, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "PhotovoltaicVoltage", Start: 2, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "Temperature", Start: 4, Length: 2, Scale: 0.01, Unit: "°C"},
+				{Name: "Humidity", Start: 6, Length: 1, Scale: 0.5, Unit: "%"},
 			},
 			TargetType: reflect.TypeOf(smartlabel.Port11Payload{}),
 		}, nil
 	case 128:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "DataRate", Start: 0, Length: 1},
+				{Name: "DataRate", Start: 0, Length: 1, BitOffset: 0, BitLength: 3},
+				{Name: "Acceleration", Start: 0, Length: 1, BitOffset: 3, BitLength: 1},
+				{Name: "Wifi", Start: 0, Length: 1, BitOffset: 4, BitLength: 1},
+				{Name: "Gnss", Start: 0, Length: 1, BitOffset: 5, BitLength: 1},
 				{Name: "SteadyInterval", Start: 1, Length: 2},
 				{Name: "MovingInterval", Start: 3, Length: 2},
 				{Name: "HeartbeatInterval", Start: 5, Length: 1},
@@ -79,23 +98,3 @@ func (t Smartlabelv1Encoder) getConfig(port uint8) (common.PayloadConfig, error)
 
 	return common.PayloadConfig{}, fmt.Errorf("%w: port %v not supported", common.ErrPortNotSupported, port)
 }
-
-func battery(v any) any {
-	return common.UintToBytes(uint64(common.BytesToFloat32(v.([]byte))*1000), 2)
-}
-
-func photovoltaic(v any) any {
-	return common.UintToBytes(uint64(common.BytesToFloat32(v.([]byte))*1000), 2)
-}
-
-func temperature(v any) any {
-	// Encode temperature as signed int16 with scale 100 (two's complement, big-endian).
-	// This matches expected payloads like -4.98°C -> 0xFE0E.
-	f := float64(common.BytesToFloat32(v.([]byte)))
-	scaled := int64(math.Round(f * 100.0))
-	return common.IntToBytes(scaled, 2)
-}
-
-func humidity(v any) any {
-	return common.UintToBytes(uint64(common.BytesToFloat32(v.([]byte))*2), 1)
-}
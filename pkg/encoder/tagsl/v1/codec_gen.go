@@ -0,0 +1,2115 @@
+// Code generated by decodergen. DO NOT EDIT.
+
+package tagsl
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/truvami/decoder/pkg/common"
+	tagsl "github.com/truvami/decoder/pkg/decoder/tagsl/v1"
+)
+
+func init() {
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port1Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1},
+			{Name: "Year", Start: 11, Length: 1},
+			{Name: "Month", Start: 12, Length: 1},
+			{Name: "Day", Start: 13, Length: 1},
+			{Name: "Hour", Start: 14, Length: 1},
+			{Name: "Minute", Start: 15, Length: 1},
+			{Name: "Second", Start: 16, Length: 1},
+		},
+		Decode: decodePort1Payload,
+		Encode: encodePort1Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port4Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "LocalizationIntervalWhileMoving", Start: 0, Length: 4},
+			{Name: "LocalizationIntervalWhileSteady", Start: 4, Length: 4},
+			{Name: "HeartbeatInterval", Start: 8, Length: 4},
+			{Name: "GPSTimeoutWhileWaitingForFix", Start: 12, Length: 2},
+			{Name: "AccelerometerWakeupThreshold", Start: 14, Length: 2},
+			{Name: "AccelerometerDelay", Start: 16, Length: 2},
+			{Name: "DeviceState", Start: 18, Length: 1},
+			{Name: "FirmwareVersionMajor", Start: 19, Length: 1},
+			{Name: "FirmwareVersionMinor", Start: 20, Length: 1},
+			{Name: "FirmwareVersionPatch", Start: 21, Length: 1},
+			{Name: "HardwareVersionType", Start: 22, Length: 1},
+			{Name: "HardwareVersionRevision", Start: 23, Length: 1},
+			{Name: "BatteryKeepAliveMessageInterval", Start: 24, Length: 4},
+			{Name: "BatchSize", Start: 28, Length: 2, Optional: true},
+			{Name: "BufferSize", Start: 30, Length: 2, Optional: true},
+		},
+		Decode: decodePort4Payload,
+		Encode: encodePort4Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port5Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "AccessPoints", Start: 1, Length: 7, MaxCount: 7, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}, {Name: "Rssi", Start: 6, Length: 1}}},
+		},
+		Decode: decodePort5Payload,
+		Encode: encodePort5Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port6Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "ButtonPressed", Start: 0, Length: 1},
+		},
+		Decode: decodePort6Payload,
+		Encode: encodePort6Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port7Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Timestamp", Start: 0, Length: 4, Transform: true},
+			{Name: "DutyCycle", Start: 4, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 4, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 4, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 4, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 6, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}, {Name: "Rssi", Start: 6, Length: 1}}},
+		},
+		Decode: decodePort7Payload,
+		Encode: encodePort7Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port10Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1},
+			{Name: "Timestamp", Start: 11, Length: 4, Transform: true},
+			{Name: "Battery", Start: 15, Length: 2, Scale: 0.001},
+			{Name: "TTF", Start: 17, Length: 1, Scale: 1},
+			{Name: "PDOP", Start: 18, Length: 1, Scale: 0.5},
+			{Name: "Satellites", Start: 19, Length: 1},
+		},
+		Decode: decodePort10Payload,
+		Encode: encodePort10Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port15Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "LowBattery", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "Battery", Start: 1, Length: 2, Scale: 0.001},
+		},
+		Decode: decodePort15Payload,
+		Encode: encodePort15Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port50Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1},
+			{Name: "Timestamp", Start: 11, Length: 4, Transform: true},
+			{Name: "Battery", Start: 15, Length: 2, Scale: 0.001},
+			{Name: "TTF", Start: 17, Length: 1, Scale: 1},
+			{Name: "AccessPoints", Start: 18, Length: 7, MaxCount: 4, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}, {Name: "Rssi", Start: 6, Length: 1}}},
+		},
+		Decode: decodePort50Payload,
+		Encode: encodePort50Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port51Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1},
+			{Name: "Timestamp", Start: 11, Length: 4, Transform: true},
+			{Name: "Battery", Start: 15, Length: 2, Scale: 0.001},
+			{Name: "TTF", Start: 17, Length: 1, Scale: 1},
+			{Name: "PDOP", Start: 18, Length: 1, Scale: 0.5},
+			{Name: "Satellites", Start: 19, Length: 1},
+			{Name: "AccessPoints", Start: 20, Length: 7, MaxCount: 4, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}, {Name: "Rssi", Start: 6, Length: 1}}},
+		},
+		Decode: decodePort51Payload,
+		Encode: encodePort51Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port105Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "BufferLevel", Start: 0, Length: 2},
+			{Name: "Timestamp", Start: 2, Length: 4, Transform: true},
+			{Name: "DutyCycle", Start: 6, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 6, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 6, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 6, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "AccessPoints", Start: 7, Length: 7, MaxCount: 6, Group: []common.FieldLayout{{Name: "Mac", Start: 0, Length: 6, Hex: true}, {Name: "Rssi", Start: 6, Length: 1}}},
+		},
+		Decode: decodePort105Payload,
+		Encode: encodePort105Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(tagsl.Port110Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "BufferLevel", Start: 0, Length: 2},
+			{Name: "DutyCycle", Start: 2, Length: 1, BitOffset: 7, BitLength: 1},
+			{Name: "ConfigId", Start: 2, Length: 1, BitOffset: 3, BitLength: 4},
+			{Name: "ConfigChange", Start: 2, Length: 1, BitOffset: 2, BitLength: 1},
+			{Name: "Moving", Start: 2, Length: 1, BitOffset: 0, BitLength: 1},
+			{Name: "Latitude", Start: 3, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Longitude", Start: 7, Length: 4, Signedness: common.Signed, Scale: 1e-06},
+			{Name: "Altitude", Start: 11, Length: 2, Scale: 0.1},
+			{Name: "Timestamp", Start: 13, Length: 4, Transform: true},
+			{Name: "Battery", Start: 17, Length: 2, Scale: 0.001},
+			{Name: "TTF", Start: 19, Length: 1, Scale: 1},
+			{Name: "PDOP", Start: 20, Length: 1, Scale: 0.5},
+			{Name: "Satellites", Start: 21, Length: 1},
+		},
+		Decode: decodePort110Payload,
+		Encode: encodePort110Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port128Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "Ble", Start: 0, Length: 1},
+			{Name: "Gnss", Start: 1, Length: 1},
+			{Name: "Wifi", Start: 2, Length: 1},
+			{Name: "MovingInterval", Start: 3, Length: 4},
+			{Name: "SteadyInterval", Start: 7, Length: 4},
+			{Name: "ConfigInterval", Start: 11, Length: 4},
+			{Name: "GnssTimeout", Start: 15, Length: 2},
+			{Name: "AccelerometerThreshold", Start: 17, Length: 2},
+			{Name: "AccelerometerDelay", Start: 19, Length: 2},
+			{Name: "BatteryInterval", Start: 21, Length: 4},
+			{Name: "BatchSize", Start: 25, Length: 2, Optional: true},
+			{Name: "BufferSize", Start: 27, Length: 2, Optional: true},
+		},
+		Decode: decodePort128Payload,
+		Encode: encodePort128Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port129Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "TimeToBuzz", Start: 0, Length: 1},
+		},
+		Decode: decodePort129Payload,
+		Encode: encodePort129Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port130Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "EraseFlash", Start: 0, Length: 1, Transform: true},
+		},
+		Decode: decodePort130Payload,
+		Encode: encodePort130Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port131Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "AccuracyEnhancement", Start: 0, Length: 1},
+		},
+		Decode: decodePort131Payload,
+		Encode: encodePort131Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port132Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "EraseFlash", Start: 0, Length: 1, Transform: true},
+		},
+		Decode: decodePort132Payload,
+		Encode: encodePort132Payload,
+	})
+	common.RegisterGenerated(reflect.TypeOf(Port134Payload{}), common.GeneratedCodec{
+		Fields: []common.FieldLayout{
+			{Name: "ScanInterval", Start: 0, Length: 2},
+			{Name: "ScanTime", Start: 2, Length: 1},
+			{Name: "MaxBeacons", Start: 3, Length: 1},
+			{Name: "MinRssi", Start: 4, Length: 1},
+			{Name: "AdvertisingName", Start: 5, Length: 10, Transform: true},
+			{Name: "AccelerometerDelay", Start: 15, Length: 2},
+			{Name: "AccelerometerThreshold", Start: 17, Length: 2},
+			{Name: "ScanMode", Start: 19, Length: 1},
+			{Name: "BleConfigUplinkInterval", Start: 20, Length: 2},
+		},
+		Decode: decodePort134Payload,
+		Encode: encodePort134Payload,
+	})
+}
+
+func decodePort1Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port1Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 0, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 0, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 0, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		p.Moving = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// Latitude
+	if raw, ok := reader.Read("Latitude", 1, 4, false); ok {
+		p.Latitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[4].Scale, config.Fields[4].Offset))
+		if err := common.ValidateField("Latitude", p.Latitude, "gte=-90,lte=90"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Longitude
+	if raw, ok := reader.Read("Longitude", 5, 4, false); ok {
+		p.Longitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[5].Scale, config.Fields[5].Offset))
+		if err := common.ValidateField("Longitude", p.Longitude, "gte=-180,lte=180"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Altitude
+	if raw, ok := reader.Read("Altitude", 9, 2, false); ok {
+		p.Altitude = float64(common.ScaledValue(raw, false, config.Fields[6].Scale, config.Fields[6].Offset))
+	}
+
+	// Year
+	if raw, ok := reader.Read("Year", 11, 1, false); ok {
+		p.Year = common.BytesToUint8(raw)
+		if err := common.ValidateField("Year", p.Year, "gte=0,lte=255"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Month
+	if raw, ok := reader.Read("Month", 12, 1, false); ok {
+		p.Month = common.BytesToUint8(raw)
+		if err := common.ValidateField("Month", p.Month, "gte=1,lte=12"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Day
+	if raw, ok := reader.Read("Day", 13, 1, false); ok {
+		p.Day = common.BytesToUint8(raw)
+		if err := common.ValidateField("Day", p.Day, "gte=1,lte=31"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Hour
+	if raw, ok := reader.Read("Hour", 14, 1, false); ok {
+		p.Hour = common.BytesToUint8(raw)
+		if err := common.ValidateField("Hour", p.Hour, "gte=0,lte=23"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Minute
+	if raw, ok := reader.Read("Minute", 15, 1, false); ok {
+		p.Minute = common.BytesToUint8(raw)
+		if err := common.ValidateField("Minute", p.Minute, "gte=0,lte=59"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Second
+	if raw, ok := reader.Read("Second", 16, 1, false); ok {
+		p.Second = common.BytesToUint8(raw)
+		if err := common.ValidateField("Second", p.Second, "gte=0,lte=59"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort1Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port1Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(17)
+	var bytes []byte
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(0, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(0, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(0, 1, 2, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	writer.WriteBits(0, 1, 0, 1, bytes)
+
+	// Latitude
+	bytes = common.UnscaledBytes(float64(p.Latitude), config.Fields[4].Scale, config.Fields[4].Offset, 4)
+	writer.Write(1, 4, bytes)
+
+	// Longitude
+	bytes = common.UnscaledBytes(float64(p.Longitude), config.Fields[5].Scale, config.Fields[5].Offset, 4)
+	writer.Write(5, 4, bytes)
+
+	// Altitude
+	bytes = common.UnscaledBytes(float64(p.Altitude), config.Fields[6].Scale, config.Fields[6].Offset, 2)
+	writer.Write(9, 2, bytes)
+
+	// Year
+	bytes = common.UintToBytes(uint64(p.Year), 1)
+	writer.Write(11, 1, bytes)
+
+	// Month
+	bytes = common.UintToBytes(uint64(p.Month), 1)
+	writer.Write(12, 1, bytes)
+
+	// Day
+	bytes = common.UintToBytes(uint64(p.Day), 1)
+	writer.Write(13, 1, bytes)
+
+	// Hour
+	bytes = common.UintToBytes(uint64(p.Hour), 1)
+	writer.Write(14, 1, bytes)
+
+	// Minute
+	bytes = common.UintToBytes(uint64(p.Minute), 1)
+	writer.Write(15, 1, bytes)
+
+	// Second
+	bytes = common.UintToBytes(uint64(p.Second), 1)
+	writer.Write(16, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort4Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port4Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// LocalizationIntervalWhileMoving
+	if raw, ok := reader.Read("LocalizationIntervalWhileMoving", 0, 4, false); ok {
+		p.LocalizationIntervalWhileMoving = common.BytesToUint32(raw)
+		if err := common.ValidateField("LocalizationIntervalWhileMoving", p.LocalizationIntervalWhileMoving, "gte=60,lte=86400"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// LocalizationIntervalWhileSteady
+	if raw, ok := reader.Read("LocalizationIntervalWhileSteady", 4, 4, false); ok {
+		p.LocalizationIntervalWhileSteady = common.BytesToUint32(raw)
+		if err := common.ValidateField("LocalizationIntervalWhileSteady", p.LocalizationIntervalWhileSteady, "gte=120,lte=86400"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// HeartbeatInterval
+	if raw, ok := reader.Read("HeartbeatInterval", 8, 4, false); ok {
+		p.HeartbeatInterval = common.BytesToUint32(raw)
+		if err := common.ValidateField("HeartbeatInterval", p.HeartbeatInterval, "gte=300,lte=604800"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// GPSTimeoutWhileWaitingForFix
+	if raw, ok := reader.Read("GPSTimeoutWhileWaitingForFix", 12, 2, false); ok {
+		p.GPSTimeoutWhileWaitingForFix = common.BytesToUint16(raw)
+		if err := common.ValidateField("GPSTimeoutWhileWaitingForFix", p.GPSTimeoutWhileWaitingForFix, "gte=60,lte=86400"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// AccelerometerWakeupThreshold
+	if raw, ok := reader.Read("AccelerometerWakeupThreshold", 14, 2, false); ok {
+		p.AccelerometerWakeupThreshold = common.BytesToUint16(raw)
+		if err := common.ValidateField("AccelerometerWakeupThreshold", p.AccelerometerWakeupThreshold, "gte=10,lte=8000"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// AccelerometerDelay
+	if raw, ok := reader.Read("AccelerometerDelay", 16, 2, false); ok {
+		p.AccelerometerDelay = common.BytesToUint16(raw)
+		if err := common.ValidateField("AccelerometerDelay", p.AccelerometerDelay, "gte=1000,lte=10000"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// DeviceState
+	if raw, ok := reader.Read("DeviceState", 18, 1, false); ok {
+		p.DeviceState = common.BytesToUint8(raw)
+	}
+
+	// FirmwareVersionMajor
+	if raw, ok := reader.Read("FirmwareVersionMajor", 19, 1, false); ok {
+		p.FirmwareVersionMajor = common.BytesToUint8(raw)
+	}
+
+	// FirmwareVersionMinor
+	if raw, ok := reader.Read("FirmwareVersionMinor", 20, 1, false); ok {
+		p.FirmwareVersionMinor = common.BytesToUint8(raw)
+	}
+
+	// FirmwareVersionPatch
+	if raw, ok := reader.Read("FirmwareVersionPatch", 21, 1, false); ok {
+		p.FirmwareVersionPatch = common.BytesToUint8(raw)
+	}
+
+	// HardwareVersionType
+	if raw, ok := reader.Read("HardwareVersionType", 22, 1, false); ok {
+		p.HardwareVersionType = common.BytesToUint8(raw)
+	}
+
+	// HardwareVersionRevision
+	if raw, ok := reader.Read("HardwareVersionRevision", 23, 1, false); ok {
+		p.HardwareVersionRevision = common.BytesToUint8(raw)
+	}
+
+	// BatteryKeepAliveMessageInterval
+	if raw, ok := reader.Read("BatteryKeepAliveMessageInterval", 24, 4, false); ok {
+		p.BatteryKeepAliveMessageInterval = common.BytesToUint32(raw)
+		if err := common.ValidateField("BatteryKeepAliveMessageInterval", p.BatteryKeepAliveMessageInterval, "gte=300,lte=604800"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// BatchSize
+	if raw, ok := reader.Read("BatchSize", 28, 2, true); ok {
+		{
+			value := common.BytesToUint16(raw)
+			p.BatchSize = &value
+		}
+		if err := common.ValidateField("BatchSize", p.BatchSize, "lte=50"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// BufferSize
+	if raw, ok := reader.Read("BufferSize", 30, 2, true); ok {
+		{
+			value := common.BytesToUint16(raw)
+			p.BufferSize = &value
+		}
+		if err := common.ValidateField("BufferSize", p.BufferSize, "gte=128,lte=8128"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort4Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port4Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(32)
+	var bytes []byte
+
+	// LocalizationIntervalWhileMoving
+	bytes = common.UintToBytes(uint64(p.LocalizationIntervalWhileMoving), 4)
+	writer.Write(0, 4, bytes)
+
+	// LocalizationIntervalWhileSteady
+	bytes = common.UintToBytes(uint64(p.LocalizationIntervalWhileSteady), 4)
+	writer.Write(4, 4, bytes)
+
+	// HeartbeatInterval
+	bytes = common.UintToBytes(uint64(p.HeartbeatInterval), 4)
+	writer.Write(8, 4, bytes)
+
+	// GPSTimeoutWhileWaitingForFix
+	bytes = common.UintToBytes(uint64(p.GPSTimeoutWhileWaitingForFix), 2)
+	writer.Write(12, 2, bytes)
+
+	// AccelerometerWakeupThreshold
+	bytes = common.UintToBytes(uint64(p.AccelerometerWakeupThreshold), 2)
+	writer.Write(14, 2, bytes)
+
+	// AccelerometerDelay
+	bytes = common.UintToBytes(uint64(p.AccelerometerDelay), 2)
+	writer.Write(16, 2, bytes)
+
+	// DeviceState
+	bytes = common.UintToBytes(uint64(p.DeviceState), 1)
+	writer.Write(18, 1, bytes)
+
+	// FirmwareVersionMajor
+	bytes = common.UintToBytes(uint64(p.FirmwareVersionMajor), 1)
+	writer.Write(19, 1, bytes)
+
+	// FirmwareVersionMinor
+	bytes = common.UintToBytes(uint64(p.FirmwareVersionMinor), 1)
+	writer.Write(20, 1, bytes)
+
+	// FirmwareVersionPatch
+	bytes = common.UintToBytes(uint64(p.FirmwareVersionPatch), 1)
+	writer.Write(21, 1, bytes)
+
+	// HardwareVersionType
+	bytes = common.UintToBytes(uint64(p.HardwareVersionType), 1)
+	writer.Write(22, 1, bytes)
+
+	// HardwareVersionRevision
+	bytes = common.UintToBytes(uint64(p.HardwareVersionRevision), 1)
+	writer.Write(23, 1, bytes)
+
+	// BatteryKeepAliveMessageInterval
+	bytes = common.UintToBytes(uint64(p.BatteryKeepAliveMessageInterval), 4)
+	writer.Write(24, 4, bytes)
+
+	// BatchSize
+	if p.BatchSize != nil {
+		bytes = common.UintToBytes(uint64(*p.BatchSize), 2)
+		if *p.BatchSize != 0 {
+			writer.Write(28, 2, bytes)
+		}
+	}
+
+	// BufferSize
+	if p.BufferSize != nil {
+		bytes = common.UintToBytes(uint64(*p.BufferSize), 2)
+		if *p.BufferSize != 0 {
+			writer.Write(30, 2, bytes)
+		}
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort5Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port5Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 0, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 0, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 0, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		p.Moving = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// AccessPoints
+	for i := 0; i < 7; i++ {
+		raw, ok := reader.Read("AccessPoints", 1+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := tagsl.AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		item.Rssi = common.BytesToInt8(raw[6:7])
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort5Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port5Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(50)
+	var bytes []byte
+	var err error
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(0, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(0, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(0, 1, 2, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	writer.WriteBits(0, 1, 0, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 7); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(1, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(1+i*7, 7)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(1+i*7, 6, bytes)
+		bytes = common.IntToBytes(int64(item.Rssi), 1)
+		writer.Write(7+i*7, 1, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort6Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port6Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// ButtonPressed
+	if raw, ok := reader.Read("ButtonPressed", 0, 1, false); ok {
+		p.ButtonPressed = raw[0]&0x01 == 1
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort6Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port6Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(1)
+	var bytes []byte
+
+	// ButtonPressed
+	bytes = common.BoolToBytes(p.ButtonPressed, 0)
+	writer.Write(0, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort7Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port7Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 0, 4, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 4, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 4, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 4, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 4, 1, false); ok {
+		p.Moving = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// AccessPoints
+	for i := 0; i < 6; i++ {
+		raw, ok := reader.Read("AccessPoints", 5+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := tagsl.AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		item.Rssi = common.BytesToInt8(raw[6:7])
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort7Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port7Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(47)
+	var bytes []byte
+	var err error
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 4, bytes)
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(4, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(4, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(4, 1, 2, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	writer.WriteBits(4, 1, 0, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 6); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(5, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(5+i*7, 7)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(5+i*7, 6, bytes)
+		bytes = common.IntToBytes(int64(item.Rssi), 1)
+		writer.Write(11+i*7, 1, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort10Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port10Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 0, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 0, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 0, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		p.Moving = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// Latitude
+	if raw, ok := reader.Read("Latitude", 1, 4, false); ok {
+		p.Latitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[4].Scale, config.Fields[4].Offset))
+		if err := common.ValidateField("Latitude", p.Latitude, "gte=-90,lte=90"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Longitude
+	if raw, ok := reader.Read("Longitude", 5, 4, false); ok {
+		p.Longitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[5].Scale, config.Fields[5].Offset))
+		if err := common.ValidateField("Longitude", p.Longitude, "gte=-180,lte=180"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Altitude
+	if raw, ok := reader.Read("Altitude", 9, 2, false); ok {
+		p.Altitude = float64(common.ScaledValue(raw, false, config.Fields[6].Scale, config.Fields[6].Offset))
+	}
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 11, 4, false); ok {
+		if value := config.Fields[7].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Battery
+	if raw, ok := reader.Read("Battery", 15, 2, false); ok {
+		p.Battery = float64(common.ScaledValue(raw, false, config.Fields[8].Scale, config.Fields[8].Offset))
+		if err := common.ValidateField("Battery", p.Battery, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// TTF
+	if raw, ok := reader.Read("TTF", 17, 1, false); ok {
+		{
+			value := time.Duration(common.ScaledValue(raw, false, config.Fields[9].Scale, config.Fields[9].Offset) * float64(time.Second))
+			p.TTF = &value
+		}
+	}
+
+	// PDOP
+	if raw, ok := reader.Read("PDOP", 18, 1, false); ok {
+		{
+			value := float64(common.ScaledValue(raw, false, config.Fields[10].Scale, config.Fields[10].Offset))
+			p.PDOP = &value
+		}
+	}
+
+	// Satellites
+	if raw, ok := reader.Read("Satellites", 19, 1, false); ok {
+		{
+			value := common.BytesToUint8(raw)
+			p.Satellites = &value
+		}
+		if err := common.ValidateField("Satellites", p.Satellites, "gte=3,lte=27"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort10Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port10Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(20)
+	var bytes []byte
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(0, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(0, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(0, 1, 2, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	writer.WriteBits(0, 1, 0, 1, bytes)
+
+	// Latitude
+	bytes = common.UnscaledBytes(float64(p.Latitude), config.Fields[4].Scale, config.Fields[4].Offset, 4)
+	writer.Write(1, 4, bytes)
+
+	// Longitude
+	bytes = common.UnscaledBytes(float64(p.Longitude), config.Fields[5].Scale, config.Fields[5].Offset, 4)
+	writer.Write(5, 4, bytes)
+
+	// Altitude
+	bytes = common.UnscaledBytes(float64(p.Altitude), config.Fields[6].Scale, config.Fields[6].Offset, 2)
+	writer.Write(9, 2, bytes)
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[7].Transform(bytes).([]byte)
+	writer.Write(11, 4, bytes)
+
+	// Battery
+	bytes = common.UnscaledBytes(float64(p.Battery), config.Fields[8].Scale, config.Fields[8].Offset, 2)
+	writer.Write(15, 2, bytes)
+
+	// TTF
+	if p.TTF != nil {
+		bytes = common.UnscaledBytes((*p.TTF).Seconds(), config.Fields[9].Scale, config.Fields[9].Offset, 1)
+		writer.Write(17, 1, bytes)
+	} else {
+		writer.Write(17, 1, make([]byte, 1))
+	}
+
+	// PDOP
+	if p.PDOP != nil {
+		bytes = common.UnscaledBytes(float64(*p.PDOP), config.Fields[10].Scale, config.Fields[10].Offset, 1)
+		writer.Write(18, 1, bytes)
+	} else {
+		writer.Write(18, 1, make([]byte, 1))
+	}
+
+	// Satellites
+	if p.Satellites != nil {
+		bytes = common.UintToBytes(uint64(*p.Satellites), 1)
+		writer.Write(19, 1, bytes)
+	} else {
+		writer.Write(19, 1, make([]byte, 1))
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort15Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port15Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 0, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 0, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 0, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// LowBattery
+	if raw, ok := reader.Read("LowBattery", 0, 1, false); ok {
+		p.LowBattery = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// Battery
+	if raw, ok := reader.Read("Battery", 1, 2, false); ok {
+		p.Battery = float64(common.ScaledValue(raw, false, config.Fields[4].Scale, config.Fields[4].Offset))
+		if err := common.ValidateField("Battery", p.Battery, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort15Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port15Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(3)
+	var bytes []byte
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(0, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(0, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(0, 1, 2, 1, bytes)
+
+	// LowBattery
+	bytes = common.BoolToBytes(p.LowBattery, 0)
+	writer.WriteBits(0, 1, 0, 1, bytes)
+
+	// Battery
+	bytes = common.UnscaledBytes(float64(p.Battery), config.Fields[4].Scale, config.Fields[4].Offset, 2)
+	writer.Write(1, 2, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort50Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port50Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 0, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 0, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 0, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		p.Moving = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// Latitude
+	if raw, ok := reader.Read("Latitude", 1, 4, false); ok {
+		p.Latitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[4].Scale, config.Fields[4].Offset))
+		if err := common.ValidateField("Latitude", p.Latitude, "gte=-90,lte=90"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Longitude
+	if raw, ok := reader.Read("Longitude", 5, 4, false); ok {
+		p.Longitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[5].Scale, config.Fields[5].Offset))
+		if err := common.ValidateField("Longitude", p.Longitude, "gte=-180,lte=180"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Altitude
+	if raw, ok := reader.Read("Altitude", 9, 2, false); ok {
+		p.Altitude = float64(common.ScaledValue(raw, false, config.Fields[6].Scale, config.Fields[6].Offset))
+	}
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 11, 4, false); ok {
+		if value := config.Fields[7].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Battery
+	if raw, ok := reader.Read("Battery", 15, 2, false); ok {
+		p.Battery = float64(common.ScaledValue(raw, false, config.Fields[8].Scale, config.Fields[8].Offset))
+		if err := common.ValidateField("Battery", p.Battery, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// TTF
+	if raw, ok := reader.Read("TTF", 17, 1, false); ok {
+		p.TTF = time.Duration(common.ScaledValue(raw, false, config.Fields[9].Scale, config.Fields[9].Offset) * float64(time.Second))
+	}
+
+	// AccessPoints
+	for i := 0; i < 4; i++ {
+		raw, ok := reader.Read("AccessPoints", 18+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := tagsl.AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		item.Rssi = common.BytesToInt8(raw[6:7])
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort50Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port50Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(46)
+	var bytes []byte
+	var err error
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(0, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(0, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(0, 1, 2, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	writer.WriteBits(0, 1, 0, 1, bytes)
+
+	// Latitude
+	bytes = common.UnscaledBytes(float64(p.Latitude), config.Fields[4].Scale, config.Fields[4].Offset, 4)
+	writer.Write(1, 4, bytes)
+
+	// Longitude
+	bytes = common.UnscaledBytes(float64(p.Longitude), config.Fields[5].Scale, config.Fields[5].Offset, 4)
+	writer.Write(5, 4, bytes)
+
+	// Altitude
+	bytes = common.UnscaledBytes(float64(p.Altitude), config.Fields[6].Scale, config.Fields[6].Offset, 2)
+	writer.Write(9, 2, bytes)
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[7].Transform(bytes).([]byte)
+	writer.Write(11, 4, bytes)
+
+	// Battery
+	bytes = common.UnscaledBytes(float64(p.Battery), config.Fields[8].Scale, config.Fields[8].Offset, 2)
+	writer.Write(15, 2, bytes)
+
+	// TTF
+	bytes = common.UnscaledBytes((p.TTF).Seconds(), config.Fields[9].Scale, config.Fields[9].Offset, 1)
+	writer.Write(17, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 4); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(18, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(18+i*7, 7)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(18+i*7, 6, bytes)
+		bytes = common.IntToBytes(int64(item.Rssi), 1)
+		writer.Write(24+i*7, 1, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort51Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port51Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 0, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 0, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 0, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 0, 1, false); ok {
+		p.Moving = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// Latitude
+	if raw, ok := reader.Read("Latitude", 1, 4, false); ok {
+		p.Latitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[4].Scale, config.Fields[4].Offset))
+		if err := common.ValidateField("Latitude", p.Latitude, "gte=-90,lte=90"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Longitude
+	if raw, ok := reader.Read("Longitude", 5, 4, false); ok {
+		p.Longitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[5].Scale, config.Fields[5].Offset))
+		if err := common.ValidateField("Longitude", p.Longitude, "gte=-180,lte=180"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Altitude
+	if raw, ok := reader.Read("Altitude", 9, 2, false); ok {
+		p.Altitude = float64(common.ScaledValue(raw, false, config.Fields[6].Scale, config.Fields[6].Offset))
+	}
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 11, 4, false); ok {
+		if value := config.Fields[7].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Battery
+	if raw, ok := reader.Read("Battery", 15, 2, false); ok {
+		p.Battery = float64(common.ScaledValue(raw, false, config.Fields[8].Scale, config.Fields[8].Offset))
+		if err := common.ValidateField("Battery", p.Battery, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// TTF
+	if raw, ok := reader.Read("TTF", 17, 1, false); ok {
+		p.TTF = time.Duration(common.ScaledValue(raw, false, config.Fields[9].Scale, config.Fields[9].Offset) * float64(time.Second))
+	}
+
+	// PDOP
+	if raw, ok := reader.Read("PDOP", 18, 1, false); ok {
+		p.PDOP = float64(common.ScaledValue(raw, false, config.Fields[10].Scale, config.Fields[10].Offset))
+	}
+
+	// Satellites
+	if raw, ok := reader.Read("Satellites", 19, 1, false); ok {
+		p.Satellites = common.BytesToUint8(raw)
+		if err := common.ValidateField("Satellites", p.Satellites, "gte=3,lte=27"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// AccessPoints
+	for i := 0; i < 4; i++ {
+		raw, ok := reader.Read("AccessPoints", 20+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := tagsl.AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		item.Rssi = common.BytesToInt8(raw[6:7])
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort51Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port51Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(48)
+	var bytes []byte
+	var err error
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(0, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(0, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(0, 1, 2, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	writer.WriteBits(0, 1, 0, 1, bytes)
+
+	// Latitude
+	bytes = common.UnscaledBytes(float64(p.Latitude), config.Fields[4].Scale, config.Fields[4].Offset, 4)
+	writer.Write(1, 4, bytes)
+
+	// Longitude
+	bytes = common.UnscaledBytes(float64(p.Longitude), config.Fields[5].Scale, config.Fields[5].Offset, 4)
+	writer.Write(5, 4, bytes)
+
+	// Altitude
+	bytes = common.UnscaledBytes(float64(p.Altitude), config.Fields[6].Scale, config.Fields[6].Offset, 2)
+	writer.Write(9, 2, bytes)
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[7].Transform(bytes).([]byte)
+	writer.Write(11, 4, bytes)
+
+	// Battery
+	bytes = common.UnscaledBytes(float64(p.Battery), config.Fields[8].Scale, config.Fields[8].Offset, 2)
+	writer.Write(15, 2, bytes)
+
+	// TTF
+	bytes = common.UnscaledBytes((p.TTF).Seconds(), config.Fields[9].Scale, config.Fields[9].Offset, 1)
+	writer.Write(17, 1, bytes)
+
+	// PDOP
+	bytes = common.UnscaledBytes(float64(p.PDOP), config.Fields[10].Scale, config.Fields[10].Offset, 1)
+	writer.Write(18, 1, bytes)
+
+	// Satellites
+	bytes = common.UintToBytes(uint64(p.Satellites), 1)
+	writer.Write(19, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 4); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(20, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(20+i*7, 7)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(20+i*7, 6, bytes)
+		bytes = common.IntToBytes(int64(item.Rssi), 1)
+		writer.Write(26+i*7, 1, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort105Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port105Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// BufferLevel
+	if raw, ok := reader.Read("BufferLevel", 0, 2, false); ok {
+		p.BufferLevel = common.BytesToUint16(raw)
+	}
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 2, 4, false); ok {
+		if value := config.Fields[1].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 6, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 6, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 6, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 6, 1, false); ok {
+		p.Moving = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// AccessPoints
+	for i := 0; i < 6; i++ {
+		raw, ok := reader.Read("AccessPoints", 7+i*7, 7, i > 0)
+		if !ok {
+			break
+		}
+
+		item := tagsl.AccessPoint{}
+		item.Mac = common.HexValue(raw[0:6])
+		item.Rssi = common.BytesToInt8(raw[6:7])
+		if err := common.ValidateField(common.GroupFieldName("AccessPoints", i, "Rssi"), item.Rssi, "gte=-120,lte=-20"); err != nil {
+			errs = append(errs, err)
+		}
+		p.AccessPoints = append(p.AccessPoints, item)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort105Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port105Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(49)
+	var bytes []byte
+	var err error
+
+	// BufferLevel
+	bytes = common.UintToBytes(uint64(p.BufferLevel), 2)
+	writer.Write(0, 2, bytes)
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[1].Transform(bytes).([]byte)
+	writer.Write(2, 4, bytes)
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(6, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(6, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(6, 1, 2, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	writer.WriteBits(6, 1, 0, 1, bytes)
+
+	// AccessPoints
+	if err := common.CheckGroupCount("AccessPoints", len(p.AccessPoints), 6); err != nil {
+		return "", err
+	}
+	if len(p.AccessPoints) == 0 {
+		writer.Reserve(7, 7)
+	}
+	for i, item := range p.AccessPoints {
+		writer.Reserve(7+i*7, 7)
+		bytes, err = common.HexStringToBytes(item.Mac)
+		if err != nil {
+			return "", err
+		}
+		writer.Write(7+i*7, 6, bytes)
+		bytes = common.IntToBytes(int64(item.Rssi), 1)
+		writer.Write(13+i*7, 1, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort110Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := tagsl.Port110Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// BufferLevel
+	if raw, ok := reader.Read("BufferLevel", 0, 2, false); ok {
+		p.BufferLevel = common.BytesToUint16(raw)
+	}
+
+	// DutyCycle
+	if raw, ok := reader.Read("DutyCycle", 2, 1, false); ok {
+		p.DutyCycle = common.ExtractBits(raw, 7, 1)[0]&0x01 == 1
+	}
+
+	// ConfigId
+	if raw, ok := reader.Read("ConfigId", 2, 1, false); ok {
+		p.ConfigId = common.BytesToUint8(common.ExtractBits(raw, 3, 4))
+		if err := common.ValidateField("ConfigId", p.ConfigId, "gte=0,lte=15"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigChange
+	if raw, ok := reader.Read("ConfigChange", 2, 1, false); ok {
+		p.ConfigChange = common.ExtractBits(raw, 2, 1)[0]&0x01 == 1
+	}
+
+	// Moving
+	if raw, ok := reader.Read("Moving", 2, 1, false); ok {
+		p.Moving = common.ExtractBits(raw, 0, 1)[0]&0x01 == 1
+	}
+
+	// Latitude
+	if raw, ok := reader.Read("Latitude", 3, 4, false); ok {
+		p.Latitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[5].Scale, config.Fields[5].Offset))
+		if err := common.ValidateField("Latitude", p.Latitude, "gte=-90,lte=90"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Longitude
+	if raw, ok := reader.Read("Longitude", 7, 4, false); ok {
+		p.Longitude = float64(common.ScaledValue(common.ExtendBytes(raw, 32, true), true, config.Fields[6].Scale, config.Fields[6].Offset))
+		if err := common.ValidateField("Longitude", p.Longitude, "gte=-180,lte=180"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Altitude
+	if raw, ok := reader.Read("Altitude", 11, 2, false); ok {
+		p.Altitude = float64(common.ScaledValue(raw, false, config.Fields[7].Scale, config.Fields[7].Offset))
+	}
+
+	// Timestamp
+	if raw, ok := reader.Read("Timestamp", 13, 4, false); ok {
+		if value := config.Fields[8].Transform(raw); value != nil {
+			p.Timestamp = value.(time.Time)
+		}
+	}
+
+	// Battery
+	if raw, ok := reader.Read("Battery", 17, 2, false); ok {
+		p.Battery = float64(common.ScaledValue(raw, false, config.Fields[9].Scale, config.Fields[9].Offset))
+		if err := common.ValidateField("Battery", p.Battery, "gte=1,lte=5"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// TTF
+	if raw, ok := reader.Read("TTF", 19, 1, false); ok {
+		{
+			value := time.Duration(common.ScaledValue(raw, false, config.Fields[10].Scale, config.Fields[10].Offset) * float64(time.Second))
+			p.TTF = &value
+		}
+	}
+
+	// PDOP
+	if raw, ok := reader.Read("PDOP", 20, 1, false); ok {
+		{
+			value := float64(common.ScaledValue(raw, false, config.Fields[11].Scale, config.Fields[11].Offset))
+			p.PDOP = &value
+		}
+	}
+
+	// Satellites
+	if raw, ok := reader.Read("Satellites", 21, 1, false); ok {
+		{
+			value := common.BytesToUint8(raw)
+			p.Satellites = &value
+		}
+		if err := common.ValidateField("Satellites", p.Satellites, "gte=3,lte=27"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort110Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(tagsl.Port110Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(22)
+	var bytes []byte
+
+	// BufferLevel
+	bytes = common.UintToBytes(uint64(p.BufferLevel), 2)
+	writer.Write(0, 2, bytes)
+
+	// DutyCycle
+	bytes = common.BoolToBytes(p.DutyCycle, 0)
+	writer.WriteBits(2, 1, 7, 1, bytes)
+
+	// ConfigId
+	bytes = common.UintToBytes(uint64(p.ConfigId), 1)
+	writer.WriteBits(2, 1, 3, 4, bytes)
+
+	// ConfigChange
+	bytes = common.BoolToBytes(p.ConfigChange, 0)
+	writer.WriteBits(2, 1, 2, 1, bytes)
+
+	// Moving
+	bytes = common.BoolToBytes(p.Moving, 0)
+	writer.WriteBits(2, 1, 0, 1, bytes)
+
+	// Latitude
+	bytes = common.UnscaledBytes(float64(p.Latitude), config.Fields[5].Scale, config.Fields[5].Offset, 4)
+	writer.Write(3, 4, bytes)
+
+	// Longitude
+	bytes = common.UnscaledBytes(float64(p.Longitude), config.Fields[6].Scale, config.Fields[6].Offset, 4)
+	writer.Write(7, 4, bytes)
+
+	// Altitude
+	bytes = common.UnscaledBytes(float64(p.Altitude), config.Fields[7].Scale, config.Fields[7].Offset, 2)
+	writer.Write(11, 2, bytes)
+
+	// Timestamp
+	bytes = common.IntToBytes(p.Timestamp.Unix(), 8)
+	bytes = config.Fields[8].Transform(bytes).([]byte)
+	writer.Write(13, 4, bytes)
+
+	// Battery
+	bytes = common.UnscaledBytes(float64(p.Battery), config.Fields[9].Scale, config.Fields[9].Offset, 2)
+	writer.Write(17, 2, bytes)
+
+	// TTF
+	if p.TTF != nil {
+		bytes = common.UnscaledBytes((*p.TTF).Seconds(), config.Fields[10].Scale, config.Fields[10].Offset, 1)
+		writer.Write(19, 1, bytes)
+	} else {
+		writer.Write(19, 1, make([]byte, 1))
+	}
+
+	// PDOP
+	if p.PDOP != nil {
+		bytes = common.UnscaledBytes(float64(*p.PDOP), config.Fields[11].Scale, config.Fields[11].Offset, 1)
+		writer.Write(20, 1, bytes)
+	} else {
+		writer.Write(20, 1, make([]byte, 1))
+	}
+
+	// Satellites
+	if p.Satellites != nil {
+		bytes = common.UintToBytes(uint64(*p.Satellites), 1)
+		writer.Write(21, 1, bytes)
+	} else {
+		writer.Write(21, 1, make([]byte, 1))
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort128Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port128Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// Ble
+	if raw, ok := reader.Read("Ble", 0, 1, false); ok {
+		p.Ble = raw[0]&0x01 == 1
+	}
+
+	// Gnss
+	if raw, ok := reader.Read("Gnss", 1, 1, false); ok {
+		p.Gnss = raw[0]&0x01 == 1
+	}
+
+	// Wifi
+	if raw, ok := reader.Read("Wifi", 2, 1, false); ok {
+		p.Wifi = raw[0]&0x01 == 1
+	}
+
+	// MovingInterval
+	if raw, ok := reader.Read("MovingInterval", 3, 4, false); ok {
+		p.MovingInterval = common.BytesToUint32(raw)
+		if err := common.ValidateField("MovingInterval", p.MovingInterval, "gte=60,lte=86400"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// SteadyInterval
+	if raw, ok := reader.Read("SteadyInterval", 7, 4, false); ok {
+		p.SteadyInterval = common.BytesToUint32(raw)
+		if err := common.ValidateField("SteadyInterval", p.SteadyInterval, "gte=120,lte=86400"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// ConfigInterval
+	if raw, ok := reader.Read("ConfigInterval", 11, 4, false); ok {
+		p.ConfigInterval = common.BytesToUint32(raw)
+		if err := common.ValidateField("ConfigInterval", p.ConfigInterval, "gte=300,lte=604800"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// GnssTimeout
+	if raw, ok := reader.Read("GnssTimeout", 15, 2, false); ok {
+		p.GnssTimeout = common.BytesToUint16(raw)
+		if err := common.ValidateField("GnssTimeout", p.GnssTimeout, "gte=60,lte=86400"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// AccelerometerThreshold
+	if raw, ok := reader.Read("AccelerometerThreshold", 17, 2, false); ok {
+		p.AccelerometerThreshold = common.BytesToUint16(raw)
+		if err := common.ValidateField("AccelerometerThreshold", p.AccelerometerThreshold, "gte=10,lte=8000"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// AccelerometerDelay
+	if raw, ok := reader.Read("AccelerometerDelay", 19, 2, false); ok {
+		p.AccelerometerDelay = common.BytesToUint16(raw)
+		if err := common.ValidateField("AccelerometerDelay", p.AccelerometerDelay, "gte=1000,lte=10000"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// BatteryInterval
+	if raw, ok := reader.Read("BatteryInterval", 21, 4, false); ok {
+		p.BatteryInterval = common.BytesToUint32(raw)
+		if err := common.ValidateField("BatteryInterval", p.BatteryInterval, "gte=300,lte=604800"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// BatchSize
+	if raw, ok := reader.Read("BatchSize", 25, 2, true); ok {
+		p.BatchSize = common.BytesToUint16(raw)
+		if err := common.ValidateField("BatchSize", p.BatchSize, "lte=50"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// BufferSize
+	if raw, ok := reader.Read("BufferSize", 27, 2, true); ok {
+		p.BufferSize = common.BytesToUint16(raw)
+		if err := common.ValidateField("BufferSize", p.BufferSize, "gte=128,lte=8128"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort128Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port128Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(29)
+	var bytes []byte
+
+	// Ble
+	bytes = common.BoolToBytes(p.Ble, 0)
+	writer.Write(0, 1, bytes)
+
+	// Gnss
+	bytes = common.BoolToBytes(p.Gnss, 0)
+	writer.Write(1, 1, bytes)
+
+	// Wifi
+	bytes = common.BoolToBytes(p.Wifi, 0)
+	writer.Write(2, 1, bytes)
+
+	// MovingInterval
+	bytes = common.UintToBytes(uint64(p.MovingInterval), 4)
+	writer.Write(3, 4, bytes)
+
+	// SteadyInterval
+	bytes = common.UintToBytes(uint64(p.SteadyInterval), 4)
+	writer.Write(7, 4, bytes)
+
+	// ConfigInterval
+	bytes = common.UintToBytes(uint64(p.ConfigInterval), 4)
+	writer.Write(11, 4, bytes)
+
+	// GnssTimeout
+	bytes = common.UintToBytes(uint64(p.GnssTimeout), 2)
+	writer.Write(15, 2, bytes)
+
+	// AccelerometerThreshold
+	bytes = common.UintToBytes(uint64(p.AccelerometerThreshold), 2)
+	writer.Write(17, 2, bytes)
+
+	// AccelerometerDelay
+	bytes = common.UintToBytes(uint64(p.AccelerometerDelay), 2)
+	writer.Write(19, 2, bytes)
+
+	// BatteryInterval
+	bytes = common.UintToBytes(uint64(p.BatteryInterval), 4)
+	writer.Write(21, 4, bytes)
+
+	// BatchSize
+	bytes = common.UintToBytes(uint64(p.BatchSize), 2)
+	if p.BatchSize != 0 {
+		writer.Write(25, 2, bytes)
+	}
+
+	// BufferSize
+	bytes = common.UintToBytes(uint64(p.BufferSize), 2)
+	if p.BufferSize != 0 {
+		writer.Write(27, 2, bytes)
+	}
+
+	return writer.String(), nil
+}
+
+func decodePort129Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port129Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// TimeToBuzz
+	if raw, ok := reader.Read("TimeToBuzz", 0, 1, false); ok {
+		p.TimeToBuzz = common.BytesToUint8(raw)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort129Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port129Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(1)
+	var bytes []byte
+
+	// TimeToBuzz
+	bytes = common.UintToBytes(uint64(p.TimeToBuzz), 1)
+	writer.Write(0, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort130Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port130Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// EraseFlash
+	if raw, ok := reader.Read("EraseFlash", 0, 1, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.EraseFlash = value.(bool)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort130Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port130Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(1)
+	var bytes []byte
+
+	// EraseFlash
+	bytes = common.BoolToBytes(p.EraseFlash, 0)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort131Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port131Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// AccuracyEnhancement
+	if raw, ok := reader.Read("AccuracyEnhancement", 0, 1, false); ok {
+		p.AccuracyEnhancement = common.BytesToUint8(raw)
+		if err := common.ValidateField("AccuracyEnhancement", p.AccuracyEnhancement, "lte=59"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort131Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port131Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(1)
+	var bytes []byte
+
+	// AccuracyEnhancement
+	bytes = common.UintToBytes(uint64(p.AccuracyEnhancement), 1)
+	writer.Write(0, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort132Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port132Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// EraseFlash
+	if raw, ok := reader.Read("EraseFlash", 0, 1, false); ok {
+		if value := config.Fields[0].Transform(raw); value != nil {
+			p.EraseFlash = value.(bool)
+		}
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort132Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port132Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(1)
+	var bytes []byte
+
+	// EraseFlash
+	bytes = common.BoolToBytes(p.EraseFlash, 0)
+	bytes = config.Fields[0].Transform(bytes).([]byte)
+	writer.Write(0, 1, bytes)
+
+	return writer.String(), nil
+}
+
+func decodePort134Payload(payload []byte, config *common.PayloadConfig) (any, error) {
+	p := Port134Payload{}
+	errs := []error{}
+
+	reader := common.NewFieldReader(payload, config)
+
+	// ScanInterval
+	if raw, ok := reader.Read("ScanInterval", 0, 2, false); ok {
+		p.ScanInterval = common.BytesToUint16(raw)
+	}
+
+	// ScanTime
+	if raw, ok := reader.Read("ScanTime", 2, 1, false); ok {
+		p.ScanTime = common.BytesToUint8(raw)
+		if err := common.ValidateField("ScanTime", p.ScanTime, "lte=180"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// MaxBeacons
+	if raw, ok := reader.Read("MaxBeacons", 3, 1, false); ok {
+		p.MaxBeacons = common.BytesToUint8(raw)
+	}
+
+	// MinRssi
+	if raw, ok := reader.Read("MinRssi", 4, 1, false); ok {
+		p.MinRssi = common.BytesToInt8(raw)
+	}
+
+	// AdvertisingName
+	if raw, ok := reader.Read("AdvertisingName", 5, 10, false); ok {
+		if value := config.Fields[4].Transform(raw); value != nil {
+			p.AdvertisingName = value.([]byte)
+		}
+		if err := common.ValidateField("AdvertisingName", p.AdvertisingName, "max=9"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// AccelerometerDelay
+	if raw, ok := reader.Read("AccelerometerDelay", 15, 2, false); ok {
+		p.AccelerometerDelay = common.BytesToUint16(raw)
+	}
+
+	// AccelerometerThreshold
+	if raw, ok := reader.Read("AccelerometerThreshold", 17, 2, false); ok {
+		p.AccelerometerThreshold = common.BytesToUint16(raw)
+	}
+
+	// ScanMode
+	if raw, ok := reader.Read("ScanMode", 19, 1, false); ok {
+		p.ScanMode = common.BytesToUint8(raw)
+		if err := common.ValidateField("ScanMode", p.ScanMode, "lte=2"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// BleConfigUplinkInterval
+	if raw, ok := reader.Read("BleConfigUplinkInterval", 20, 2, false); ok {
+		p.BleConfigUplinkInterval = common.BytesToUint16(raw)
+	}
+
+	return reader.Result(p, errs)
+}
+
+func encodePort134Payload(data any, config common.PayloadConfig) (string, error) {
+	p, ok := data.(Port134Payload)
+	if !ok {
+		return common.EncodeReflect(data, config)
+	}
+
+	writer := common.NewPayloadWriter(22)
+	var bytes []byte
+
+	// ScanInterval
+	bytes = common.UintToBytes(uint64(p.ScanInterval), 2)
+	writer.Write(0, 2, bytes)
+
+	// ScanTime
+	bytes = common.UintToBytes(uint64(p.ScanTime), 1)
+	writer.Write(2, 1, bytes)
+
+	// MaxBeacons
+	bytes = common.UintToBytes(uint64(p.MaxBeacons), 1)
+	writer.Write(3, 1, bytes)
+
+	// MinRssi
+	bytes = common.IntToBytes(int64(p.MinRssi), 1)
+	writer.Write(4, 1, bytes)
+
+	// AdvertisingName
+	bytes = p.AdvertisingName
+	bytes = config.Fields[4].Transform(bytes).([]byte)
+	writer.Write(5, 10, bytes)
+
+	// AccelerometerDelay
+	bytes = common.UintToBytes(uint64(p.AccelerometerDelay), 2)
+	writer.Write(15, 2, bytes)
+
+	// AccelerometerThreshold
+	bytes = common.UintToBytes(uint64(p.AccelerometerThreshold), 2)
+	writer.Write(17, 2, bytes)
+
+	// ScanMode
+	bytes = common.UintToBytes(uint64(p.ScanMode), 1)
+	writer.Write(19, 1, bytes)
+
+	// BleConfigUplinkInterval
+	bytes = common.UintToBytes(uint64(p.BleConfigUplinkInterval), 2)
+	writer.Write(20, 2, bytes)
+
+	return writer.String(), nil
+}
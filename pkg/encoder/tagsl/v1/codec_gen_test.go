@@ -0,0 +1,102 @@
+package tagsl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	helpers "github.com/truvami/decoder/pkg/common"
+	tagsl "github.com/truvami/decoder/pkg/decoder/tagsl/v1"
+)
+
+var generatedTests = []struct {
+	port    uint8
+	payload string
+}{
+	{port: 1, payload: "8002cdcd1300744f5e166018040b14341a"},
+	{port: 4, payload: "0000003c0000012c000151800078012c05dc02020100010200005460"},
+	{port: 4, payload: "0000003c0000012c000151800078012c05dc02020100010200005460000a1000"},
+	{port: 5, payload: "00e0286d8aabfca8e0286d8a9478c2726c9a74b58dab726cdac8b89dacf0b0140c96bbc8"},
+	{port: 6, payload: "01"},
+	{port: 7, payload: "66ec04bb80e0286d8aabfcbbec6c9a74b58fb2726c9a74b58db1e0286d8a9478cbf0b0140c96bbd2260122180d42ad"},
+	{port: 10, payload: "0002d30b070082491f11256718d9fe0ede190505"},
+	{port: 15, payload: "000ee5"},
+	{port: 50, payload: "0002d30c9300824c87117966c45dcd0f8118e0286d8aabfca9f0b0140c96bbc8726c9a74b58da8e0286d8a9478bf"},
+}
+
+func TestGeneratedCodec(t *testing.T) {
+	decoder := tagsl.NewTagSLv1Decoder()
+	encoder := TagSLv1Encoder{}
+
+	for _, test := range generatedTests {
+		t.Run(fmt.Sprintf("TestPort%vWith%v", test.port, test.payload), func(t *testing.T) {
+			uplink, err := decoder.Decode(context.TODO(), test.payload, test.port)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			config, err := encoder.PayloadConfig(test.port)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !helpers.HasGenerated(&config) {
+				t.Fatalf("expected generated codec for port %v", test.port)
+			}
+
+			got, err := helpers.Encode(uplink.Data, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected, err := helpers.EncodeReflect(uplink.Data, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != expected {
+				t.Errorf("expected: %v\ngot: %v", expected, got)
+			}
+		})
+	}
+
+	t.Run("TestFallbackToReflection", func(t *testing.T) {
+		config, err := encoder.PayloadConfig(1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err = helpers.Encode(&tagsl.Port1Payload{}, config)
+		if err == nil || err.Error() != "data must be a struct" {
+			t.Errorf("expected error data must be a struct, got %v", err)
+		}
+	})
+}
+
+func BenchmarkEncode(b *testing.B) {
+	decoder := tagsl.NewTagSLv1Decoder()
+	encoder := TagSLv1Encoder{}
+
+	for _, test := range generatedTests {
+		uplink, err := decoder.Decode(context.TODO(), test.payload, test.port)
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+
+		config, err := encoder.PayloadConfig(test.port)
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+
+		b.Run(fmt.Sprintf("Port%v/Generated", test.port), func(b *testing.B) {
+			for b.Loop() {
+				_, _ = helpers.Encode(uplink.Data, config)
+			}
+		})
+		b.Run(fmt.Sprintf("Port%v/Reflect", test.port), func(b *testing.B) {
+			for b.Loop() {
+				_, _ = helpers.EncodeReflect(uplink.Data, config)
+			}
+		})
+	}
+}
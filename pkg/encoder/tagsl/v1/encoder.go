@@ -5,10 +5,19 @@ import (
 	"reflect"
 
 	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
 	tagsl "github.com/truvami/decoder/pkg/decoder/tagsl/v1"
 	"github.com/truvami/decoder/pkg/encoder"
 )
 
+//go:generate go run github.com/truvami/decoder/internal/decodergen
+
+func init() {
+	decoder.RegisterEncoder("tagsl/v1", func() encoder.Encoder {
+		return NewTagSLv1Encoder()
+	})
+}
+
 type Option func(*TagSLv1Encoder)
 
 type TagSLv1Encoder struct{}
@@ -25,7 +34,7 @@ func NewTagSLv1Encoder(options ...Option) encoder.Encoder {
 
 // Encode encodes the provided data into a payload string
 func (t TagSLv1Encoder) Encode(data any, port uint8) (any, error) {
-	config, err := t.getConfig(port)
+	config, err := t.PayloadConfig(port)
 	if err != nil {
 		return nil, err
 	}
@@ -38,17 +47,30 @@ func (t TagSLv1Encoder) Encode(data any, port uint8) (any, error) {
 	return payload, nil
 }
 
+// PayloadType returns the payload struct the encoder expects for the port.
+func (t TagSLv1Encoder) PayloadType(port uint8) (reflect.Type, error) {
+	config, err := t.PayloadConfig(port)
+	if err != nil {
+		return nil, err
+	}
+	return config.TargetType, nil
+}
+
+// PayloadConfig returns the payload layout the encoder uses for the port.
 // https://docs.truvami.com/docs/payloads/tag-S
 // https://docs.truvami.com/docs/payloads/tag-L
-func (t TagSLv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
+func (t TagSLv1Encoder) PayloadConfig(port uint8) (common.PayloadConfig, error) {
 	switch port {
 	case 1:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Year", Start: 11, Length: 1},
 				{Name: "Month", Start: 12, Length: 1},
 				{Name: "Day", Start: 13, Length: 1},
@@ -82,21 +104,14 @@ func (t TagSLv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 5:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Mac1", Start: 1, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 7, Length: 1},
-				{Name: "Mac2", Start: 8, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi2", Start: 14, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 15, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi3", Start: 21, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 22, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi4", Start: 28, Length: 1, Optional: true},
-				{Name: "Mac5", Start: 29, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi5", Start: 35, Length: 1, Optional: true},
-				{Name: "Mac6", Start: 36, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi6", Start: 42, Length: 1, Optional: true},
-				{Name: "Mac7", Start: 43, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi7", Start: 49, Length: 1, Optional: true},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "AccessPoints", Start: 1, Length: 7, MaxCount: 7, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(tagsl.Port5Payload{}),
 		}, nil
@@ -111,33 +126,31 @@ func (t TagSLv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
 				{Name: "Timestamp", Start: 0, Length: 4, Transform: timestamp},
-				{Name: "Moving", Start: 4, Length: 1, Transform: moving},
-				{Name: "Mac1", Start: 5, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 11, Length: 1},
-				{Name: "Mac2", Start: 12, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi2", Start: 18, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 19, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi3", Start: 25, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 26, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi4", Start: 32, Length: 1, Optional: true},
-				{Name: "Mac5", Start: 33, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi5", Start: 39, Length: 1, Optional: true},
-				{Name: "Mac6", Start: 40, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi6", Start: 46, Length: 1, Optional: true},
+				{Name: "DutyCycle", Start: 4, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 4, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 4, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 4, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "AccessPoints", Start: 5, Length: 7, MaxCount: 6, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(tagsl.Port7Payload{}),
 		}, nil
 	case 10:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 11, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 15, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 17, Length: 1, Transform: ttf},
-				{Name: "PDOP", Start: 18, Length: 1, Transform: pdop},
+				{Name: "Battery", Start: 15, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 17, Length: 1, Scale: 1, Unit: "s"},
+				{Name: "PDOP", Start: 18, Length: 1, Scale: 0.5},
 				{Name: "Satellites", Start: 19, Length: 1},
 			},
 			TargetType: reflect.TypeOf(tagsl.Port10Payload{}),
@@ -145,52 +158,53 @@ func (t TagSLv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	case 15:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "LowBattery", Start: 0, Length: 1, Transform: lowBattery},
-				{Name: "Battery", Start: 1, Length: 2, Transform: battery},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "LowBattery", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Battery", Start: 1, Length: 2, Scale: 0.001, Unit: "V"},
 			},
 			TargetType: reflect.TypeOf(tagsl.Port15Payload{}),
 		}, nil
 	case 50:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 11, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 15, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 17, Length: 1, Transform: ttf},
-				{Name: "Mac1", Start: 18, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 24, Length: 1},
-				{Name: "Mac2", Start: 25, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi2", Start: 31, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 32, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi3", Start: 38, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 39, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi4", Start: 45, Length: 1, Optional: true},
+				{Name: "Battery", Start: 15, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 17, Length: 1, Scale: 1, Unit: "s"},
+				{Name: "AccessPoints", Start: 18, Length: 7, MaxCount: 4, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(tagsl.Port50Payload{}),
 		}, nil
 	case 51:
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
-				{Name: "Moving", Start: 0, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 1, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 5, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 9, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 0, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 0, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 0, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 0, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 1, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 5, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 9, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 11, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 15, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 17, Length: 1, Transform: ttf},
-				{Name: "PDOP", Start: 18, Length: 1, Transform: pdop},
+				{Name: "Battery", Start: 15, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 17, Length: 1, Scale: 1, Unit: "s"},
+				{Name: "PDOP", Start: 18, Length: 1, Scale: 0.5},
 				{Name: "Satellites", Start: 19, Length: 1},
-				{Name: "Mac1", Start: 20, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 26, Length: 1},
-				{Name: "Mac2", Start: 27, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi2", Start: 33, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 34, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi3", Start: 40, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 41, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi4", Start: 47, Length: 1, Optional: true},
+				{Name: "AccessPoints", Start: 20, Length: 7, MaxCount: 4, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(tagsl.Port51Payload{}),
 		}, nil
@@ -199,19 +213,14 @@ func (t TagSLv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
 			Fields: []common.FieldConfig{
 				{Name: "BufferLevel", Start: 0, Length: 2},
 				{Name: "Timestamp", Start: 2, Length: 4, Transform: timestamp},
-				{Name: "Moving", Start: 6, Length: 1, Transform: moving},
-				{Name: "Mac1", Start: 7, Length: 6, Hex: true},
-				{Name: "Rssi1", Start: 13, Length: 1},
-				{Name: "Mac2", Start: 14, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi2", Start: 20, Length: 1, Optional: true},
-				{Name: "Mac3", Start: 21, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi3", Start: 27, Length: 1, Optional: true},
-				{Name: "Mac4", Start: 28, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi4", Start: 34, Length: 1, Optional: true},
-				{Name: "Mac5", Start: 35, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi5", Start: 41, Length: 1, Optional: true},
-				{Name: "Mac6", Start: 42, Length: 6, Hex: true, Optional: true},
-				{Name: "Rssi6", Start: 48, Length: 1, Optional: true},
+				{Name: "DutyCycle", Start: 6, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 6, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 6, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 6, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "AccessPoints", Start: 7, Length: 7, MaxCount: 6, Group: []common.FieldConfig{
+					{Name: "Mac", Start: 0, Length: 6, Hex: true},
+					{Name: "Rssi", Start: 6, Length: 1},
+				}},
 			},
 			TargetType: reflect.TypeOf(tagsl.Port105Payload{}),
 		}, nil
@@ -219,14 +228,17 @@ func (t TagSLv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
 		return common.PayloadConfig{
 			Fields: []common.FieldConfig{
 				{Name: "BufferLevel", Start: 0, Length: 2},
-				{Name: "Moving", Start: 2, Length: 1, Transform: moving},
-				{Name: "Latitude", Start: 3, Length: 4, Transform: latitude},
-				{Name: "Longitude", Start: 7, Length: 4, Transform: longitude},
-				{Name: "Altitude", Start: 11, Length: 2, Transform: altitude},
+				{Name: "DutyCycle", Start: 2, Length: 1, BitOffset: 7, BitLength: 1},
+				{Name: "ConfigId", Start: 2, Length: 1, BitOffset: 3, BitLength: 4},
+				{Name: "ConfigChange", Start: 2, Length: 1, BitOffset: 2, BitLength: 1},
+				{Name: "Moving", Start: 2, Length: 1, BitOffset: 0, BitLength: 1},
+				{Name: "Latitude", Start: 3, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Longitude", Start: 7, Length: 4, Signedness: common.Signed, Scale: 0.000001, Unit: "°"},
+				{Name: "Altitude", Start: 11, Length: 2, Scale: 0.1, Unit: "m"},
 				{Name: "Timestamp", Start: 13, Length: 4, Transform: timestamp},
-				{Name: "Battery", Start: 17, Length: 2, Transform: battery},
-				{Name: "TTF", Start: 19, Length: 1, Transform: ttf},
-				{Name: "PDOP", Start: 20, Length: 1, Transform: pdop},
+				{Name: "Battery", Start: 17, Length: 2, Scale: 0.001, Unit: "V"},
+				{Name: "TTF", Start: 19, Length: 1, Scale: 1, Unit: "s"},
+				{Name: "PDOP", Start: 20, Length: 1, Scale: 0.5},
 				{Name: "Satellites", Start: 21, Length: 1},
 			},
 			TargetType: reflect.TypeOf(tagsl.Port110Payload{}),
@@ -310,38 +322,6 @@ func (t TagSLv1Encoder) getConfig(port uint8) (common.PayloadConfig, error) {
 	return common.PayloadConfig{}, fmt.Errorf("%w: port %v not supported", common.ErrPortNotSupported, port)
 }
 
-func moving(v any) any {
-	return common.BoolToBytes(common.BytesToBool(v.([]byte)), 0)
-}
-
 func timestamp(v any) any {
 	return common.IntToBytes(common.BytesToInt64(v.([]byte)), 4)
 }
-
-func latitude(v any) any {
-	return common.IntToBytes(int64(common.BytesToFloat64(v.([]byte))*1000000), 4)
-}
-
-func longitude(v any) any {
-	return common.IntToBytes(int64(common.BytesToFloat64(v.([]byte))*1000000), 4)
-}
-
-func altitude(v any) any {
-	return common.UintToBytes(uint64(common.BytesToFloat64(v.([]byte))*10), 2)
-}
-
-func lowBattery(v any) any {
-	return common.BoolToBytes(common.BytesToBool(v.([]byte)), 0)
-}
-
-func battery(v any) any {
-	return common.UintToBytes(uint64(common.BytesToFloat64(v.([]byte))*1000), 2)
-}
-
-func ttf(v any) any {
-	return common.UintToBytes(uint64(common.BytesToInt64(v.([]byte))/1000000000), 1)
-}
-
-func pdop(v any) any {
-	return common.UintToBytes(uint64(common.BytesToFloat64(v.([]byte))*2), 1)
-}
@@ -48,6 +48,25 @@ func TestEncode(t *testing.T) {
 			port:     1,
 			expected: "0102c70f40009ef21e767a14042d2e2009",
 		},
+		{
+			data: tagsl.Port1Payload{
+				DutyCycle:    true,
+				ConfigId:     5,
+				ConfigChange: true,
+				Moving:       true,
+				Latitude:     46.63858,
+				Longitude:    10.39973,
+				Altitude:     2909,
+				Year:         16,
+				Month:        8,
+				Day:          32,
+				Hour:         12,
+				Minute:       56,
+				Second:       34,
+			},
+			port:     1,
+			expected: "ad02c7a5f4009eaff271a21008200c3822",
+		},
 		{
 			data: tagsl.Port4Payload{
 				LocalizationIntervalWhileMoving: 60,
@@ -93,8 +112,9 @@ func TestEncode(t *testing.T) {
 		{
 			data: tagsl.Port5Payload{
 				Moving: false,
-				Mac1:   "e1c384f2ab5d",
-				Rssi1:  -64,
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "e1c384f2ab5d", Rssi: -64},
+				},
 			},
 			port:     5,
 			expected: "00e1c384f2ab5dc0",
@@ -102,10 +122,10 @@ func TestEncode(t *testing.T) {
 		{
 			data: tagsl.Port5Payload{
 				Moving: true,
-				Mac1:   "e1c384f2ab5d",
-				Rssi1:  -64,
-				Mac2:   helpers.StringPtr("f6a8d09c3e72"),
-				Rssi2:  helpers.Int8Ptr(-72),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "e1c384f2ab5d", Rssi: -64},
+					{Mac: "f6a8d09c3e72", Rssi: -72},
+				},
 			},
 			port:     5,
 			expected: "01e1c384f2ab5dc0f6a8d09c3e72b8",
@@ -113,12 +133,11 @@ func TestEncode(t *testing.T) {
 		{
 			data: tagsl.Port5Payload{
 				Moving: true,
-				Mac1:   "e1c384f2ab5d",
-				Rssi1:  -64,
-				Mac2:   helpers.StringPtr("f6a8d09c3e72"),
-				Rssi2:  helpers.Int8Ptr(-72),
-				Mac3:   helpers.StringPtr("d9475b62c801"),
-				Rssi3:  helpers.Int8Ptr(-80),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "e1c384f2ab5d", Rssi: -64},
+					{Mac: "f6a8d09c3e72", Rssi: -72},
+					{Mac: "d9475b62c801", Rssi: -80},
+				},
 			},
 			port:     5,
 			expected: "01e1c384f2ab5dc0f6a8d09c3e72b8d9475b62c801b0",
@@ -126,14 +145,12 @@ func TestEncode(t *testing.T) {
 		{
 			data: tagsl.Port5Payload{
 				Moving: true,
-				Mac1:   "e1c384f2ab5d",
-				Rssi1:  -64,
-				Mac2:   helpers.StringPtr("f6a8d09c3e72"),
-				Rssi2:  helpers.Int8Ptr(-72),
-				Mac3:   helpers.StringPtr("d9475b62c801"),
-				Rssi3:  helpers.Int8Ptr(-80),
-				Mac4:   helpers.StringPtr("0a3ed14bf69c"),
-				Rssi4:  helpers.Int8Ptr(-88),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "e1c384f2ab5d", Rssi: -64},
+					{Mac: "f6a8d09c3e72", Rssi: -72},
+					{Mac: "d9475b62c801", Rssi: -80},
+					{Mac: "0a3ed14bf69c", Rssi: -88},
+				},
 			},
 			port:     5,
 			expected: "01e1c384f2ab5dc0f6a8d09c3e72b8d9475b62c801b00a3ed14bf69ca8",
@@ -141,16 +158,13 @@ func TestEncode(t *testing.T) {
 		{
 			data: tagsl.Port5Payload{
 				Moving: true,
-				Mac1:   "e1c384f2ab5d",
-				Rssi1:  -64,
-				Mac2:   helpers.StringPtr("f6a8d09c3e72"),
-				Rssi2:  helpers.Int8Ptr(-72),
-				Mac3:   helpers.StringPtr("d9475b62c801"),
-				Rssi3:  helpers.Int8Ptr(-80),
-				Mac4:   helpers.StringPtr("0a3ed14bf69c"),
-				Rssi4:  helpers.Int8Ptr(-88),
-				Mac5:   helpers.StringPtr("bc2e90da1473"),
-				Rssi5:  helpers.Int8Ptr(-96),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "e1c384f2ab5d", Rssi: -64},
+					{Mac: "f6a8d09c3e72", Rssi: -72},
+					{Mac: "d9475b62c801", Rssi: -80},
+					{Mac: "0a3ed14bf69c", Rssi: -88},
+					{Mac: "bc2e90da1473", Rssi: -96},
+				},
 			},
 			port:     5,
 			expected: "01e1c384f2ab5dc0f6a8d09c3e72b8d9475b62c801b00a3ed14bf69ca8bc2e90da1473a0",
@@ -158,18 +172,14 @@ func TestEncode(t *testing.T) {
 		{
 			data: tagsl.Port5Payload{
 				Moving: true,
-				Mac1:   "e1c384f2ab5d",
-				Rssi1:  -64,
-				Mac2:   helpers.StringPtr("f6a8d09c3e72"),
-				Rssi2:  helpers.Int8Ptr(-72),
-				Mac3:   helpers.StringPtr("d9475b62c801"),
-				Rssi3:  helpers.Int8Ptr(-80),
-				Mac4:   helpers.StringPtr("0a3ed14bf69c"),
-				Rssi4:  helpers.Int8Ptr(-88),
-				Mac5:   helpers.StringPtr("bc2e90da1473"),
-				Rssi5:  helpers.Int8Ptr(-96),
-				Mac6:   helpers.StringPtr("3f5a7cc0b6e8"),
-				Rssi6:  helpers.Int8Ptr(-104),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "e1c384f2ab5d", Rssi: -64},
+					{Mac: "f6a8d09c3e72", Rssi: -72},
+					{Mac: "d9475b62c801", Rssi: -80},
+					{Mac: "0a3ed14bf69c", Rssi: -88},
+					{Mac: "bc2e90da1473", Rssi: -96},
+					{Mac: "3f5a7cc0b6e8", Rssi: -104},
+				},
 			},
 			port:     5,
 			expected: "01e1c384f2ab5dc0f6a8d09c3e72b8d9475b62c801b00a3ed14bf69ca8bc2e90da1473a03f5a7cc0b6e898",
@@ -177,20 +187,15 @@ func TestEncode(t *testing.T) {
 		{
 			data: tagsl.Port5Payload{
 				Moving: true,
-				Mac1:   "e1c384f2ab5d",
-				Rssi1:  -64,
-				Mac2:   helpers.StringPtr("f6a8d09c3e72"),
-				Rssi2:  helpers.Int8Ptr(-72),
-				Mac3:   helpers.StringPtr("d9475b62c801"),
-				Rssi3:  helpers.Int8Ptr(-80),
-				Mac4:   helpers.StringPtr("0a3ed14bf69c"),
-				Rssi4:  helpers.Int8Ptr(-88),
-				Mac5:   helpers.StringPtr("bc2e90da1473"),
-				Rssi5:  helpers.Int8Ptr(-96),
-				Mac6:   helpers.StringPtr("3f5a7cc0b6e8"),
-				Rssi6:  helpers.Int8Ptr(-104),
-				Mac7:   helpers.StringPtr("a4d38e27fc60"),
-				Rssi7:  helpers.Int8Ptr(-112),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "e1c384f2ab5d", Rssi: -64},
+					{Mac: "f6a8d09c3e72", Rssi: -72},
+					{Mac: "d9475b62c801", Rssi: -80},
+					{Mac: "0a3ed14bf69c", Rssi: -88},
+					{Mac: "bc2e90da1473", Rssi: -96},
+					{Mac: "3f5a7cc0b6e8", Rssi: -104},
+					{Mac: "a4d38e27fc60", Rssi: -112},
+				},
 			},
 			port:     5,
 			expected: "01e1c384f2ab5dc0f6a8d09c3e72b8d9475b62c801b00a3ed14bf69ca8bc2e90da1473a03f5a7cc0b6e898a4d38e27fc6090",
@@ -213,8 +218,9 @@ func TestEncode(t *testing.T) {
 			data: tagsl.Port7Payload{
 				Timestamp: time.Date(1984, 4, 19, 0, 0, 0, 0, time.UTC),
 				Moving:    false,
-				Mac1:      "fa6d293c851b",
-				Rssi1:     -48,
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+				},
 			},
 			port:     7,
 			expected: "1ae4790000fa6d293c851bd0",
@@ -223,10 +229,10 @@ func TestEncode(t *testing.T) {
 			data: tagsl.Port7Payload{
 				Timestamp: time.Date(1996, 7, 3, 0, 0, 0, 0, time.UTC),
 				Moving:    true,
-				Mac1:      "fa6d293c851b",
-				Rssi1:     -48,
-				Mac2:      helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:     helpers.Int8Ptr(-56),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+				},
 			},
 			port:     7,
 			expected: "31d9b80001fa6d293c851bd00e42c97a1f64c8",
@@ -235,12 +241,11 @@ func TestEncode(t *testing.T) {
 			data: tagsl.Port7Payload{
 				Timestamp: time.Date(2004, 12, 24, 0, 0, 0, 0, time.UTC),
 				Moving:    false,
-				Mac1:      "fa6d293c851b",
-				Rssi1:     -48,
-				Mac2:      helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:     helpers.Int8Ptr(-56),
-				Mac3:      helpers.StringPtr("b3885e902da7"),
-				Rssi3:     helpers.Int8Ptr(-64),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+					{Mac: "b3885e902da7", Rssi: -64},
+				},
 			},
 			port:     7,
 			expected: "41cb5c0000fa6d293c851bd00e42c97a1f64c8b3885e902da7c0",
@@ -249,14 +254,12 @@ func TestEncode(t *testing.T) {
 			data: tagsl.Port7Payload{
 				Timestamp: time.Date(2011, 5, 31, 0, 0, 0, 0, time.UTC),
 				Moving:    true,
-				Mac1:      "fa6d293c851b",
-				Rssi1:     -48,
-				Mac2:      helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:     helpers.Int8Ptr(-56),
-				Mac3:      helpers.StringPtr("b3885e902da7"),
-				Rssi3:     helpers.Int8Ptr(-64),
-				Mac4:      helpers.StringPtr("4cd29176ab0f"),
-				Rssi4:     helpers.Int8Ptr(-72),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+					{Mac: "b3885e902da7", Rssi: -64},
+					{Mac: "4cd29176ab0f", Rssi: -72},
+				},
 			},
 			port:     7,
 			expected: "4de42f8001fa6d293c851bd00e42c97a1f64c8b3885e902da7c04cd29176ab0fb8",
@@ -265,16 +268,13 @@ func TestEncode(t *testing.T) {
 			data: tagsl.Port7Payload{
 				Timestamp: time.Date(2018, 8, 28, 0, 0, 0, 0, time.UTC),
 				Moving:    false,
-				Mac1:      "fa6d293c851b",
-				Rssi1:     -48,
-				Mac2:      helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:     helpers.Int8Ptr(-56),
-				Mac3:      helpers.StringPtr("b3885e902da7"),
-				Rssi3:     helpers.Int8Ptr(-64),
-				Mac4:      helpers.StringPtr("4cd29176ab0f"),
-				Rssi4:     helpers.Int8Ptr(-72),
-				Mac5:      helpers.StringPtr("a81b3def09cd"),
-				Rssi5:     helpers.Int8Ptr(-80),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+					{Mac: "b3885e902da7", Rssi: -64},
+					{Mac: "4cd29176ab0f", Rssi: -72},
+					{Mac: "a81b3def09cd", Rssi: -80},
+				},
 			},
 			port:     7,
 			expected: "5b84908000fa6d293c851bd00e42c97a1f64c8b3885e902da7c04cd29176ab0fb8a81b3def09cdb0",
@@ -283,18 +283,14 @@ func TestEncode(t *testing.T) {
 			data: tagsl.Port7Payload{
 				Timestamp: time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC),
 				Moving:    true,
-				Mac1:      "fa6d293c851b",
-				Rssi1:     -48,
-				Mac2:      helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:     helpers.Int8Ptr(-56),
-				Mac3:      helpers.StringPtr("b3885e902da7"),
-				Rssi3:     helpers.Int8Ptr(-64),
-				Mac4:      helpers.StringPtr("4cd29176ab0f"),
-				Rssi4:     helpers.Int8Ptr(-72),
-				Mac5:      helpers.StringPtr("a81b3def09cd"),
-				Rssi5:     helpers.Int8Ptr(-80),
-				Mac6:      helpers.StringPtr("3fe478115062"),
-				Rssi6:     helpers.Int8Ptr(-88),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+					{Mac: "b3885e902da7", Rssi: -64},
+					{Mac: "4cd29176ab0f", Rssi: -72},
+					{Mac: "a81b3def09cd", Rssi: -80},
+					{Mac: "3fe478115062", Rssi: -88},
+				},
 			},
 			port:     7,
 			expected: "696ec58001fa6d293c851bd00e42c97a1f64c8b3885e902da7c04cd29176ab0fb8a81b3def09cdb03fe478115062a8",
@@ -384,8 +380,9 @@ func TestEncode(t *testing.T) {
 				Timestamp: time.Date(2002, 5, 10, 0, 0, 0, 0, time.UTC),
 				Battery:   3.780,
 				TTF:       time.Duration(24) * time.Second,
-				Mac1:      "a3b9f214c6d0",
-				Rssi1:     -80,
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "a3b9f214c6d0", Rssi: -80},
+				},
 			},
 			port:     50,
 			expected: "0002c619f0007c0858a6f43cdb0d800ec418a3b9f214c6d0b0",
@@ -399,10 +396,10 @@ func TestEncode(t *testing.T) {
 				Timestamp: time.Date(2015, 7, 14, 0, 0, 0, 0, time.UTC),
 				Battery:   3.835,
 				TTF:       time.Duration(37) * time.Second,
-				Mac1:      "a3b9f214c6d0",
-				Rssi1:     -80,
-				Mac2:      helpers.StringPtr("f0e1d2c3b4a5"),
-				Rssi2:     helpers.Int8Ptr(-88),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "a3b9f214c6d0", Rssi: -80},
+					{Mac: "f0e1d2c3b4a5", Rssi: -88},
+				},
 			},
 			port:     50,
 			expected: "0102bd8aec0074dc68aeec55a451000efb25a3b9f214c6d0b0f0e1d2c3b4a5a8",
@@ -416,12 +413,11 @@ func TestEncode(t *testing.T) {
 				Timestamp: time.Date(1995, 10, 1, 0, 0, 0, 0, time.UTC),
 				Battery:   3.895,
 				TTF:       time.Duration(73) * time.Second,
-				Mac1:      "a3b9f214c6d0",
-				Rssi1:     -80,
-				Mac2:      helpers.StringPtr("f0e1d2c3b4a5"),
-				Rssi2:     helpers.Int8Ptr(-88),
-				Mac3:      helpers.StringPtr("9a8b7c6d5e4f"),
-				Rssi3:     helpers.Int8Ptr(-96),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "a3b9f214c6d0", Rssi: -80},
+					{Mac: "f0e1d2c3b4a5", Rssi: -88},
+					{Mac: "9a8b7c6d5e4f", Rssi: -96},
+				},
 			},
 			port:     50,
 			expected: "00ffd131f8023a0050e646306dda000f3749a3b9f214c6d0b0f0e1d2c3b4a5a89a8b7c6d5e4fa0",
@@ -435,14 +431,12 @@ func TestEncode(t *testing.T) {
 				Timestamp: time.Date(1990, 12, 1, 0, 0, 0, 0, time.UTC),
 				Battery:   3.960,
 				TTF:       time.Duration(192) * time.Second,
-				Mac1:      "a3b9f214c6d0",
-				Rssi1:     -80,
-				Mac2:      helpers.StringPtr("f0e1d2c3b4a5"),
-				Rssi2:     helpers.Int8Ptr(-88),
-				Mac3:      helpers.StringPtr("9a8b7c6d5e4f"),
-				Rssi3:     helpers.Int8Ptr(-96),
-				Mac4:      helpers.StringPtr("1c2d3e4f5a6b"),
-				Rssi4:     helpers.Int8Ptr(-104),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "a3b9f214c6d0", Rssi: -80},
+					{Mac: "f0e1d2c3b4a5", Rssi: -88},
+					{Mac: "9a8b7c6d5e4f", Rssi: -96},
+					{Mac: "1c2d3e4f5a6b", Rssi: -104},
+				},
 			},
 			port:     50,
 			expected: "01ff127ce0fbab7fd4fafa2756f2800f78c0a3b9f214c6d0b0f0e1d2c3b4a5a89a8b7c6d5e4fa01c2d3e4f5a6b98",
@@ -458,8 +452,9 @@ func TestEncode(t *testing.T) {
 				TTF:        time.Duration(24) * time.Second,
 				PDOP:       2,
 				Satellites: 12,
-				Mac1:       "a3b9f214c6d0",
-				Rssi1:      -80,
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "a3b9f214c6d0", Rssi: -80},
+				},
 			},
 			port:     51,
 			expected: "0002c619f0007c0858a6f43cdb0d800ec418040ca3b9f214c6d0b0",
@@ -475,10 +470,10 @@ func TestEncode(t *testing.T) {
 				TTF:        time.Duration(37) * time.Second,
 				PDOP:       2.5,
 				Satellites: 10,
-				Mac1:       "a3b9f214c6d0",
-				Rssi1:      -80,
-				Mac2:       helpers.StringPtr("f0e1d2c3b4a5"),
-				Rssi2:      helpers.Int8Ptr(-88),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "a3b9f214c6d0", Rssi: -80},
+					{Mac: "f0e1d2c3b4a5", Rssi: -88},
+				},
 			},
 			port:     51,
 			expected: "0102bd8aec0074dc68aeec55a451000efb25050aa3b9f214c6d0b0f0e1d2c3b4a5a8",
@@ -494,12 +489,11 @@ func TestEncode(t *testing.T) {
 				TTF:        time.Duration(73) * time.Second,
 				PDOP:       4,
 				Satellites: 6,
-				Mac1:       "a3b9f214c6d0",
-				Rssi1:      -80,
-				Mac2:       helpers.StringPtr("f0e1d2c3b4a5"),
-				Rssi2:      helpers.Int8Ptr(-88),
-				Mac3:       helpers.StringPtr("9a8b7c6d5e4f"),
-				Rssi3:      helpers.Int8Ptr(-96),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "a3b9f214c6d0", Rssi: -80},
+					{Mac: "f0e1d2c3b4a5", Rssi: -88},
+					{Mac: "9a8b7c6d5e4f", Rssi: -96},
+				},
 			},
 			port:     51,
 			expected: "00ffd131f8023a0050e646306dda000f37490806a3b9f214c6d0b0f0e1d2c3b4a5a89a8b7c6d5e4fa0",
@@ -515,14 +509,12 @@ func TestEncode(t *testing.T) {
 				TTF:        time.Duration(192) * time.Second,
 				PDOP:       9.5,
 				Satellites: 4,
-				Mac1:       "a3b9f214c6d0",
-				Rssi1:      -80,
-				Mac2:       helpers.StringPtr("f0e1d2c3b4a5"),
-				Rssi2:      helpers.Int8Ptr(-88),
-				Mac3:       helpers.StringPtr("9a8b7c6d5e4f"),
-				Rssi3:      helpers.Int8Ptr(-96),
-				Mac4:       helpers.StringPtr("1c2d3e4f5a6b"),
-				Rssi4:      helpers.Int8Ptr(-104),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "a3b9f214c6d0", Rssi: -80},
+					{Mac: "f0e1d2c3b4a5", Rssi: -88},
+					{Mac: "9a8b7c6d5e4f", Rssi: -96},
+					{Mac: "1c2d3e4f5a6b", Rssi: -104},
+				},
 			},
 			port:     51,
 			expected: "01ff127ce0fbab7fd4fafa2756f2800f78c01304a3b9f214c6d0b0f0e1d2c3b4a5a89a8b7c6d5e4fa01c2d3e4f5a6b98",
@@ -532,8 +524,9 @@ func TestEncode(t *testing.T) {
 				BufferLevel: 8128,
 				Timestamp:   time.Date(1984, 4, 19, 0, 0, 0, 0, time.UTC),
 				Moving:      false,
-				Mac1:        "fa6d293c851b",
-				Rssi1:       -48,
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+				},
 			},
 			port:     105,
 			expected: "1fc01ae4790000fa6d293c851bd0",
@@ -543,10 +536,10 @@ func TestEncode(t *testing.T) {
 				BufferLevel: 4375,
 				Timestamp:   time.Date(1996, 7, 3, 0, 0, 0, 0, time.UTC),
 				Moving:      true,
-				Mac1:        "fa6d293c851b",
-				Rssi1:       -48,
-				Mac2:        helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:       helpers.Int8Ptr(-56),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+				},
 			},
 			port:     105,
 			expected: "111731d9b80001fa6d293c851bd00e42c97a1f64c8",
@@ -556,12 +549,11 @@ func TestEncode(t *testing.T) {
 				BufferLevel: 1567,
 				Timestamp:   time.Date(2004, 12, 24, 0, 0, 0, 0, time.UTC),
 				Moving:      false,
-				Mac1:        "fa6d293c851b",
-				Rssi1:       -48,
-				Mac2:        helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:       helpers.Int8Ptr(-56),
-				Mac3:        helpers.StringPtr("b3885e902da7"),
-				Rssi3:       helpers.Int8Ptr(-64),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+					{Mac: "b3885e902da7", Rssi: -64},
+				},
 			},
 			port:     105,
 			expected: "061f41cb5c0000fa6d293c851bd00e42c97a1f64c8b3885e902da7c0",
@@ -571,14 +563,12 @@ func TestEncode(t *testing.T) {
 				BufferLevel: 2318,
 				Timestamp:   time.Date(2011, 5, 31, 0, 0, 0, 0, time.UTC),
 				Moving:      true,
-				Mac1:        "fa6d293c851b",
-				Rssi1:       -48,
-				Mac2:        helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:       helpers.Int8Ptr(-56),
-				Mac3:        helpers.StringPtr("b3885e902da7"),
-				Rssi3:       helpers.Int8Ptr(-64),
-				Mac4:        helpers.StringPtr("4cd29176ab0f"),
-				Rssi4:       helpers.Int8Ptr(-72),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+					{Mac: "b3885e902da7", Rssi: -64},
+					{Mac: "4cd29176ab0f", Rssi: -72},
+				},
 			},
 			port:     105,
 			expected: "090e4de42f8001fa6d293c851bd00e42c97a1f64c8b3885e902da7c04cd29176ab0fb8",
@@ -588,16 +578,13 @@ func TestEncode(t *testing.T) {
 				BufferLevel: 561,
 				Timestamp:   time.Date(2018, 8, 28, 0, 0, 0, 0, time.UTC),
 				Moving:      false,
-				Mac1:        "fa6d293c851b",
-				Rssi1:       -48,
-				Mac2:        helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:       helpers.Int8Ptr(-56),
-				Mac3:        helpers.StringPtr("b3885e902da7"),
-				Rssi3:       helpers.Int8Ptr(-64),
-				Mac4:        helpers.StringPtr("4cd29176ab0f"),
-				Rssi4:       helpers.Int8Ptr(-72),
-				Mac5:        helpers.StringPtr("a81b3def09cd"),
-				Rssi5:       helpers.Int8Ptr(-80),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+					{Mac: "b3885e902da7", Rssi: -64},
+					{Mac: "4cd29176ab0f", Rssi: -72},
+					{Mac: "a81b3def09cd", Rssi: -80},
+				},
 			},
 			port:     105,
 			expected: "02315b84908000fa6d293c851bd00e42c97a1f64c8b3885e902da7c04cd29176ab0fb8a81b3def09cdb0",
@@ -607,18 +594,14 @@ func TestEncode(t *testing.T) {
 				BufferLevel: 42,
 				Timestamp:   time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC),
 				Moving:      true,
-				Mac1:        "fa6d293c851b",
-				Rssi1:       -48,
-				Mac2:        helpers.StringPtr("0e42c97a1f64"),
-				Rssi2:       helpers.Int8Ptr(-56),
-				Mac3:        helpers.StringPtr("b3885e902da7"),
-				Rssi3:       helpers.Int8Ptr(-64),
-				Mac4:        helpers.StringPtr("4cd29176ab0f"),
-				Rssi4:       helpers.Int8Ptr(-72),
-				Mac5:        helpers.StringPtr("a81b3def09cd"),
-				Rssi5:       helpers.Int8Ptr(-80),
-				Mac6:        helpers.StringPtr("3fe478115062"),
-				Rssi6:       helpers.Int8Ptr(-88),
+				AccessPoints: []tagsl.AccessPoint{
+					{Mac: "fa6d293c851b", Rssi: -48},
+					{Mac: "0e42c97a1f64", Rssi: -56},
+					{Mac: "b3885e902da7", Rssi: -64},
+					{Mac: "4cd29176ab0f", Rssi: -72},
+					{Mac: "a81b3def09cd", Rssi: -80},
+					{Mac: "3fe478115062", Rssi: -88},
+				},
 			},
 			port:     105,
 			expected: "002a696ec58001fa6d293c851bd00e42c97a1f64c8b3885e902da7c04cd29176ab0fb8a81b3def09cdb03fe478115062a8",
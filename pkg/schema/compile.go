@@ -0,0 +1,212 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+var types = map[string]reflect.Type{
+	"bool":     reflect.TypeOf(bool(false)),
+	"int8":     reflect.TypeOf(int8(0)),
+	"int16":    reflect.TypeOf(int16(0)),
+	"int32":    reflect.TypeOf(int32(0)),
+	"int64":    reflect.TypeOf(int64(0)),
+	"uint8":    reflect.TypeOf(uint8(0)),
+	"uint16":   reflect.TypeOf(uint16(0)),
+	"uint32":   reflect.TypeOf(uint32(0)),
+	"uint64":   reflect.TypeOf(uint64(0)),
+	"float32":  reflect.TypeOf(float32(0)),
+	"float64":  reflect.TypeOf(float64(0)),
+	"string":   reflect.TypeOf(string("")),
+	"hex":      reflect.TypeOf(string("")),
+	"time":     reflect.TypeOf(time.Time{}),
+	"duration": reflect.TypeOf(time.Duration(0)),
+}
+
+var features = map[decoder.Feature]bool{
+	decoder.FeatureTimestamp:       true,
+	decoder.FeatureResetReason:     true,
+	decoder.FeatureGNSS:            true,
+	decoder.FeatureBuffered:        true,
+	decoder.FeatureBattery:         true,
+	decoder.FeaturePhotovoltaic:    true,
+	decoder.FeatureTemperature:     true,
+	decoder.FeatureHumidity:        true,
+	decoder.FeaturePressure:        true,
+	decoder.FeatureWiFi:            true,
+	decoder.FeatureBle:             true,
+	decoder.FeatureButton:          true,
+	decoder.FeatureConfig:          true,
+	decoder.FeatureConfigChange:    true,
+	decoder.FeatureMoving:          true,
+	decoder.FeatureDutyCycle:       true,
+	decoder.FeatureFirmwareVersion: true,
+	decoder.FeatureHardwareVersion: true,
+	decoder.FeatureRotationState:   true,
+	decoder.FeatureSequenceNumber:  true,
+	decoder.FeatureDataRate:        true,
+}
+
+// compile builds the payload config of the port, including a struct type
+// holding one exported field per schema field.
+func (p Port) compile() (common.PayloadConfig, error) {
+	if len(p.Fields) != 0 && len(p.Tags) != 0 {
+		return common.PayloadConfig{}, fmt.Errorf("%w: port %d declares fields and tags", ErrInvalidSchema, p.Port)
+	}
+
+	config := common.PayloadConfig{
+		Features: []decoder.Feature{},
+	}
+
+	for _, name := range p.Features {
+		feature := decoder.Feature(name)
+		if !features[feature] {
+			return common.PayloadConfig{}, fmt.Errorf("%w: port %d: unknown feature %q", ErrInvalidSchema, p.Port, name)
+		}
+		config.Features = append(config.Features, feature)
+	}
+
+	structFields := []reflect.StructField{}
+	names := map[string]bool{}
+
+	addField := func(field Field, optional bool) error {
+		if !isExported(field.Name) {
+			return fmt.Errorf("%w: port %d: field name %q must start with an upper case letter", ErrInvalidSchema, p.Port, field.Name)
+		}
+		if names[field.Name] {
+			return fmt.Errorf("%w: port %d: field %s is defined more than once", ErrInvalidSchema, p.Port, field.Name)
+		}
+		names[field.Name] = true
+
+		fieldType, ok := types[field.Type]
+		if !ok {
+			return fmt.Errorf("%w: port %d: field %s has unknown type %q", ErrInvalidSchema, p.Port, field.Name, field.Type)
+		}
+		if field.BitOffset < 0 || field.BitLength < 0 || field.BitLength > 64 {
+			return fmt.Errorf("%w: port %d: field %s has invalid bit range", ErrInvalidSchema, p.Port, field.Name)
+		}
+
+		if optional {
+			fieldType = reflect.PointerTo(fieldType)
+		}
+
+		tag := fmt.Sprintf(`json:"%s"`, jsonName(field))
+		if field.Validate != "" {
+			tag += fmt.Sprintf(` validate:"%s"`, field.Validate)
+		}
+
+		structFields = append(structFields, reflect.StructField{
+			Name: field.Name,
+			Type: fieldType,
+			Tag:  reflect.StructTag(tag),
+		})
+
+		return nil
+	}
+
+	for _, field := range p.Fields {
+		if field.Start < 0 || field.Length == 0 || field.Length < -1 {
+			return common.PayloadConfig{}, fmt.Errorf("%w: port %d: field %s has invalid position %d+%d", ErrInvalidSchema, p.Port, field.Name, field.Start, field.Length)
+		}
+
+		err := addField(field, field.Optional)
+		if err != nil {
+			return common.PayloadConfig{}, err
+		}
+
+		config.Fields = append(config.Fields, field.fieldConfig())
+	}
+
+	for _, tag := range p.Tags {
+		feature := decoder.Feature(tag.Feature)
+		if tag.Feature != "" && !features[feature] {
+			return common.PayloadConfig{}, fmt.Errorf("%w: port %d: unknown feature %q", ErrInvalidSchema, p.Port, tag.Feature)
+		}
+
+		// tags are only present if the device sent them
+		err := addField(tag.Field, true)
+		if err != nil {
+			return common.PayloadConfig{}, err
+		}
+
+		field := tag.fieldConfig()
+		config.Tags = append(config.Tags, common.TagConfig{
+			Name:       tag.Name,
+			Tag:        tag.Tag,
+			Optional:   true,
+			Feature:    feature,
+			Unit:       field.Unit,
+			Hex:        field.Hex,
+			BitOffset:  field.BitOffset,
+			BitLength:  field.BitLength,
+			Signedness: field.Signedness,
+			Scale:      field.Scale,
+			Offset:     field.Offset,
+		})
+	}
+
+	config.TargetType = reflect.StructOf(structFields)
+
+	return config, nil
+}
+
+// fieldConfig returns the config of a field, which tags are declared with as
+// well. Its sign, scale and offset are declared instead of converted by a
+// transform.
+func (f Field) fieldConfig() common.FieldConfig {
+	config := common.FieldConfig{
+		Name:      f.Name,
+		Start:     f.Start,
+		Length:    f.Length,
+		Optional:  f.Optional,
+		Hex:       f.Type == "hex",
+		BitOffset: f.BitOffset,
+		BitLength: f.BitLength,
+		Unit:      f.Unit,
+	}
+
+	switch f.Type {
+	case "bool", "string", "hex":
+		return config
+	case "float32", "float64", "time", "duration":
+		// the integer is converted into the type even if it is not scaled
+		config.Scale = 1
+	}
+
+	if f.Signed {
+		config.Signedness = common.Signed
+	}
+	if f.Scale != 0 || f.Offset != 0 {
+		config.Scale = f.Scale
+		config.Offset = f.Offset
+	}
+	return config
+}
+
+func jsonName(field Field) string {
+	if field.JSON != "" {
+		return field.JSON
+	}
+	return strings.ToLower(field.Name[:1]) + field.Name[1:]
+}
+
+func isExported(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if i == 0 && !unicode.IsUpper(r) {
+			return false
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,170 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+type Option func(*SchemaDecoder)
+
+// SchemaDecoder decodes uplinks of a device described by a Schema.
+type SchemaDecoder struct {
+	schema         Schema
+	configs        map[uint8]common.PayloadConfig
+	skipValidation bool
+	lenient        bool
+}
+
+// NewSchemaDecoder compiles the schema into payload configs and returns a decoder for it.
+func NewSchemaDecoder(schema Schema, options ...Option) (decoder.Decoder, error) {
+	schemaDecoder := &SchemaDecoder{
+		schema:  schema,
+		configs: map[uint8]common.PayloadConfig{},
+	}
+
+	for _, port := range schema.Ports {
+		config, err := port.compile()
+		if err != nil {
+			return nil, err
+		}
+		schemaDecoder.configs[port.Port] = config
+	}
+
+	for _, option := range options {
+		option(schemaDecoder)
+	}
+
+	return schemaDecoder, nil
+}
+
+// NewDevice compiles the schema into a registry entry. The decoders built by
+// the entry share the compiled payload configs.
+func NewDevice(schema Schema) (decoder.Device, error) {
+	compiled, err := NewSchemaDecoder(schema)
+	if err != nil {
+		return decoder.Device{}, err
+	}
+	configs := compiled.(*SchemaDecoder).configs
+
+	device := decoder.Device{
+		Name:        schema.Device,
+		Version:     schema.Version,
+		Description: schema.Path() + " schema",
+		New: func(options decoder.DeviceOptions) (decoder.Decoder, error) {
+			return &SchemaDecoder{
+				schema:         schema,
+				configs:        configs,
+				skipValidation: options.SkipValidation,
+				lenient:        options.Lenient,
+			}, nil
+		},
+	}
+	for _, port := range schema.Ports {
+		device.Ports = append(device.Ports, port.Port)
+		for _, feature := range configs[port.Port].Features {
+			if !slices.Contains(device.Features, feature) {
+				device.Features = append(device.Features, feature)
+			}
+		}
+	}
+	slices.Sort(device.Ports)
+
+	return device, nil
+}
+
+func WithSkipValidation(skipValidation bool) Option {
+	return func(t *SchemaDecoder) {
+		t.skipValidation = skipValidation
+	}
+}
+
+// WithLenient decodes truncated payloads as far as possible, see common.PayloadConfig.Lenient.
+func WithLenient(lenient bool) Option {
+	return func(t *SchemaDecoder) {
+		t.lenient = lenient
+	}
+}
+
+func (t SchemaDecoder) getConfig(port uint8) (common.PayloadConfig, error) {
+	config, ok := t.configs[port]
+	if !ok {
+		return common.PayloadConfig{}, fmt.Errorf("%w: port %v not supported", common.ErrPortNotSupported, port)
+	}
+
+	// decoding TLV payloads appends features, never share the slice
+	config.Features = append([]decoder.Feature{}, config.Features...)
+	return config, nil
+}
+
+var _ decoder.Catalog = &SchemaDecoder{}
+
+// Ports describes the payloads of the ports of the schema.
+func (t SchemaDecoder) Ports() []decoder.PortInfo {
+	ports := slices.Sorted(maps.Keys(t.configs))
+
+	infos := []decoder.PortInfo{}
+	for _, port := range ports {
+		infos = append(infos, common.DescribePort(port, nil, t.configs[port], ""))
+	}
+	return infos
+}
+
+var _ decoder.Explainer = &SchemaDecoder{}
+
+// Explain describes how each field of the payload is decoded on the port.
+func (t SchemaDecoder) Explain(ctx context.Context, payload []byte, port uint8) ([]decoder.FieldExplanation, error) {
+	config, err := t.getConfig(port)
+	if err != nil {
+		return nil, err
+	}
+	return common.Explain(payload, &config)
+}
+
+func (t SchemaDecoder) Decode(ctx context.Context, data string, port uint8) (*decoder.DecodedUplink, error) {
+	payload, err := decoder.FromHex(data)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, t.schema.Path(), port, nil)
+	}
+	return t.DecodeBytes(ctx, payload, port)
+}
+
+func (t SchemaDecoder) DecodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	options, err := decoder.OptionsFromContext(ctx, port)
+	if err != nil {
+		return nil, common.WrapDecodeError(err, t.schema.Path(), port, nil)
+	}
+	return t.DecodeWithOptions(ctx, payload, options)
+}
+
+var _ decoder.OptionsDecoder = &SchemaDecoder{}
+
+// DecodeWithOptions decodes the binary payload on options.Port with the options of the uplink.
+func (t SchemaDecoder) DecodeWithOptions(ctx context.Context, payload []byte, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	uplink, err := t.decodeBytes(ctx, payload, options.Port)
+	uplink.SetMetadata(t.schema.Path(), payload, options)
+	return uplink, common.WrapDecodeError(err, t.schema.Path(), options.Port, payload)
+}
+
+func (t SchemaDecoder) decodeBytes(ctx context.Context, payload []byte, port uint8) (*decoder.DecodedUplink, error) {
+	config, err := t.getConfig(port)
+	if err != nil {
+		return nil, err
+	}
+
+	config.Lenient = t.lenient
+
+	if !t.skipValidation {
+		err := common.ValidateBytesLength(payload, &config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	decodedData, err := common.DecodeBytes(payload, &config)
+	return decoder.NewDecodedUplink(config.Features, decodedData), err
+}
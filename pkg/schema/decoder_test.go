@@ -0,0 +1,242 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	helpers "github.com/truvami/decoder/pkg/common"
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+func TestDecode(t *testing.T) {
+	schemas, err := LoadDir("testdata")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	decoders := map[string]decoder.Decoder{}
+	for _, schema := range schemas {
+		d, err := NewSchemaDecoder(*schema)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		decoders[schema.Path()] = d
+	}
+
+	tests := []struct {
+		device   string
+		payload  string
+		port     uint8
+		expected string
+		features []decoder.Feature
+	}{
+		{
+			device:   "acme/v1",
+			port:     1,
+			payload:  "990d80fe0e6553f100012c",
+			expected: `{"dutyCycle":true,"configId":3,"moving":true,"battery":3.456,"temperature":-4.98,"timestamp":"2023-11-14T22:13:20Z","uplinkInterval":300000000000}`,
+			features: []decoder.Feature{decoder.FeatureDutyCycle, decoder.FeatureMoving, decoder.FeatureBattery, decoder.FeatureTemperature},
+		},
+		{
+			device:   "acme/v1",
+			port:     1,
+			payload:  "000bb800196553f100",
+			expected: `{"dutyCycle":false,"configId":0,"moving":false,"battery":3,"temperature":0.25,"timestamp":"2023-11-14T22:13:20Z","uplinkInterval":null}`,
+			features: []decoder.Feature{decoder.FeatureDutyCycle, decoder.FeatureMoving, decoder.FeatureBattery, decoder.FeatureTemperature},
+		},
+		{
+			device:   "acme/v1",
+			port:     2,
+			payload:  "0a0b0c0d68656c6c6f",
+			expected: `{"serial":"0a0b0c0d","name":"hello"}`,
+			features: []decoder.Feature{},
+		},
+		{
+			device:   "beacon/v2",
+			port:     151,
+			payload:  "4c000045020d8043013c4604deadbeef",
+			expected: `{"battery":3.456,"temperature":null,"heartbeatInterval":60,"firmwareHash":"deadbeef"}`,
+			features: []decoder.Feature{decoder.FeatureBattery, decoder.FeatureConfig, decoder.FeatureFirmwareVersion},
+		},
+		{
+			device:   "beacon/v2",
+			port:     151,
+			payload:  "4c000043013c",
+			expected: `{"battery":null,"temperature":null,"heartbeatInterval":60,"firmwareHash":null}`,
+			features: []decoder.Feature{decoder.FeatureConfig},
+		},
+		{
+			device:   "beacon/v2",
+			port:     151,
+			payload:  "4c00004702ff9c",
+			expected: `{"battery":null,"temperature":-10,"heartbeatInterval":null,"firmwareHash":null}`,
+			features: []decoder.Feature{decoder.FeatureTemperature},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("Test%vPort%vWith%v", test.device, test.port, test.payload), func(t *testing.T) {
+			got, err := decoders[test.device].Decode(context.TODO(), test.payload, test.port)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			marshaled, err := json.Marshal(got.Data)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(marshaled) != test.expected {
+				t.Errorf("expected: %s\ngot:      %s", test.expected, marshaled)
+			}
+
+			if !reflect.DeepEqual(got.GetFeatures(), test.features) {
+				t.Errorf("expected features: %v, got: %v", test.features, got.GetFeatures())
+			}
+		})
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	schema, err := Load("testdata/acme.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d, err := NewSchemaDecoder(*schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = d.Decode(context.TODO(), "00", 3)
+	if !errors.Is(err, helpers.ErrPortNotSupported) {
+		t.Errorf("expected port not supported, got %v", err)
+	}
+
+	_, err = d.Decode(context.TODO(), "990d80", 1)
+	if !errors.Is(err, helpers.ErrPayloadTooShort) {
+		t.Errorf("expected payload too short, got %v", err)
+	}
+
+	_, err = d.Decode(context.TODO(), "990d80fe0e6553f100012c00", 1)
+	if !errors.Is(err, helpers.ErrPayloadTooLong) {
+		t.Errorf("expected payload too long, got %v", err)
+	}
+
+	ctx := context.WithValue(context.TODO(), decoder.FCNT_CONTEXT_KEY, "42")
+	_, err = d.Decode(ctx, "990d80fe0e6553f100012c", 1)
+	if !errors.Is(err, decoder.ErrInvalidFrameCounter) {
+		t.Errorf("expected invalid frame counter, got %v", err)
+	}
+
+	d, err = NewSchemaDecoder(*schema, WithSkipValidation(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = d.Decode(context.TODO(), "990d80fe0e6553f100012c00", 1)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	schema, err := Parse([]byte(`
+device: sensor
+version: v1
+ports:
+  - port: 5
+    fields:
+      - name: Humidity
+        type: float32
+        start: 0
+        length: 1
+        scale: 0.5
+        validate: lte=100
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d, err := NewSchemaDecoder(*schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := d.Decode(context.TODO(), "c8", 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reflect.ValueOf(got.Data).FieldByName("Humidity").Float() != 100 {
+		t.Errorf("expected humidity 100, got %v", got.Data)
+	}
+
+	_, err = d.Decode(context.TODO(), "ff", 5)
+	if !errors.Is(err, helpers.ErrValidationFailed) {
+		t.Errorf("expected validation failed, got %v", err)
+	}
+}
+
+func TestPortsUnits(t *testing.T) {
+	schema, err := Load("testdata/acme.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d, err := NewSchemaDecoder(*schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	units := map[string]string{}
+	for _, field := range d.(decoder.Catalog).Ports()[0].Fields {
+		if field.Unit != "" {
+			units[field.Name] = field.Unit
+		}
+	}
+
+	expected := map[string]string{"Battery": "V", "Temperature": "°C"}
+	if !reflect.DeepEqual(units, expected) {
+		t.Errorf("expected units %v, got %v", expected, units)
+	}
+}
+
+func TestTagsJSONSchema(t *testing.T) {
+	schema, err := Load("testdata/beacon.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d, err := NewSchemaDecoder(*schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	port := d.(decoder.Catalog).Ports()[0]
+
+	scales := map[string]float64{}
+	for _, field := range port.Fields {
+		if field.Scale != 0 {
+			scales[field.Name] = field.Scale
+		}
+	}
+	expectedScales := map[string]float64{"Battery": 0.001, "Temperature": 0.1}
+	if !reflect.DeepEqual(scales, expectedScales) {
+		t.Errorf("expected scales %v, got %v", expectedScales, scales)
+	}
+
+	units := map[string]any{}
+	for name, property := range port.JSONSchema()["properties"].(map[string]any) {
+		if unit, ok := property.(map[string]any)[decoder.JSONSchemaUnit]; ok {
+			units[name] = unit
+		}
+	}
+	expectedUnits := map[string]any{"battery": "V", "temperature": "°C"}
+	if !reflect.DeepEqual(units, expectedUnits) {
+		t.Errorf("expected units %v, got %v", expectedUnits, units)
+	}
+}
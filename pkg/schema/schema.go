@@ -0,0 +1,186 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+var (
+	ErrInvalidSchema = errors.New("invalid schema")
+)
+
+// Schema describes the uplink payloads of a single device version.
+//
+// Example:
+//
+//	device: acme
+//	version: v1
+//	ports:
+//	  - port: 1
+//	    features: [battery, temperature]
+//	    fields:
+//	      - name: Battery
+//	        type: float64
+//	        start: 0
+//	        length: 2
+//	        scale: 0.001
+//	      - name: Temperature
+//	        type: float32
+//	        start: 2
+//	        length: 2
+//	        signed: true
+//	        scale: 0.01
+//	        unit: °C
+type Schema struct {
+	Device  string `json:"device" yaml:"device"`
+	Version string `json:"version" yaml:"version"`
+	Ports   []Port `json:"ports" yaml:"ports"`
+}
+
+// Port describes the payload layout of a single LoRaWAN port.
+// A port either declares fixed position fields or TLV tags.
+type Port struct {
+	Port     uint8    `json:"port" yaml:"port"`
+	Features []string `json:"features" yaml:"features"`
+	Fields   []Field  `json:"fields" yaml:"fields"`
+	Tags     []Tag    `json:"tags" yaml:"tags"`
+}
+
+// Field describes a single value of the payload.
+type Field struct {
+	// Name is the exported Go field name of the decoded value, e.g. "Battery".
+	Name string `json:"name" yaml:"name"`
+	// JSON is the key used when the decoded value is marshaled.
+	// Defaults to the name with a lower case first letter.
+	JSON string `json:"json" yaml:"json"`
+	// Type is one of bool, int8, int16, int32, int64, uint8, uint16, uint32, uint64,
+	// float32, float64, string, hex, time (unix seconds) or duration (seconds).
+	Type      string  `json:"type" yaml:"type"`
+	Start     int     `json:"start" yaml:"start"`
+	Length    int     `json:"length" yaml:"length"`
+	Optional  bool    `json:"optional" yaml:"optional"`
+	BitOffset int     `json:"bitOffset" yaml:"bitOffset"`
+	BitLength int     `json:"bitLength" yaml:"bitLength"`
+	Signed    bool    `json:"signed" yaml:"signed"`
+	Scale     float64 `json:"scale" yaml:"scale"`
+	Offset    float64 `json:"offset" yaml:"offset"`
+	// Unit is the unit of the decoded value, e.g. "V". It is listed in the
+	// catalog of the port.
+	Unit string `json:"unit" yaml:"unit"`
+	// Validate holds go-playground/validator rules, e.g. "gte=0,lte=15".
+	Validate string `json:"validate" yaml:"validate"`
+}
+
+// Tag describes a single TLV encoded value of the payload.
+type Tag struct {
+	Field   `yaml:",inline"`
+	Tag     uint8  `json:"tag" yaml:"tag"`
+	Feature string `json:"feature" yaml:"feature"`
+}
+
+// Path returns the path under which the schema is served, e.g. "acme/v1".
+func (s Schema) Path() string {
+	return s.Device + "/" + s.Version
+}
+
+// Parse reads a schema from YAML or JSON data.
+// JSON is a subset of YAML, so both formats are accepted.
+func Parse(data []byte) (*Schema, error) {
+	var schema Schema
+	err := yaml.Unmarshal(data, &schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+
+	err = schema.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema, nil
+}
+
+// Load reads a schema from a .yaml, .yml or .json file.
+func Load(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return schema, nil
+}
+
+// LoadDir reads every .yaml, .yml and .json schema of a directory.
+// Schemas are returned in lexical file name order.
+func LoadDir(dir string) ([]*Schema, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	schemas := []*Schema{}
+	paths := map[string]string{}
+	for _, name := range names {
+		schema, err := Load(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		if other, ok := paths[schema.Path()]; ok {
+			return nil, fmt.Errorf("%w: %s and %s both define %s", ErrInvalidSchema, other, name, schema.Path())
+		}
+		paths[schema.Path()] = name
+
+		schemas = append(schemas, schema)
+	}
+
+	return schemas, nil
+}
+
+func (s Schema) validate() error {
+	if s.Device == "" {
+		return fmt.Errorf("%w: device is required", ErrInvalidSchema)
+	}
+	if s.Version == "" {
+		return fmt.Errorf("%w: version is required", ErrInvalidSchema)
+	}
+
+	ports := map[uint8]bool{}
+	for _, port := range s.Ports {
+		if ports[port.Port] {
+			return fmt.Errorf("%w: port %d is defined more than once", ErrInvalidSchema, port.Port)
+		}
+		ports[port.Port] = true
+
+		// compiling the port checks types, names and features
+		_, err := port.compile()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
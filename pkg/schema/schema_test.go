@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadDir(t *testing.T) {
+	schemas, err := LoadDir("testdata")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(schemas) != 2 {
+		t.Fatalf("expected 2 schemas, got %d", len(schemas))
+	}
+
+	if schemas[0].Path() != "acme/v1" || schemas[1].Path() != "beacon/v2" {
+		t.Errorf("unexpected schemas %s and %s", schemas[0].Path(), schemas[1].Path())
+	}
+}
+
+func TestLoadDirDuplicate(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.yaml", "b.yml", "notes.txt"} {
+		err := os.WriteFile(filepath.Join(dir, name), []byte("device: acme\nversion: v1\n"), 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := LoadDir(dir)
+	if !errors.Is(err, ErrInvalidSchema) {
+		t.Errorf("expected invalid schema, got %v", err)
+	}
+}
+
+func TestLoadDirMissing(t *testing.T) {
+	_, err := LoadDir(filepath.Join(t.TempDir(), "missing"))
+	if err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{
+			name:   "Syntax",
+			schema: "device: [",
+		},
+		{
+			name:   "MissingDevice",
+			schema: "version: v1",
+		},
+		{
+			name:   "MissingVersion",
+			schema: "device: acme",
+		},
+		{
+			name:   "DuplicatePort",
+			schema: "device: acme\nversion: v1\nports:\n  - port: 1\n  - port: 1",
+		},
+		{
+			name:   "UnknownFeature",
+			schema: "device: acme\nversion: v1\nports:\n  - port: 1\n    features: [teleport]",
+		},
+		{
+			name:   "UnknownType",
+			schema: "device: acme\nversion: v1\nports:\n  - port: 1\n    fields:\n      - {name: A, type: complex128, start: 0, length: 1}",
+		},
+		{
+			name:   "UnexportedName",
+			schema: "device: acme\nversion: v1\nports:\n  - port: 1\n    fields:\n      - {name: a, type: uint8, start: 0, length: 1}",
+		},
+		{
+			name:   "DuplicateName",
+			schema: "device: acme\nversion: v1\nports:\n  - port: 1\n    fields:\n      - {name: A, type: uint8, start: 0, length: 1}\n      - {name: A, type: uint8, start: 1, length: 1}",
+		},
+		{
+			name:   "InvalidLength",
+			schema: "device: acme\nversion: v1\nports:\n  - port: 1\n    fields:\n      - {name: A, type: uint8, start: 0, length: 0}",
+		},
+		{
+			name:   "FieldsAndTags",
+			schema: "device: acme\nversion: v1\nports:\n  - port: 1\n    fields:\n      - {name: A, type: uint8, start: 0, length: 1}\n    tags:\n      - {name: B, type: uint8, tag: 64}",
+		},
+		{
+			name:   "UnknownTagFeature",
+			schema: "device: acme\nversion: v1\nports:\n  - port: 1\n    tags:\n      - {name: B, type: uint8, tag: 64, feature: teleport}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse([]byte(test.schema))
+			if !errors.Is(err, ErrInvalidSchema) {
+				t.Errorf("expected invalid schema, got %v", err)
+			}
+		})
+	}
+}
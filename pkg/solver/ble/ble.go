@@ -0,0 +1,184 @@
+package ble
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/truvami/decoder/internal/geo"
+	"github.com/truvami/decoder/pkg/decoder"
+	"github.com/truvami/decoder/pkg/solver"
+)
+
+// defaultRange is the accuracy in meters of a single beacon, about the indoor
+// range of a beacon.
+const defaultRange = 10
+
+// Beacon is a BLE beacon mounted at a known position.
+type Beacon struct {
+	MAC       string
+	Latitude  float64
+	Longitude float64
+	// Floor is the floor the beacon is mounted on, nil if unknown.
+	Floor *int
+	// Zone is the zone the beacon is mounted in, e.g. a room, empty if unknown.
+	Zone string
+}
+
+type Option func(*Locator)
+
+// Locator resolves indoor positions from a registry of mounted beacons.
+type Locator struct {
+	beacons    map[string]Beacon
+	minBeacons int
+}
+
+var _ solver.IndoorSolver = &Locator{}
+
+func NewLocator(beacons []Beacon, options ...Option) *Locator {
+	locator := &Locator{
+		beacons:    map[string]Beacon{},
+		minBeacons: 1,
+	}
+
+	for _, beacon := range beacons {
+		locator.beacons[geo.NormalizeMAC(beacon.MAC)] = beacon
+	}
+
+	for _, option := range options {
+		option(locator)
+	}
+
+	return locator
+}
+
+// WithMinBeacons sets how many known beacons a position needs, 1 by default.
+func WithMinBeacons(minBeacons int) Option {
+	return func(l *Locator) {
+		l.minBeacons = max(minBeacons, 1)
+	}
+}
+
+// OpenRegistry builds a locator from the beacons of the CSV file, see ReadRegistry.
+func OpenRegistry(path string, options ...Option) (*Locator, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	beacons, err := ReadRegistry(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidRegistry, path, err)
+	}
+	return NewLocator(beacons, options...), nil
+}
+
+// ReadRegistry reads beacons from rows of MAC, latitude, longitude and
+// optionally floor and zone. A first row which is no beacon is skipped as
+// header, lines starting with # are comments.
+func ReadRegistry(r io.Reader) ([]Beacon, error) {
+	return geo.ReadCSV(r, parseBeacon)
+}
+
+func parseBeacon(record []string) (Beacon, error) {
+	if len(record) < 3 || len(record) > 5 {
+		return Beacon{}, fmt.Errorf("expected 3 to 5 fields, got %d", len(record))
+	}
+
+	latitude, longitude, err := geo.ParsePosition(record[1], record[2])
+	if err != nil {
+		return Beacon{}, err
+	}
+
+	beacon := Beacon{
+		MAC:       record[0],
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+	if len(record) > 3 && strings.TrimSpace(record[3]) != "" {
+		floor, err := strconv.Atoi(strings.TrimSpace(record[3]))
+		if err != nil {
+			return Beacon{}, err
+		}
+		beacon.Floor = &floor
+	}
+	if len(record) > 4 {
+		beacon.Zone = strings.TrimSpace(record[4])
+	}
+	return beacon, nil
+}
+
+// SolveIndoor resolves the indoor position of the detected beacons. The floor
+// and zone are those of the beacons with the most received power 10^(RSSI/10),
+// the position is the centroid of the beacons on that floor weighted by it.
+func (l *Locator) SolveIndoor(ctx context.Context, beacons []decoder.Beacon) (*decoder.IndoorPosition, error) {
+	type known struct {
+		Beacon
+		weight float64
+	}
+
+	knowns := []known{}
+	floors := map[int]float64{}
+	for _, beacon := range beacons {
+		registered, ok := l.beacons[geo.NormalizeMAC(beacon.MAC)]
+		if !ok {
+			continue
+		}
+		weight := geo.Weight(beacon.RSSI)
+		knowns = append(knowns, known{Beacon: registered, weight: weight})
+		if registered.Floor != nil {
+			floors[*registered.Floor] += weight
+		}
+	}
+
+	if len(knowns) < l.minBeacons {
+		return nil, fmt.Errorf("%w: %d of %d beacons known", solver.ErrNoKnownBeacons, len(knowns), len(beacons))
+	}
+
+	position := &decoder.IndoorPosition{}
+	if floor, ok := strongest(floors); ok {
+		position.Floor = &floor
+	}
+
+	points := []geo.Point{}
+	zones := map[string]float64{}
+	for _, known := range knowns {
+		// beacons of other floors are closer than their position suggests
+		if position.Floor != nil && known.Floor != nil && *known.Floor != *position.Floor {
+			continue
+		}
+		points = append(points, geo.Point{
+			Latitude:  known.Latitude,
+			Longitude: known.Longitude,
+			Accuracy:  defaultRange,
+			Weight:    known.weight,
+		})
+		if known.Zone != "" {
+			zones[known.Zone] += known.weight
+		}
+	}
+
+	position.Latitude, position.Longitude, position.Accuracy = geo.Centroid(points)
+	position.Beacons = len(points)
+	position.Zone, _ = strongest(zones)
+	return position, nil
+}
+
+// strongest returns the key with the highest weight, the lowest key of equal
+// weights.
+func strongest[K cmp.Ordered](weights map[K]float64) (K, bool) {
+	var best K
+	found := false
+	for key, weight := range weights {
+		if !found || weight > weights[best] || (weight == weights[best] && key < best) {
+			best = key
+			found = true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,190 @@
+package ble
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/decoder"
+	"github.com/truvami/decoder/pkg/solver"
+)
+
+func rssi(v int8) *int8 {
+	return &v
+}
+
+func floor(v int) *int {
+	return &v
+}
+
+func TestSolveIndoor(t *testing.T) {
+	locator := NewLocator([]Beacon{
+		{MAC: "F0:52:FA:B9:20:FE", Latitude: 47, Longitude: 8, Floor: floor(1), Zone: "kitchen"},
+		{MAC: "d0e4158b38b9", Latitude: 47, Longitude: 8.0002, Floor: floor(1), Zone: "office"},
+		{MAC: "e05994cb2f5c", Latitude: 47, Longitude: 8.0001, Floor: floor(2), Zone: "lab"},
+		{MAC: "8c59c3c99fc0", Latitude: 47.0001, Longitude: 8},
+	})
+
+	tests := []struct {
+		name     string
+		beacons  []decoder.Beacon
+		expected decoder.IndoorPosition
+	}{
+		{
+			name:     "single",
+			beacons:  []decoder.Beacon{{MAC: "f052fab920fe", RSSI: rssi(-70)}, {MAC: "000000000000", RSSI: rssi(-50)}},
+			expected: decoder.IndoorPosition{Latitude: 47, Longitude: 8, Accuracy: 10, Floor: floor(1), Zone: "kitchen", Beacons: 1},
+		},
+		{
+			name:     "strongest zone",
+			beacons:  []decoder.Beacon{{MAC: "f052fab920fe", RSSI: rssi(-80)}, {MAC: "d0e4158b38b9", RSSI: rssi(-70)}},
+			expected: decoder.IndoorPosition{Latitude: 47, Longitude: 8.00018182, Accuracy: 10.91, Floor: floor(1), Zone: "office", Beacons: 2},
+		},
+		{
+			name:     "other floor ignored",
+			beacons:  []decoder.Beacon{{MAC: "f052fab920fe", RSSI: rssi(-70)}, {MAC: "d0e4158b38b9", RSSI: rssi(-70)}, {MAC: "e05994cb2f5c", RSSI: rssi(-68)}},
+			expected: decoder.IndoorPosition{Latitude: 47, Longitude: 8.0001, Accuracy: 12.55, Floor: floor(1), Zone: "kitchen", Beacons: 2},
+		},
+		{
+			name:     "without floor",
+			beacons:  []decoder.Beacon{{MAC: "8c59c3c99fc0"}},
+			expected: decoder.IndoorPosition{Latitude: 47.0001, Longitude: 8, Accuracy: 10, Beacons: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			position, err := locator.SolveIndoor(context.Background(), test.beacons)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if math.Abs(position.Latitude-test.expected.Latitude) > 1e-7 ||
+				math.Abs(position.Longitude-test.expected.Longitude) > 1e-7 ||
+				math.Abs(position.Accuracy-test.expected.Accuracy) > 0.01 ||
+				!reflect.DeepEqual(position.Floor, test.expected.Floor) ||
+				position.Zone != test.expected.Zone ||
+				position.Beacons != test.expected.Beacons {
+				t.Errorf("expected %+v, got %+v", test.expected, *position)
+			}
+		})
+	}
+}
+
+func TestSolveIndoorUnknown(t *testing.T) {
+	locator := NewLocator([]Beacon{{MAC: "f052fab920fe", Latitude: 47, Longitude: 8}}, WithMinBeacons(2))
+
+	_, err := locator.SolveIndoor(context.Background(), []decoder.Beacon{{MAC: "f052fab920fe"}, {MAC: "d0e4158b38b9"}})
+	if !errors.Is(err, solver.ErrNoKnownBeacons) {
+		t.Errorf("expected %v, got %v", solver.ErrNoKnownBeacons, err)
+	}
+}
+
+type bleData struct {
+	beacons []decoder.Beacon
+}
+
+func (b bleData) GetBeacons() []decoder.Beacon {
+	return b.beacons
+}
+
+func TestLocateIndoor(t *testing.T) {
+	locator := NewLocator([]Beacon{{MAC: "f052fab920fe", Latitude: 47, Longitude: 8, Floor: floor(-1), Zone: "garage"}})
+
+	data := bleData{beacons: []decoder.Beacon{{MAC: "f052fab920fe", RSSI: rssi(-70)}}}
+	uplink := decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureBle}, data)
+	uplink.Metadata = decoder.Metadata{Port: 3, DevEUI: "0011223344556677"}
+
+	err := solver.LocateIndoor(context.Background(), locator, uplink)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(uplink.Data, data) || uplink.Metadata.DevEUI != "0011223344556677" {
+		t.Errorf("expected the decoded uplink to be kept, got %+v", uplink)
+	}
+
+	indoor := uplink.Metadata.IndoorPosition
+	if indoor == nil || indoor.Latitude != 47 || indoor.Longitude != 8 || indoor.Accuracy != 10 || *indoor.Floor != -1 || indoor.Zone != "garage" || indoor.Beacons != 1 {
+		t.Errorf("unexpected indoor position %+v", indoor)
+	}
+
+	if _, ok := decoder.As[decoder.UplinkFeatureIndoorPosition](uplink); !ok {
+		t.Error("expected the indoor position feature")
+	}
+	if err := decoder.CheckFeatures([]decoder.Feature{decoder.FeatureIndoorPosition}, reflect.TypeOf(indoor)); err != nil {
+		t.Errorf("unexpected feature mismatch: %v", err)
+	}
+
+	telemetry := uplink.Telemetry()
+	if telemetry.Indoor == nil || telemetry.Indoor.Zone != "garage" || telemetry.Ble == nil {
+		t.Errorf("unexpected telemetry %+v", telemetry)
+	}
+}
+
+func TestReadRegistry(t *testing.T) {
+	tests := []struct {
+		name     string
+		csv      string
+		expected []Beacon
+		err      bool
+	}{
+		{
+			name: "header",
+			csv:  "mac,latitude,longitude,floor,zone\n# first floor\nf052fab920fe,47.0418,8.3093,1,kitchen\nd0:e4:15:8b:38:b9, 47.0419, 8.3095, , hall\ne05994cb2f5c,47.0417,8.3094\n",
+			expected: []Beacon{
+				{MAC: "f052fab920fe", Latitude: 47.0418, Longitude: 8.3093, Floor: floor(1), Zone: "kitchen"},
+				{MAC: "d0:e4:15:8b:38:b9", Latitude: 47.0419, Longitude: 8.3095, Zone: "hall"},
+				{MAC: "e05994cb2f5c", Latitude: 47.0417, Longitude: 8.3094},
+			},
+		},
+		{
+			name: "invalid floor",
+			csv:  "mac,latitude,longitude,floor\nf052fab920fe,47.0418,8.3093,first\n",
+			err:  true,
+		},
+		{
+			name: "invalid longitude",
+			csv:  "mac,latitude,longitude\nf052fab920fe,47.0418,188.3093\n",
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beacons, err := ReadRegistry(strings.NewReader(test.csv))
+			if (err != nil) != test.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.err && !reflect.DeepEqual(beacons, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, beacons)
+			}
+		})
+	}
+}
+
+func TestOpenRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacons.csv")
+	err := os.WriteFile(path, []byte("f052fab920fe,47.0418,8.3093,0,lobby\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locator, err := OpenRegistry(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	position, err := locator.SolveIndoor(context.Background(), []decoder.Beacon{{MAC: "f052fab920fe"}})
+	if err != nil || position.Zone != "lobby" {
+		t.Errorf("unexpected position %+v %v", position, err)
+	}
+
+	_ = os.WriteFile(path, []byte("mac,latitude,longitude\nf052fab920fe,47.0418\n"), 0o600)
+	_, err = OpenRegistry(path)
+	if !errors.Is(err, ErrInvalidRegistry) {
+		t.Errorf("expected %v, got %v", ErrInvalidRegistry, err)
+	}
+}
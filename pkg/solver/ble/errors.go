@@ -0,0 +1,5 @@
+package ble
+
+import "errors"
+
+var ErrInvalidRegistry = errors.New("invalid beacon registry")
@@ -0,0 +1,46 @@
+package solver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+var ErrNoKnownBeacons = errors.New("no known beacons")
+
+// IndoorSolver resolves an indoor position from the BLE beacons of an uplink.
+type IndoorSolver interface {
+	SolveIndoor(ctx context.Context, beacons []decoder.Beacon) (*decoder.IndoorPosition, error)
+}
+
+type MockIndoorSolver struct {
+	Position *decoder.IndoorPosition
+	Err      error
+}
+
+func (m MockIndoorSolver) SolveIndoor(ctx context.Context, beacons []decoder.Beacon) (*decoder.IndoorPosition, error) {
+	return m.Position, m.Err
+}
+
+// LocateIndoor resolves the indoor position of the BLE beacons of the uplink,
+// stores it in the metadata of the uplink and adds the indoor position feature
+// with it. Uplinks without beacons are left unchanged.
+func LocateIndoor(ctx context.Context, solver IndoorSolver, uplink *decoder.DecodedUplink) error {
+	ble, ok := decoder.As[decoder.UplinkFeatureBle](uplink)
+	if !ok {
+		return nil
+	}
+	beacons := ble.GetBeacons()
+	if len(beacons) == 0 {
+		return nil
+	}
+
+	position, err := solver.SolveIndoor(ctx, beacons)
+	if err != nil {
+		return err
+	}
+	uplink.Metadata.IndoorPosition = position
+	uplink.AddFeature(decoder.FeatureIndoorPosition, position)
+	return nil
+}
@@ -0,0 +1,73 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+type bleData struct {
+	beacons []decoder.Beacon
+}
+
+func (b bleData) GetBeacons() []decoder.Beacon {
+	return b.beacons
+}
+
+func TestLocateIndoor(t *testing.T) {
+	t.Parallel()
+
+	position := &decoder.IndoorPosition{Latitude: 47, Longitude: 8, Accuracy: 10, Zone: "kitchen", Beacons: 1}
+	failed := errors.New("failed")
+
+	tests := []struct {
+		name     string
+		uplink   *decoder.DecodedUplink
+		solver   IndoorSolver
+		expected *decoder.IndoorPosition
+		err      error
+	}{
+		{
+			name:     "ble",
+			uplink:   decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureBle}, bleData{beacons: []decoder.Beacon{{MAC: "f052fab920fe"}}}),
+			solver:   MockIndoorSolver{Position: position},
+			expected: position,
+		},
+		{
+			name:   "no beacons",
+			uplink: decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureBle}, bleData{}),
+			solver: MockIndoorSolver{Err: failed},
+		},
+		{
+			name:   "no ble",
+			uplink: decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureBattery}, bleData{}),
+			solver: MockIndoorSolver{Err: failed},
+		},
+		{
+			name:   "error",
+			uplink: decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureBle}, bleData{beacons: []decoder.Beacon{{MAC: "f052fab920fe"}}}),
+			solver: MockIndoorSolver{Err: failed},
+			err:    failed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := LocateIndoor(context.Background(), test.solver, test.uplink)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			if test.uplink.Metadata.IndoorPosition != test.expected {
+				t.Fatalf("expected position %v, got %v", test.expected, test.uplink.Metadata.IndoorPosition)
+			}
+			if test.uplink.Is(decoder.FeatureIndoorPosition) != (test.expected != nil) {
+				t.Fatalf("unexpected features %v", test.uplink.GetFeatures())
+			}
+			if indoor, ok := decoder.As[decoder.UplinkFeatureIndoorPosition](test.uplink); ok && indoor != test.expected {
+				t.Fatalf("expected position %v, got %v", test.expected, indoor)
+			}
+		})
+	}
+}
@@ -9,6 +9,9 @@ var (
 	ErrContextInvalidPort        = errors.New("context port is invalid, must be a number between 0 and 255")
 	ErrContextInvalidDevEui      = errors.New("context DevEUI is invalid, must be a valid hex string of length 16")
 	ErrContextInvalidFCount      = errors.New("context frame counter is invalid, must be a positive integer")
+	ErrDevEuiNotFound            = errors.New("DevEUI not set")
+	ErrFCountNotFound            = errors.New("frame counter not set")
+	ErrInvalidDevEui             = errors.New("DevEUI is invalid, must be a valid hex string of length 16")
 	ErrSemtechLoRaCloudShutdown  = errors.New("LoRa Cloud is no longer available after 31.07.2025, see https://www.semtech.com/loracloud-shutdown")
 	ErrSendingRequest            = errors.New("error sending request")
 	ErrUnexpectedStatusCode      = errors.New("unexpected status code returned")
@@ -104,22 +104,44 @@ func validateContext(ctx context.Context) error {
 	return nil
 }
 
+// Solve reads the port, DevEUI and frame counter of the uplink from the context.
+//
+// Deprecated: use SolveWithOptions.
 func (m LoracloudClient) Solve(ctx context.Context, payload string) (*decoder.DecodedUplink, error) {
 	if err := validateContext(ctx); err != nil {
 		return nil, fmt.Errorf("context validation failed: %v", err)
 	}
 
-	port, ok := ctx.Value(decoder.PORT_CONTEXT_KEY).(uint8)
-	if !ok {
-		return nil, fmt.Errorf("port not found in context")
+	port, _ := ctx.Value(decoder.PORT_CONTEXT_KEY).(uint8)
+	options, err := decoder.OptionsFromContext(ctx, port)
+	if err != nil {
+		return nil, fmt.Errorf("context validation failed: %w", err)
 	}
-	devEui, ok := ctx.Value(decoder.DEVEUI_CONTEXT_KEY).(string)
-	if !ok {
-		return nil, fmt.Errorf("devEui not found in context")
+	return m.SolveWithOptions(ctx, payload, options)
+}
+
+func validateOptions(options decoder.DecodeOptions) error {
+	if options.DevEUI == "" {
+		return ErrDevEuiNotFound
 	}
-	fCount, ok := ctx.Value(decoder.FCNT_CONTEXT_KEY).(int)
-	if !ok {
-		return nil, fmt.Errorf("fCount not found in context")
+	if options.FCnt == nil {
+		return ErrFCountNotFound
+	}
+	// check if devEui is a valid hex string
+	hexCheck, err := hex.DecodeString(options.DevEUI)
+	if err != nil || len(hexCheck) != 8 {
+		return ErrInvalidDevEui
+	}
+	return nil
+}
+
+var _ solver.OptionsSolverV1 = LoracloudClient{}
+
+// SolveWithOptions resolves the position of the payload for the DevEUI, port
+// and frame counter of the options.
+func (m LoracloudClient) SolveWithOptions(ctx context.Context, payload string, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	if err := validateOptions(options); err != nil {
+		return nil, fmt.Errorf("options validation failed: %w", err)
 	}
 
 	var timestamp *float64 = nil
@@ -130,11 +152,11 @@ func (m LoracloudClient) Solve(ctx context.Context, payload string) (*decoder.De
 		timestamp = &unixTime
 	}
 
-	decodedData, err := m.DeliverUplinkMessage(devEui, UplinkMsg{
+	decodedData, err := m.DeliverUplinkMessage(options.DevEUI, UplinkMsg{
 		MsgType:   "updf",
-		Port:      uint8(port),
+		Port:      options.Port,
 		Payload:   payload,
-		FCount:    uint32(fCount),
+		FCount:    *options.FCnt,
 		Timestamp: timestamp,
 	})
 
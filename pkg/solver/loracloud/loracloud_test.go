@@ -549,3 +549,44 @@ func TestWithTimeNow(t *testing.T) {
 	// Test that the time function was set correctly
 	assert.Equal(t, fixedTime, client.timeNow())
 }
+
+func TestValidateOptions(t *testing.T) {
+	fcnt := uint32(42)
+
+	tests := []struct {
+		name    string
+		options decoder.DecodeOptions
+		wantErr error
+	}{
+		{
+			name:    "missing devEui",
+			options: decoder.DecodeOptions{FCnt: &fcnt, Port: 1},
+			wantErr: ErrDevEuiNotFound,
+		},
+		{
+			name:    "missing fCount",
+			options: decoder.DecodeOptions{DevEUI: "0123456789abcdef", Port: 1},
+			wantErr: ErrFCountNotFound,
+		},
+		{
+			name:    "invalid devEui",
+			options: decoder.DecodeOptions{DevEUI: "0123456789abcdeg", FCnt: &fcnt, Port: 1},
+			wantErr: ErrInvalidDevEui,
+		},
+		{
+			name:    "valid options",
+			options: decoder.DecodeOptions{DevEUI: "0123456789abcdef", FCnt: &fcnt, Port: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateOptions(tt.options)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
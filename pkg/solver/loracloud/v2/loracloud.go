@@ -28,7 +28,10 @@ type LoracloudClient struct {
 	bufferedThreshold time.Duration
 }
 
-var _ solver.SolverV2 = &LoracloudClient{}
+var (
+	_ solver.SolverV2         = &LoracloudClient{}
+	_ solver.MultiFrameSolver = &LoracloudClient{}
+)
 
 // Options for configuring the v2 client
 type LoracloudClientOptions func(*LoracloudClient)
@@ -78,17 +81,6 @@ func (l LoracloudClient) Solve(ctx context.Context, payload string, options solv
 		return nil, common.WrapError(ErrInvalidOptions, err)
 	}
 
-	// Build timestamp to send to LoRaCloud (seconds, UTC)
-	var ts *float64
-	if options.Timestamp != nil {
-		sec := float64(options.Timestamp.UTC().Unix())
-		ts = &sec
-	} else {
-		// If no timestamp provided, always use current time for better API compatibility
-		sec := float64(time.Now().UTC().Unix())
-		ts = &sec
-	}
-
 	// Reuse v1 client for actual HTTP and response shaping, to keep behavior aligned
 	v1Client, err := v1.NewLoracloudClient(ctx, l.accessToken, l.logger, v1.WithBaseUrl(l.BaseUrl))
 	if err != nil {
@@ -97,31 +89,10 @@ func (l LoracloudClient) Solve(ctx context.Context, payload string, options solv
 		return nil, common.WrapError(ErrBuildRequest, err)
 	}
 
-	uplink := v1.UplinkMsg{
-		MsgType:   "updf",
-		FCount:    uint32(options.UplinkCounter),
-		Port:      options.Port,
-		Payload:   payload,
-		Timestamp: ts,
-	}
-
-	resp, err := v1Client.DeliverUplinkMessage(options.DevEui, uplink)
+	resp, err := v1Client.DeliverUplinkMessage(options.DevEui, uplinkMsg(payload, options))
 	if err != nil {
 		loracloudV2RequestsTotal.WithLabelValues(baseURLLabel, "error").Inc()
-		switch {
-		case errors.Is(err, v1.ErrUnexpectedStatusCode):
-			loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "unexpected_status").Inc()
-			return nil, common.WrapError(ErrUnexpectedStatus, err)
-		case errors.Is(err, v1.ErrDecodingResponse):
-			loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "decode_failed").Inc()
-			return nil, common.WrapError(ErrDecodeFailed, err)
-		case errors.Is(err, v1.ErrPositionResolutionIsEmpty):
-			loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "position_invalid").Inc()
-			return nil, common.WrapError(ErrPositionInvalid, err)
-		default:
-			loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "request_failed").Inc()
-			return nil, common.WrapError(ErrRequestFailed, err)
-		}
+		return nil, deliverError(baseURLLabel, err)
 	}
 
 	// Defensive validation of response
@@ -228,6 +199,76 @@ func (l LoracloudClient) Solve(ctx context.Context, payload string, options solv
 	return decoder.NewDecodedUplink(features, data), nil
 }
 
+// SolveFrames delivers the NAV messages of a GNSS-NG group in order. LoRa Cloud
+// combines the messages of the group and resolves the position with the last
+// one, which is solved like Solve.
+func (l LoracloudClient) SolveFrames(ctx context.Context, frames []solver.Frame) (*decoder.DecodedUplink, error) {
+	if len(frames) == 0 {
+		return nil, common.WrapError(ErrInvalidOptions, fmt.Errorf("no frames"))
+	}
+
+	baseURLLabel := l.BaseUrl
+	v1Client, err := v1.NewLoracloudClient(ctx, l.accessToken, l.logger, v1.WithBaseUrl(l.BaseUrl))
+	if err != nil {
+		loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "build_request").Inc()
+		return nil, common.WrapError(ErrBuildRequest, err)
+	}
+
+	last := frames[len(frames)-1]
+	for _, frame := range frames[:len(frames)-1] {
+		if err := l.validateOptions(frame.Payload, frame.Options); err != nil {
+			loracloudV2RequestsTotal.WithLabelValues(baseURLLabel, "error").Inc()
+			loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "invalid_options").Inc()
+			return nil, common.WrapError(ErrInvalidOptions, err)
+		}
+
+		_, err := v1Client.DeliverUplinkMessage(frame.Options.DevEui, uplinkMsg(frame.Payload, frame.Options))
+		if err != nil {
+			loracloudV2RequestsTotal.WithLabelValues(baseURLLabel, "error").Inc()
+			return nil, deliverError(baseURLLabel, err)
+		}
+		loracloudV2RequestsTotal.WithLabelValues(baseURLLabel, "success").Inc()
+	}
+
+	return l.Solve(ctx, last.Payload, last.Options)
+}
+
+// uplinkMsg returns the uplink message LoRa Cloud expects for the payload.
+func uplinkMsg(payload string, options solver.SolverV2Options) v1.UplinkMsg {
+	// Build timestamp to send to LoRaCloud (seconds, UTC)
+	// If no timestamp provided, always use current time for better API compatibility
+	sec := float64(time.Now().UTC().Unix())
+	if options.Timestamp != nil {
+		sec = float64(options.Timestamp.UTC().Unix())
+	}
+
+	return v1.UplinkMsg{
+		MsgType:   "updf",
+		FCount:    uint32(options.UplinkCounter),
+		Port:      options.Port,
+		Payload:   payload,
+		Timestamp: &sec,
+	}
+}
+
+// deliverError wraps the error of delivering an uplink message and counts it.
+func deliverError(baseURLLabel string, err error) error {
+	switch {
+	case errors.Is(err, v1.ErrUnexpectedStatusCode):
+		loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "unexpected_status").Inc()
+		return common.WrapError(ErrUnexpectedStatus, err)
+	case errors.Is(err, v1.ErrDecodingResponse):
+		loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "decode_failed").Inc()
+		return common.WrapError(ErrDecodeFailed, err)
+	case errors.Is(err, v1.ErrPositionResolutionIsEmpty):
+		loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "position_invalid").Inc()
+		return common.WrapError(ErrPositionInvalid, err)
+	default:
+		loracloudV2ErrorsTotal.WithLabelValues(baseURLLabel, "request_failed").Inc()
+		return common.WrapError(ErrRequestFailed, err)
+	}
+}
+
 // validateOptions validates DevEui, payload and basic constraints.
 func (l LoracloudClient) validateOptions(payload string, options solver.SolverV2Options) error {
 	if len(options.DevEui) != 16 {
@@ -1091,3 +1091,106 @@ func TestDataStructureSelection(t *testing.T) {
 		})
 	}
 }
+
+func TestSolveFrames(t *testing.T) {
+	type request struct {
+		payload string
+		fcnt    float64
+	}
+
+	tests := []struct {
+		name     string
+		frames   []solver.Frame
+		status   int
+		expected []request
+		err      error
+	}{
+		{
+			name: "group",
+			frames: []solver.Frame{
+				{Payload: "05aabb", Options: solver.SolverV2Options{DevEui: "0011223344556677", UplinkCounter: 1, Port: 192}},
+				{Payload: "05ccdd", Options: solver.SolverV2Options{DevEui: "0011223344556677", UplinkCounter: 2, Port: 192}},
+				{Payload: "85eeff", Options: solver.SolverV2Options{DevEui: "0011223344556677", UplinkCounter: 3, Port: 192}},
+			},
+			status:   http.StatusOK,
+			expected: []request{{payload: "05aabb", fcnt: 1}, {payload: "05ccdd", fcnt: 2}, {payload: "85eeff", fcnt: 3}},
+		},
+		{
+			name: "failed message",
+			frames: []solver.Frame{
+				{Payload: "05aabb", Options: solver.SolverV2Options{DevEui: "0011223344556677", UplinkCounter: 1, Port: 192}},
+				{Payload: "85eeff", Options: solver.SolverV2Options{DevEui: "0011223344556677", UplinkCounter: 2, Port: 192}},
+			},
+			status:   http.StatusInternalServerError,
+			expected: []request{{payload: "05aabb", fcnt: 1}},
+			err:      ErrUnexpectedStatus,
+		},
+		{
+			name:     "no frames",
+			frames:   []solver.Frame{},
+			expected: []request{},
+			err:      ErrInvalidOptions,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			received := []request{}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Uplink struct {
+						Payload string  `json:"payload"`
+						FCount  float64 `json:"fcnt"`
+					} `json:"uplink"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				received = append(received, request{payload: body.Uplink.Payload, fcnt: body.Uplink.FCount})
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				// LoRa Cloud resolves the position with the end of the group
+				llh := []float64{0, 0, 0}
+				if strings.HasPrefix(body.Uplink.Payload, "8") {
+					llh = []float64{47, 8, 10}
+				}
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"result": map[string]any{
+						"deveui": "00-11-22-33-44-55-66-77",
+						"position_solution": map[string]any{
+							"llh":              llh,
+							"accuracy":         5.0,
+							"capture_time_utc": float64(time.Now().UTC().Unix()),
+							"timestamp":        float64(time.Now().UTC().Unix()),
+						},
+						"operation": "gnss",
+					},
+				})
+			}))
+			defer srv.Close()
+
+			c, err := NewLoracloudClient(context.Background(), "Bearer test-token", newLogger(t), WithBaseUrl(srv.URL))
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			uplink, err := c.SolveFrames(context.Background(), test.frames)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			if test.err == nil {
+				gnss, ok := decoder.As[decoder.UplinkFeatureGNSS](uplink)
+				if !ok || gnss.GetLatitude() != 47 || gnss.GetLongitude() != 8 {
+					t.Errorf("expected position of the group, got %+v", uplink)
+				}
+			}
+			if len(received) != len(test.expected) {
+				t.Fatalf("expected requests %+v, got %+v", test.expected, received)
+			}
+			for i := range received {
+				if received[i] != test.expected[i] {
+					t.Errorf("expected request %+v, got %+v", test.expected[i], received[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package solver
+
+import (
+	"context"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+// Frame is a hex encoded NAV message of a GNSS-NG group with the options of
+// the uplink it was received with.
+type Frame struct {
+	Payload string
+	Options SolverV2Options
+}
+
+// MultiFrameSolver is implemented by solvers which solve the NAV messages of a
+// GNSS-NG group together. The frames are ordered by their frame counter, the
+// last one has the end of group flag set.
+type MultiFrameSolver interface {
+	SolveFrames(ctx context.Context, frames []Frame) (*decoder.DecodedUplink, error)
+}
+
+type MockMultiFrameSolver struct {
+	Data *decoder.DecodedUplink
+	Err  error
+}
+
+func (m MockMultiFrameSolver) SolveFrames(ctx context.Context, frames []Frame) (*decoder.DecodedUplink, error) {
+	return m.Data, m.Err
+}
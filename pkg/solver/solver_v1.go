@@ -18,3 +18,18 @@ type MockSolverV1 struct {
 func (m MockSolverV1) Solve(ctx context.Context, payload string) (*decoder.DecodedUplink, error) {
 	return m.Data, m.Err
 }
+
+// OptionsSolverV1 is implemented by v1 solvers which accept the options of the
+// uplink explicitly instead of reading the deprecated context keys.
+type OptionsSolverV1 interface {
+	SolveWithOptions(ctx context.Context, payload string, options decoder.DecodeOptions) (*decoder.DecodedUplink, error)
+}
+
+// SolveV1 solves the payload with the options of the uplink. Solvers which are
+// no OptionsSolverV1 get the options with the deprecated context keys.
+func SolveV1(ctx context.Context, solver SolverV1, payload string, options decoder.DecodeOptions) (*decoder.DecodedUplink, error) {
+	if optionsSolver, ok := solver.(OptionsSolverV1); ok {
+		return optionsSolver.SolveWithOptions(ctx, payload, options)
+	}
+	return solver.Solve(decoder.ContextWithOptions(ctx, options), payload)
+}
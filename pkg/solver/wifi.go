@@ -0,0 +1,45 @@
+package solver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+var ErrNoKnownAccessPoints = errors.New("no known access points")
+
+// WifiSolver resolves a position from the WiFi access points of an uplink.
+type WifiSolver interface {
+	SolveWifi(ctx context.Context, accessPoints []decoder.AccessPoint) (*decoder.WifiPosition, error)
+}
+
+type MockWifiSolver struct {
+	Position *decoder.WifiPosition
+	Err      error
+}
+
+func (m MockWifiSolver) SolveWifi(ctx context.Context, accessPoints []decoder.AccessPoint) (*decoder.WifiPosition, error) {
+	return m.Position, m.Err
+}
+
+// LocateWifi resolves the position of the WiFi access points of the uplink and
+// stores it in the metadata of the uplink. Uplinks without WiFi access points
+// are left unchanged.
+func LocateWifi(ctx context.Context, solver WifiSolver, uplink *decoder.DecodedUplink) error {
+	wifi, ok := decoder.As[decoder.UplinkFeatureWiFi](uplink)
+	if !ok {
+		return nil
+	}
+	accessPoints := wifi.GetAccessPoints()
+	if len(accessPoints) == 0 {
+		return nil
+	}
+
+	position, err := solver.SolveWifi(ctx, accessPoints)
+	if err != nil {
+		return err
+	}
+	uplink.Metadata.WifiPosition = position
+	return nil
+}
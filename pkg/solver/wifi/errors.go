@@ -0,0 +1,5 @@
+package wifi
+
+import "errors"
+
+var ErrInvalidDatabase = errors.New("invalid access point database")
@@ -0,0 +1,149 @@
+package wifi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/truvami/decoder/internal/geo"
+	"github.com/truvami/decoder/pkg/decoder"
+	"github.com/truvami/decoder/pkg/solver"
+)
+
+// defaultAccuracy is the accuracy in meters of access points without one,
+// about the range of an access point.
+const defaultAccuracy = 50
+
+// AccessPoint is the known position of an access point.
+type AccessPoint struct {
+	MAC       string
+	Latitude  float64
+	Longitude float64
+	// Accuracy is the radius in meters around the position the access point is
+	// located in, 0 if unknown.
+	Accuracy float64
+}
+
+type Option func(*LocalSolver)
+
+// LocalSolver resolves positions from a database of known access points
+// without any network requests.
+type LocalSolver struct {
+	accessPoints    map[string]AccessPoint
+	minAccessPoints int
+}
+
+var _ solver.WifiSolver = &LocalSolver{}
+
+func NewLocalSolver(accessPoints []AccessPoint, options ...Option) *LocalSolver {
+	localSolver := &LocalSolver{
+		accessPoints:    map[string]AccessPoint{},
+		minAccessPoints: 1,
+	}
+
+	for _, accessPoint := range accessPoints {
+		localSolver.accessPoints[geo.NormalizeMAC(accessPoint.MAC)] = accessPoint
+	}
+
+	for _, option := range options {
+		option(localSolver)
+	}
+
+	return localSolver
+}
+
+// WithMinAccessPoints sets how many known access points a position needs, 1 by default.
+func WithMinAccessPoints(minAccessPoints int) Option {
+	return func(s *LocalSolver) {
+		s.minAccessPoints = max(minAccessPoints, 1)
+	}
+}
+
+// OpenCSV builds a local solver from the access points of the CSV file, see ReadCSV.
+func OpenCSV(path string, options ...Option) (*LocalSolver, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	accessPoints, err := ReadCSV(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidDatabase, path, err)
+	}
+	return NewLocalSolver(accessPoints, options...), nil
+}
+
+// ReadCSV reads access points from rows of MAC, latitude, longitude and an
+// optional accuracy in meters. A first row which is no access point is skipped
+// as header, lines starting with # are comments.
+func ReadCSV(r io.Reader) ([]AccessPoint, error) {
+	return geo.ReadCSV(r, parseAccessPoint)
+}
+
+func parseAccessPoint(record []string) (AccessPoint, error) {
+	if len(record) < 3 || len(record) > 4 {
+		return AccessPoint{}, fmt.Errorf("expected 3 or 4 fields, got %d", len(record))
+	}
+
+	latitude, longitude, err := geo.ParsePosition(record[1], record[2])
+	if err != nil {
+		return AccessPoint{}, err
+	}
+
+	accessPoint := AccessPoint{
+		MAC:       record[0],
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+	if len(record) > 3 {
+		accuracy, err := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+		if err != nil {
+			return AccessPoint{}, err
+		}
+		if accuracy < 0 {
+			return AccessPoint{}, fmt.Errorf("invalid accuracy %v", record[3])
+		}
+		accessPoint.Accuracy = accuracy
+	}
+	return accessPoint, nil
+}
+
+// SolveWifi returns the centroid of the known access points weighted by their
+// received power 10^(RSSI/10). The accuracy combines the spread of the access
+// points around the centroid with their own accuracy.
+func (s *LocalSolver) SolveWifi(ctx context.Context, accessPoints []decoder.AccessPoint) (*decoder.WifiPosition, error) {
+	points := []geo.Point{}
+	for _, accessPoint := range accessPoints {
+		known, ok := s.accessPoints[geo.NormalizeMAC(accessPoint.MAC)]
+		if !ok {
+			continue
+		}
+
+		accuracy := known.Accuracy
+		if accuracy == 0 {
+			accuracy = defaultAccuracy
+		}
+		points = append(points, geo.Point{
+			Latitude:  known.Latitude,
+			Longitude: known.Longitude,
+			Accuracy:  accuracy,
+			Weight:    geo.Weight(accessPoint.RSSI),
+		})
+	}
+
+	if len(points) < s.minAccessPoints {
+		return nil, fmt.Errorf("%w: %d of %d access points known", solver.ErrNoKnownAccessPoints, len(points), len(accessPoints))
+	}
+
+	latitude, longitude, accuracy := geo.Centroid(points)
+	return &decoder.WifiPosition{
+		Latitude:     latitude,
+		Longitude:    longitude,
+		Accuracy:     accuracy,
+		AccessPoints: len(points),
+	}, nil
+}
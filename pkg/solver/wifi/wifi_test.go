@@ -0,0 +1,146 @@
+package wifi
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/decoder"
+	"github.com/truvami/decoder/pkg/solver"
+)
+
+func rssi(v int8) *int8 {
+	return &v
+}
+
+func TestSolveWifi(t *testing.T) {
+	database := NewLocalSolver([]AccessPoint{
+		{MAC: "E0:28:6D:8A:AB:FC", Latitude: 47, Longitude: 8, Accuracy: 10},
+		{MAC: "f052fab920fe", Latitude: 47, Longitude: 8.001, Accuracy: 10},
+		{MAC: "d0e4158b38b9", Latitude: 47.001, Longitude: 8},
+	})
+
+	tests := []struct {
+		name         string
+		accessPoints []decoder.AccessPoint
+		expected     decoder.WifiPosition
+	}{
+		{
+			name:         "single",
+			accessPoints: []decoder.AccessPoint{{MAC: "e0286d8aabfc", RSSI: rssi(-60)}, {MAC: "000000000000", RSSI: rssi(-40)}},
+			expected:     decoder.WifiPosition{Latitude: 47, Longitude: 8, Accuracy: 10, AccessPoints: 1},
+		},
+		{
+			name:         "equal rssi",
+			accessPoints: []decoder.AccessPoint{{MAC: "e0286d8aabfc", RSSI: rssi(-70)}, {MAC: "f052fab920fe", RSSI: rssi(-70)}},
+			expected:     decoder.WifiPosition{Latitude: 47, Longitude: 8.0005, Accuracy: 39.21, AccessPoints: 2},
+		},
+		{
+			name:         "weighted",
+			accessPoints: []decoder.AccessPoint{{MAC: "e0286d8aabfc", RSSI: rssi(-60)}, {MAC: "f052fab920fe", RSSI: rssi(-70)}},
+			expected:     decoder.WifiPosition{Latitude: 47, Longitude: 8.0000909, Accuracy: 23.99, AccessPoints: 2},
+		},
+		{
+			name:         "default rssi and accuracy",
+			accessPoints: []decoder.AccessPoint{{MAC: "d0e4158b38b9"}},
+			expected:     decoder.WifiPosition{Latitude: 47.001, Longitude: 8, Accuracy: 50, AccessPoints: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			position, err := database.SolveWifi(context.Background(), test.accessPoints)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if math.Abs(position.Latitude-test.expected.Latitude) > 1e-7 ||
+				math.Abs(position.Longitude-test.expected.Longitude) > 1e-7 ||
+				math.Abs(position.Accuracy-test.expected.Accuracy) > 0.01 ||
+				position.AccessPoints != test.expected.AccessPoints {
+				t.Errorf("expected %+v, got %+v", test.expected, *position)
+			}
+		})
+	}
+}
+
+func TestSolveWifiUnknown(t *testing.T) {
+	database := NewLocalSolver([]AccessPoint{{MAC: "e0286d8aabfc", Latitude: 47, Longitude: 8}}, WithMinAccessPoints(2))
+
+	_, err := database.SolveWifi(context.Background(), []decoder.AccessPoint{{MAC: "e0286d8aabfc"}, {MAC: "f052fab920fe"}})
+	if !errors.Is(err, solver.ErrNoKnownAccessPoints) {
+		t.Errorf("expected %v, got %v", solver.ErrNoKnownAccessPoints, err)
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		csv      string
+		expected []AccessPoint
+		err      bool
+	}{
+		{
+			name: "header",
+			csv:  "mac,latitude,longitude,accuracy\n# office\ne0286d8aabfc,47.0418,8.3093,15\nf0:52:fa:b9:20:fe, 47.0419, 8.3095\n",
+			expected: []AccessPoint{
+				{MAC: "e0286d8aabfc", Latitude: 47.0418, Longitude: 8.3093, Accuracy: 15},
+				{MAC: "f0:52:fa:b9:20:fe", Latitude: 47.0419, Longitude: 8.3095},
+			},
+		},
+		{
+			name:     "no header",
+			csv:      "e0286d8aabfc,47.0418,8.3093\n",
+			expected: []AccessPoint{{MAC: "e0286d8aabfc", Latitude: 47.0418, Longitude: 8.3093}},
+		},
+		{
+			name: "invalid latitude",
+			csv:  "mac,latitude,longitude\ne0286d8aabfc,97.0418,8.3093\n",
+			err:  true,
+		},
+		{
+			name: "missing longitude",
+			csv:  "e0286d8aabfc,47.0418,8.3093\nf052fab920fe,47.0419\n",
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			accessPoints, err := ReadCSV(strings.NewReader(test.csv))
+			if (err != nil) != test.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.err && !reflect.DeepEqual(accessPoints, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, accessPoints)
+			}
+		})
+	}
+}
+
+func TestOpenCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access-points.csv")
+	err := os.WriteFile(path, []byte("f052fab920fe,47.0419,8.3095,20\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	database, err := OpenCSV(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	position, err := database.SolveWifi(context.Background(), []decoder.AccessPoint{{MAC: "f052fab920fe"}})
+	if err != nil || position.Accuracy != 20 {
+		t.Errorf("unexpected position %+v %v", position, err)
+	}
+
+	_ = os.WriteFile(path, []byte("mac,latitude,longitude\nf052fab920fe,47.0419\n"), 0o600)
+	_, err = OpenCSV(path)
+	if !errors.Is(err, ErrInvalidDatabase) {
+		t.Errorf("expected %v, got %v", ErrInvalidDatabase, err)
+	}
+}
@@ -0,0 +1,67 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/truvami/decoder/pkg/decoder"
+)
+
+type wifiData struct {
+	accessPoints []decoder.AccessPoint
+}
+
+func (w wifiData) GetAccessPoints() []decoder.AccessPoint {
+	return w.accessPoints
+}
+
+func TestLocateWifi(t *testing.T) {
+	t.Parallel()
+
+	position := &decoder.WifiPosition{Latitude: 47, Longitude: 8, Accuracy: 20, AccessPoints: 1}
+	failed := errors.New("failed")
+
+	tests := []struct {
+		name     string
+		uplink   *decoder.DecodedUplink
+		solver   WifiSolver
+		expected *decoder.WifiPosition
+		err      error
+	}{
+		{
+			name:     "wifi",
+			uplink:   decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureWiFi}, wifiData{accessPoints: []decoder.AccessPoint{{MAC: "e0286d8aabfc"}}}),
+			solver:   MockWifiSolver{Position: position},
+			expected: position,
+		},
+		{
+			name:   "no access points",
+			uplink: decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureWiFi}, wifiData{}),
+			solver: MockWifiSolver{Err: failed},
+		},
+		{
+			name:   "no wifi",
+			uplink: decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureBattery}, wifiData{}),
+			solver: MockWifiSolver{Err: failed},
+		},
+		{
+			name:   "error",
+			uplink: decoder.NewDecodedUplink([]decoder.Feature{decoder.FeatureWiFi}, wifiData{accessPoints: []decoder.AccessPoint{{MAC: "e0286d8aabfc"}}}),
+			solver: MockWifiSolver{Err: failed},
+			err:    failed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := LocateWifi(context.Background(), test.solver, test.uplink)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			if test.uplink.Metadata.WifiPosition != test.expected {
+				t.Fatalf("expected position %v, got %v", test.expected, test.uplink.Metadata.WifiPosition)
+			}
+		})
+	}
+}